@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"os/signal"
 	"slices"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/primev/mev-commit/bridge/standard/pkg/node"
+	"github.com/primev/mev-commit/bridge/standard/pkg/relayer"
 	"github.com/primev/mev-commit/x/keysigner"
 	"github.com/primev/mev-commit/x/util"
 	"github.com/urfave/cli/v2"
@@ -32,17 +36,33 @@ var (
 	})
 
 	optionKeystorePath = altsrc.NewStringFlag(&cli.StringFlag{
-		Name:     "keystore-dir",
-		Usage:    "directory where keystore file is stored",
-		EnvVars:  []string{"STANDARD_BRIDGE_RELAYER_KEYSTORE_DIR"},
-		Required: true,
+		Name:    "keystore-dir",
+		Usage:   "directory where keystore file is stored, required unless a remote signer is used",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_KEYSTORE_DIR"},
 	})
 
 	optionKeystorePassword = altsrc.NewStringFlag(&cli.StringFlag{
-		Name:     "keystore-password",
-		Usage:    "use to access keystore",
-		EnvVars:  []string{"STANDARD_BRIDGE_RELAYER_KEYSTORE_PASSWORD"},
-		Required: true,
+		Name:    "keystore-password",
+		Usage:   "use to access keystore",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_KEYSTORE_PASSWORD"},
+	})
+
+	optionRemoteSignerURL = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-url",
+		Usage:   "URL of the Web3Signer compatible signing service, if set the private key is never loaded in the relayer",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_REMOTE_SIGNER_URL"},
+	})
+
+	optionRemoteSignerAddress = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-address",
+		Usage:   "address of the key to use on the remote signing service, required if it holds more than one key",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_REMOTE_SIGNER_ADDRESS"},
+		Action: func(_ *cli.Context, s string) error {
+			if s != "" && !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid remote signer address: %s", s)
+			}
+			return nil
+		},
 	})
 
 	optionLogFmt = altsrc.NewStringFlag(&cli.StringFlag{
@@ -99,6 +119,149 @@ var (
 		Required: true,
 	})
 
+	optionL1Confirmations = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "l1-confirmations",
+		Usage:   "Number of blocks the L1 gateway events must be deep before they are processed",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_L1_CONFIRMATIONS"},
+		Value:   0,
+	})
+
+	optionSettlementConfirmations = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "settlement-confirmations",
+		Usage:   "Number of blocks the settlement gateway events must be deep before they are processed",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_CONFIRMATIONS"},
+		Value:   0,
+	})
+
+	optionSettlementFeeBumpInterval = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "settlement-fee-bump-interval",
+		Usage:   "Replace settlement chain transactions pending for longer than the interval with bumped fees, 0 disables the replacements",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_FEE_BUMP_INTERVAL"},
+		Value:   10 * time.Second,
+	})
+
+	optionSettlementMaxGasTipCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "settlement-max-gas-tip-cap",
+		Usage:   "Maximum gas tip cap of the settlement chain transactions replaced with bumped fees",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_MAX_GAS_TIP_CAP"},
+		Value:   "500000000", // 0.5 gWEI
+	})
+
+	optionSettlementMaxGasFeeCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "settlement-max-gas-fee-cap",
+		Usage:   "Maximum gas fee cap of the settlement chain transactions replaced with bumped fees",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_MAX_GAS_FEE_CAP"},
+		Value:   "600000000", // 0.6 gWEI
+	})
+
+	optionFinalizationRetryBackoff = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "finalization-retry-backoff",
+		Usage:   "Delay before the first retry of a failed finalization, doubled with every attempt",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_FINALIZATION_RETRY_BACKOFF"},
+		Value:   10 * time.Second,
+		Action: func(_ *cli.Context, d time.Duration) error {
+			if d <= 0 {
+				return fmt.Errorf("finalization retry backoff must be positive")
+			}
+			return nil
+		},
+	})
+
+	optionFinalizationMaxRetryBackoff = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "finalization-max-retry-backoff",
+		Usage:   "Maximum delay between the retries of a failed finalization",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_FINALIZATION_MAX_RETRY_BACKOFF"},
+		Value:   10 * time.Minute,
+	})
+
+	optionReconcileInterval = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "reconcile-interval",
+		Usage:   "Interval between the scans of the gateway logs for missed transfers",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_RECONCILE_INTERVAL"},
+		Value:   10 * time.Minute,
+		Action: func(_ *cli.Context, d time.Duration) error {
+			if d <= 0 {
+				return fmt.Errorf("reconcile interval must be positive")
+			}
+			return nil
+		},
+	})
+
+	optionReconcileLookback = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "reconcile-lookback",
+		Usage:   "Number of blocks scanned for missed transfers before the first checkpoint",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_RECONCILE_LOOKBACK"},
+		Value:   10_000,
+	})
+
+	optionL1ToSettlementMaxTransferAmount = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "l1-to-settlement-max-transfer-amount",
+		Usage:   "Maximum amount in wei of a transfer from L1 finalized without approval, unlimited if empty",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_L1_TO_SETTLEMENT_MAX_TRANSFER_AMOUNT"},
+	})
+
+	optionL1ToSettlementMaxWindowVolume = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "l1-to-settlement-max-window-volume",
+		Usage:   "Maximum volume in wei of the transfers from L1 finalized in the limit window, unlimited if empty",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_L1_TO_SETTLEMENT_MAX_WINDOW_VOLUME"},
+	})
+
+	optionSettlementToL1MaxTransferAmount = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "settlement-to-l1-max-transfer-amount",
+		Usage:   "Maximum amount in wei of a transfer from the settlement chain finalized without approval, unlimited if empty",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_TO_L1_MAX_TRANSFER_AMOUNT"},
+	})
+
+	optionSettlementToL1MaxWindowVolume = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "settlement-to-l1-max-window-volume",
+		Usage:   "Maximum volume in wei of the transfers from the settlement chain finalized in the limit window, unlimited if empty",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_SETTLEMENT_TO_L1_MAX_WINDOW_VOLUME"},
+	})
+
+	optionLimitWindow = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "limit-window",
+		Usage:   "Rolling window of the maximum finalized volumes",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_LIMIT_WINDOW"},
+		Value:   24 * time.Hour,
+		Action: func(_ *cli.Context, d time.Duration) error {
+			if d <= 0 {
+				return fmt.Errorf("limit window must be positive")
+			}
+			return nil
+		},
+	})
+
+	optionPauseL1ToSettlement = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "pause-l1-to-settlement",
+		Usage:   "Start with the finalizations of the transfers from L1 paused",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_PAUSE_L1_TO_SETTLEMENT"},
+	})
+
+	optionPauseSettlementToL1 = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "pause-settlement-to-l1",
+		Usage:   "Start with the finalizations of the transfers from the settlement chain paused",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_PAUSE_SETTLEMENT_TO_L1"},
+	})
+
+	optionAdminPort = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "admin-port",
+		Usage:   "port of the admin API, which only listens on the loopback interface",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_ADMIN_PORT"},
+		Value:   8081,
+	})
+
+	optionAdminToken = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "admin-token",
+		Usage:   "Bearer token of the admin API, the admin API is disabled if empty",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_ADMIN_TOKEN"},
+	})
+
+	optionAlertWebhookURL = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "alert-webhook-url",
+		Usage:   "Slack compatible webhook URL the alerts of the held finalizations are posted to",
+		EnvVars: []string{"STANDARD_BRIDGE_RELAYER_ALERT_WEBHOOK_URL"},
+	})
+
 	optionL1ContractAddr = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "l1-contract-addr",
 		Usage:    "address of the L1 gateway contract",
@@ -155,11 +318,32 @@ func main() {
 		optionHTTPPort,
 		optionKeystorePath,
 		optionKeystorePassword,
+		optionRemoteSignerURL,
+		optionRemoteSignerAddress,
 		optionLogFmt,
 		optionLogLevel,
 		optionLogTags,
 		optionL1RPCUrls,
 		optionSettlementRPCUrl,
+		optionSettlementFeeBumpInterval,
+		optionSettlementMaxGasTipCap,
+		optionSettlementMaxGasFeeCap,
+		optionFinalizationRetryBackoff,
+		optionFinalizationMaxRetryBackoff,
+		optionReconcileInterval,
+		optionReconcileLookback,
+		optionL1Confirmations,
+		optionSettlementConfirmations,
+		optionL1ToSettlementMaxTransferAmount,
+		optionL1ToSettlementMaxWindowVolume,
+		optionSettlementToL1MaxTransferAmount,
+		optionSettlementToL1MaxWindowVolume,
+		optionLimitWindow,
+		optionPauseL1ToSettlement,
+		optionPauseSettlementToL1,
+		optionAdminPort,
+		optionAdminToken,
+		optionAlertWebhookURL,
 		optionL1ContractAddr,
 		optionSettlementContractAddr,
 		optionPgHost,
@@ -210,24 +394,76 @@ func start(c *cli.Context) error {
 		return fmt.Errorf("failed to create logger: %w", err)
 	}
 
-	signer, err := keysigner.NewKeystoreSigner(c.String(optionKeystorePath.Name), c.String(optionKeystorePassword.Name))
+	signer, err := newKeySigner(c)
 	if err != nil {
-		return fmt.Errorf("failed to create keystore signer: %w", err)
+		return fmt.Errorf("failed to create key signer: %w", err)
+	}
+
+	maxGasTipCap, ok := new(big.Int).SetString(c.String(optionSettlementMaxGasTipCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas tip cap %q", c.String(optionSettlementMaxGasTipCap.Name))
+	}
+	maxGasFeeCap, ok := new(big.Int).SetString(c.String(optionSettlementMaxGasFeeCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas fee cap %q", c.String(optionSettlementMaxGasFeeCap.Name))
+	}
+
+	var limitAmounts [4]*big.Int
+	for i, flag := range []*altsrc.StringFlag{
+		optionL1ToSettlementMaxTransferAmount,
+		optionL1ToSettlementMaxWindowVolume,
+		optionSettlementToL1MaxTransferAmount,
+		optionSettlementToL1MaxWindowVolume,
+	} {
+		value := c.String(flag.Name)
+		if value == "" {
+			continue
+		}
+		amount, ok := new(big.Int).SetString(value, 10)
+		if !ok || amount.Sign() < 0 {
+			return fmt.Errorf("failed to parse %s %q", flag.Name, value)
+		}
+		limitAmounts[i] = amount
 	}
 
 	nd, err := node.NewNode(&node.Options{
-		Logger:                 logger,
-		HTTPPort:               c.Int(optionHTTPPort.Name),
-		Signer:                 signer,
-		L1RPCURLs:              c.StringSlice(optionL1RPCUrls.Name),
-		L1GatewayContractAddr:  common.HexToAddress(c.String(optionL1ContractAddr.Name)),
-		SettlementRPCURL:       c.String(optionSettlementRPCUrl.Name),
-		SettlementContractAddr: common.HexToAddress(c.String(optionSettlementContractAddr.Name)),
-		PgHost:                 c.String(optionPgHost.Name),
-		PgPort:                 c.Int(optionPgPort.Name),
-		PgUser:                 c.String(optionPgUser.Name),
-		PgPassword:             c.String(optionPgPassword.Name),
-		PgDB:                   c.String(optionPgDbname.Name),
+		Logger:                    logger,
+		HTTPPort:                  c.Int(optionHTTPPort.Name),
+		Signer:                    signer,
+		L1RPCURLs:                 c.StringSlice(optionL1RPCUrls.Name),
+		L1GatewayContractAddr:     common.HexToAddress(c.String(optionL1ContractAddr.Name)),
+		SettlementRPCURL:          c.String(optionSettlementRPCUrl.Name),
+		SettlementContractAddr:    common.HexToAddress(c.String(optionSettlementContractAddr.Name)),
+		PgHost:                    c.String(optionPgHost.Name),
+		PgPort:                    c.Int(optionPgPort.Name),
+		PgUser:                    c.String(optionPgUser.Name),
+		PgPassword:                c.String(optionPgPassword.Name),
+		PgDB:                      c.String(optionPgDbname.Name),
+		L1Confirmations:           c.Uint64(optionL1Confirmations.Name),
+		SettlementConfirmations:   c.Uint64(optionSettlementConfirmations.Name),
+		SettlementFeeBumpInterval: c.Duration(optionSettlementFeeBumpInterval.Name),
+		SettlementMaxGasTipCap:    maxGasTipCap,
+		SettlementMaxGasFeeCap:    maxGasFeeCap,
+
+		FinalizationRetryBackoff:    c.Duration(optionFinalizationRetryBackoff.Name),
+		FinalizationMaxRetryBackoff: c.Duration(optionFinalizationMaxRetryBackoff.Name),
+		ReconcileInterval:           c.Duration(optionReconcileInterval.Name),
+		ReconcileLookback:           c.Uint64(optionReconcileLookback.Name),
+		L1ToSettlementLimits: relayer.Limits{
+			MaxTransferAmount: limitAmounts[0],
+			MaxWindowVolume:   limitAmounts[1],
+			Window:            c.Duration(optionLimitWindow.Name),
+			Paused:            c.Bool(optionPauseL1ToSettlement.Name),
+		},
+		SettlementToL1Limits: relayer.Limits{
+			MaxTransferAmount: limitAmounts[2],
+			MaxWindowVolume:   limitAmounts[3],
+			Window:            c.Duration(optionLimitWindow.Name),
+			Paused:            c.Bool(optionPauseSettlementToL1.Name),
+		},
+		AdminPort:       c.Int(optionAdminPort.Name),
+		AdminToken:      c.String(optionAdminToken.Name),
+		AlertWebhookURL: c.String(optionAlertWebhookURL.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create node: %w", err)
@@ -238,3 +474,17 @@ func start(c *cli.Context) error {
 
 	return nd.Close()
 }
+
+func newKeySigner(c *cli.Context) (keysigner.KeySigner, error) {
+	if c.IsSet(optionRemoteSignerURL.Name) {
+		return keysigner.NewRemoteSigner(
+			c.String(optionRemoteSignerURL.Name),
+			common.HexToAddress(c.String(optionRemoteSignerAddress.Name)),
+			0,
+		)
+	}
+	if !c.IsSet(optionKeystorePath.Name) || !c.IsSet(optionKeystorePassword.Name) {
+		return nil, errors.New("keystore dir and password are required without a remote signer")
+	}
+	return keysigner.NewKeystoreSigner(c.String(optionKeystorePath.Name), c.String(optionKeystorePassword.Name))
+}
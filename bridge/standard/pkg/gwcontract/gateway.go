@@ -23,6 +23,10 @@ type GatewayTransactor interface {
 	) (*types.Transaction, error)
 }
 
+type GatewayCaller interface {
+	TransferFinalizedIdx(opts *bind.CallOpts) (*big.Int, error)
+}
+
 type Storage interface {
 	StoreTransfer(
 		ctx context.Context,
@@ -44,6 +48,7 @@ type Gateway[EventType any] struct {
 	monitor           Monitor
 	listener          events.EventManager
 	gatewayTransactor GatewayTransactor
+	gatewayCaller     GatewayCaller
 	store             Storage
 	optsGetter        func(context.Context) (*bind.TransactOpts, error)
 	logger            *slog.Logger
@@ -54,6 +59,7 @@ func NewGateway[EventType any](
 	monitor Monitor,
 	listener events.EventManager,
 	transactor GatewayTransactor,
+	caller GatewayCaller,
 	optsGetter func(context.Context) (*bind.TransactOpts, error),
 	store Storage,
 ) *Gateway[EventType] {
@@ -61,6 +67,7 @@ func NewGateway[EventType any](
 		monitor:           monitor,
 		listener:          listener,
 		gatewayTransactor: transactor,
+		gatewayCaller:     caller,
 		store:             store,
 		optsGetter:        optsGetter,
 		logger:            logger,
@@ -74,7 +81,7 @@ func (g *Gateway[EventType]) FinalizeTransfer(
 	counterpartyIdx *big.Int,
 	finalizationFee *big.Int,
 ) error {
-	switch settled, err := g.store.IsSettled(ctx, counterpartyIdx); {
+	switch settled, err := g.IsSettled(ctx, counterpartyIdx); {
 	case err != nil:
 		g.logger.Error("failed to check if transfer is settled", "counterpartyIdx", counterpartyIdx, "error", err)
 		return err
@@ -137,6 +144,23 @@ func (g *Gateway[EventType]) FinalizeTransfer(
 	}
 }
 
+// IsSettled returns true if the transfer with the counterparty index is
+// finalized, either by the relayer or by anyone else on-chain. The gateway
+// finalizes the transfers in the order of the counterparty index, so every
+// index below the next one to finalize is settled.
+func (g *Gateway[EventType]) IsSettled(ctx context.Context, counterpartyIdx *big.Int) (bool, error) {
+	settled, err := g.store.IsSettled(ctx, counterpartyIdx)
+	if err != nil || settled {
+		return settled, err
+	}
+
+	nextIdx, err := g.gatewayCaller.TransferFinalizedIdx(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to get finalized transfer index: %w", err)
+	}
+	return counterpartyIdx.Cmp(nextIdx) < 0, nil
+}
+
 func (g *Gateway[EventType]) Subscribe(ctx context.Context) (<-chan *EventType, <-chan error) {
 	gatewayTransfers := make(chan *EventType)
 	sub, err := g.listener.Subscribe(
@@ -115,11 +115,20 @@ func (t *testGatewayTransactor) FinalizeTransfer(
 	), nil
 }
 
+type testGatewayCaller struct {
+	finalizedIdx *big.Int
+}
+
+func (t *testGatewayCaller) TransferFinalizedIdx(_ *bind.CallOpts) (*big.Int, error) {
+	return t.finalizedIdx, nil
+}
+
 func TestGateway(t *testing.T) {
 	logger := util.NewTestLogger(os.Stdout)
 	monitor := &testMonitor{errNonce: 3}
 	st := &testStorage{}
 	transactor := &testGatewayTransactor{}
+	caller := &testGatewayCaller{finalizedIdx: big.NewInt(1)}
 	optsGetter := func(context.Context) (*bind.TransactOpts, error) {
 		return nil, nil
 	}
@@ -139,6 +148,7 @@ func TestGateway(t *testing.T) {
 		monitor,
 		evtMgr,
 		transactor,
+		caller,
 		optsGetter,
 		st,
 	)
@@ -258,6 +268,29 @@ func TestGateway(t *testing.T) {
 	); err == nil {
 		t.Fatal("expected error")
 	}
+
+	// A transfer finalized on-chain without the relayer is settled.
+	caller.finalizedIdx = big.NewInt(6)
+	settled, err := gw.IsSettled(ctx, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !settled {
+		t.Fatal("expected transfer finalized on-chain to be settled")
+	}
+	prevNonce = transactor.nonce
+	if err := gw.FinalizeTransfer(
+		ctx,
+		common.HexToAddress("0x1234"),
+		big.NewInt(100),
+		big.NewInt(5),
+		big.NewInt(10),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if transactor.nonce != prevNonce {
+		t.Fatalf("expected nonce to not be incremented")
+	}
 }
 
 func publishTransfer(
@@ -0,0 +1,160 @@
+package gwcontract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// scanBlockRange is the maximum number of blocks queried for logs at once.
+const scanBlockRange = 5000
+
+type ScanClient interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+type InitiatedIdxCaller interface {
+	TransferInitiatedIdx(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// TransferLog is a TransferInitiated or TransferFinalized event of a gateway.
+// For the finalized transfers the index is the index of the transfer on the
+// counterparty gateway and there is no finalization fee.
+type TransferLog struct {
+	TransferIdx     *big.Int
+	Recipient       common.Address
+	Amount          *big.Int
+	FinalizationFee *big.Int
+	BlockNumber     uint64
+}
+
+// Scanner reads the past transfer events of a gateway contract. Both of the
+// gateways share the events of the Gateway contract.
+type Scanner struct {
+	client        ScanClient
+	caller        InitiatedIdxCaller
+	contract      *bind.BoundContract
+	abi           *abi.ABI
+	address       common.Address
+	confirmations uint64
+}
+
+// NewScanner returns a scanner which only scans the blocks at least
+// confirmations deep in the chain.
+func NewScanner(
+	client ScanClient,
+	caller InitiatedIdxCaller,
+	contractABI *abi.ABI,
+	address common.Address,
+	confirmations uint64,
+) *Scanner {
+	return &Scanner{
+		client:        client,
+		caller:        caller,
+		contract:      bind.NewBoundContract(address, *contractABI, nil, nil, nil),
+		abi:           contractABI,
+		address:       address,
+		confirmations: confirmations,
+	}
+}
+
+// BlockNumber returns the last block which is safe to scan, the confirmations
+// below the head of the chain.
+func (s *Scanner) BlockNumber(ctx context.Context) (uint64, error) {
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if head < s.confirmations {
+		return 0, nil
+	}
+	return head - s.confirmations, nil
+}
+
+// TransferInitiatedIdx returns the index of the next transfer initiated on
+// the gateway.
+func (s *Scanner) TransferInitiatedIdx(ctx context.Context) (*big.Int, error) {
+	return s.caller.TransferInitiatedIdx(&bind.CallOpts{Context: ctx})
+}
+
+// TransfersInitiated returns the transfers initiated on the gateway in the
+// block range, inclusive.
+func (s *Scanner) TransfersInitiated(ctx context.Context, from, to uint64) ([]*TransferLog, error) {
+	var transfers []*TransferLog
+	err := s.scan(ctx, "TransferInitiated", from, to, func(l types.Log) error {
+		var ev struct {
+			Sender                      common.Address
+			Recipient                   common.Address
+			Amount                      *big.Int
+			TransferIdx                 *big.Int
+			CounterpartyFinalizationFee *big.Int
+		}
+		if err := s.contract.UnpackLog(&ev, "TransferInitiated", l); err != nil {
+			return err
+		}
+		transfers = append(transfers, &TransferLog{
+			TransferIdx:     ev.TransferIdx,
+			Recipient:       ev.Recipient,
+			Amount:          ev.Amount,
+			FinalizationFee: ev.CounterpartyFinalizationFee,
+			BlockNumber:     l.BlockNumber,
+		})
+		return nil
+	})
+	return transfers, err
+}
+
+// TransfersFinalized returns the transfers finalized on the gateway in the
+// block range, inclusive.
+func (s *Scanner) TransfersFinalized(ctx context.Context, from, to uint64) ([]*TransferLog, error) {
+	var transfers []*TransferLog
+	err := s.scan(ctx, "TransferFinalized", from, to, func(l types.Log) error {
+		var ev struct {
+			Recipient       common.Address
+			Amount          *big.Int
+			CounterpartyIdx *big.Int
+		}
+		if err := s.contract.UnpackLog(&ev, "TransferFinalized", l); err != nil {
+			return err
+		}
+		transfers = append(transfers, &TransferLog{
+			TransferIdx: ev.CounterpartyIdx,
+			Recipient:   ev.Recipient,
+			Amount:      ev.Amount,
+			BlockNumber: l.BlockNumber,
+		})
+		return nil
+	})
+	return transfers, err
+}
+
+func (s *Scanner) scan(ctx context.Context, event string, from, to uint64, fn func(types.Log) error) error {
+	for start := from; start <= to; start += scanBlockRange {
+		end := min(start+scanBlockRange-1, to)
+		logs, err := s.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{s.address},
+			Topics:    [][]common.Hash{{s.abi.Events[event].ID}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to filter %s logs in blocks %d-%d: %w", event, start, end, err)
+		}
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			if err := fn(l); err != nil {
+				return fmt.Errorf("failed to unpack %s log: %w", event, err)
+			}
+		}
+	}
+	return nil
+}
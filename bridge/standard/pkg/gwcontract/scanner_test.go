@@ -0,0 +1,55 @@
+package gwcontract_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/mev-commit/bridge/standard/pkg/gwcontract"
+	l1gateway "github.com/primev/mev-commit/contracts-abi/clients/L1Gateway"
+)
+
+type testScanClient struct {
+	head uint64
+}
+
+func (c *testScanClient) BlockNumber(_ context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *testScanClient) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func TestScannerBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	gwABI, err := abi.JSON(strings.NewReader(l1gateway.L1gatewayABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &testScanClient{head: 100}
+	scanner := gwcontract.NewScanner(client, nil, &gwABI, common.HexToAddress("0x1"), 12)
+
+	blockNumber, err := scanner.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNumber != 88 {
+		t.Fatalf("expected block 88, got %d", blockNumber)
+	}
+
+	client.head = 5
+	blockNumber, err = scanner.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNumber != 0 {
+		t.Fatalf("expected block 0 below the confirmations, got %d", blockNumber)
+	}
+}
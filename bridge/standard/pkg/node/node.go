@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math/big"
@@ -16,6 +17,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/primev/mev-commit/bridge/standard/pkg/gwcontract"
+	"github.com/primev/mev-commit/bridge/standard/pkg/notifier"
+	"github.com/primev/mev-commit/bridge/standard/pkg/reconciler"
 	"github.com/primev/mev-commit/bridge/standard/pkg/relayer"
 	"github.com/primev/mev-commit/bridge/standard/pkg/store"
 	l1gateway "github.com/primev/mev-commit/contracts-abi/clients/L1Gateway"
@@ -46,6 +49,33 @@ type Options struct {
 	PgUser                 string
 	PgPassword             string
 	PgDB                   string
+	// The gateway logs are only processed and scanned by the reconciler once
+	// their block is the number of confirmations deep in the chain.
+	L1Confirmations         uint64
+	SettlementConfirmations uint64
+	// The transactions on the settlement chain which stay pending for longer
+	// than the interval are replaced with bumped fees, up to the max caps.
+	SettlementFeeBumpInterval time.Duration
+	SettlementMaxGasTipCap    *big.Int
+	SettlementMaxGasFeeCap    *big.Int
+	// The failed finalizations are retried after the backoff, which doubles
+	// with every attempt up to the max backoff.
+	FinalizationRetryBackoff    time.Duration
+	FinalizationMaxRetryBackoff time.Duration
+	// The gateway logs are scanned for missed transfers on startup and then
+	// every interval. The first scan starts the lookback blocks before the
+	// head of each chain.
+	ReconcileInterval time.Duration
+	ReconcileLookback uint64
+	// The finalizations tripping the safety limits of their direction are
+	// held for approval through the admin API, authenticated with the admin
+	// token and served on the admin port of the loopback interface. The
+	// alerts are posted to the webhook, if set.
+	L1ToSettlementLimits relayer.Limits
+	SettlementToL1Limits relayer.Limits
+	AdminPort            int
+	AdminToken           string
+	AlertWebhookURL      string
 }
 
 type StartableObjWithDesc struct {
@@ -68,6 +98,8 @@ type Node struct {
 	startables        []StartableObjWithDesc
 	l1Gateway         relayer.L1Gateway
 	settlementGateway relayer.SettlementGateway
+	l1Scanner         *gwcontract.Scanner
+	settlementScanner *gwcontract.Scanner
 	closeFn           func() error
 }
 
@@ -129,12 +161,24 @@ func NewNode(opts *Options) (*Node, error) {
 		opts.Signer,
 		opts.L1GatewayContractAddr,
 		l1Store,
+		nil,
+		opts.L1Confirmations,
 	)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to create L1 gateway contract: %w", err)
 	}
 
+	var settlementFeeBump *txmonitor.FeeBumpPolicy
+	if opts.SettlementFeeBumpInterval > 0 {
+		settlementFeeBump = &txmonitor.FeeBumpPolicy{
+			Interval:     opts.SettlementFeeBumpInterval,
+			Percentage:   txmonitor.DefaultFeeBumpPercentage,
+			MaxGasTipCap: opts.SettlementMaxGasTipCap,
+			MaxGasFeeCap: opts.SettlementMaxGasFeeCap,
+		}
+	}
+
 	err = n.createGatewayContract(
 		ctx,
 		"settlement",
@@ -143,21 +187,67 @@ func NewNode(opts *Options) (*Node, error) {
 		opts.Signer,
 		opts.SettlementContractAddr,
 		settlementStore,
+		settlementFeeBump,
+		opts.SettlementConfirmations,
 	)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to create settlement gateway contract: %w", err)
 	}
 
-	r := relayer.NewRelayer(
+	relayerOpts := []relayer.Option{
+		relayer.WithLimits(opts.L1ToSettlementLimits, opts.SettlementToL1Limits),
+		relayer.WithAdminToken(opts.AdminToken),
+	}
+	if opts.AlertWebhookURL != "" {
+		relayerOpts = append(relayerOpts, relayer.WithAlerter(notifier.New(opts.AlertWebhookURL)))
+	}
+	if opts.FinalizationRetryBackoff > 0 {
+		relayerOpts = append(
+			relayerOpts,
+			relayer.WithRetryBackoff(opts.FinalizationRetryBackoff, opts.FinalizationMaxRetryBackoff),
+		)
+	}
+
+	r, err := relayer.NewRelayer(
 		opts.Logger.With("component", "relayer"),
 		n.l1Gateway,
 		n.settlementGateway,
+		l1Store,
+		settlementStore,
+		relayerOpts...,
 	)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to create relayer: %w", err)
+	}
 	n.metrics.MustRegister(r.Metrics()...)
 
 	n.startables = append(n.startables, StartableObjWithDesc{Startable: r, Desc: "relayer"})
 
+	rc := reconciler.New(
+		opts.Logger.With("component", "reconciler"),
+		&reconciler.Chain{
+			Name:    "l1",
+			Scanner: n.l1Scanner,
+			Store:   l1Store,
+			Queue:   l1Store,
+			Gateway: n.l1Gateway,
+		},
+		&reconciler.Chain{
+			Name:    "settlement",
+			Scanner: n.settlementScanner,
+			Store:   settlementStore,
+			Queue:   settlementStore,
+			Gateway: n.settlementGateway,
+		},
+		opts.ReconcileInterval,
+		opts.ReconcileLookback,
+	)
+	n.metrics.MustRegister(rc.Metrics()...)
+
+	n.startables = append(n.startables, StartableObjWithDesc{Startable: rc, Desc: "reconciler"})
+
 	h := health.New()
 	waitChan := make([]<-chan struct{}, 0, len(n.startables))
 	for _, s := range n.startables {
@@ -205,6 +295,8 @@ func NewNode(opts *Options) (*Node, error) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
+	mux.Handle("GET /reconciliation", rc.Handler())
+
 	server := http.Server{
 		Addr:    fmt.Sprintf(":%d", opts.HTTPPort),
 		Handler: mux,
@@ -216,6 +308,19 @@ func NewNode(opts *Options) (*Node, error) {
 		}
 	}()
 
+	// The admin API changes the state of the finalizations, so it is not
+	// exposed on the public port.
+	adminServer := http.Server{
+		Addr:    fmt.Sprintf("127.0.0.1:%d", opts.AdminPort),
+		Handler: r.AdminHandler(),
+	}
+
+	go func() {
+		if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			opts.Logger.Error("failed to start admin HTTP server", "error", err)
+		}
+	}()
+
 	n.closeFn = func() error {
 		cancel()
 		_ = db.Close()
@@ -229,7 +334,7 @@ func NewNode(opts *Options) (*Node, error) {
 				return fmt.Errorf("failed to close in time")
 			}
 		}
-		return server.Shutdown(closeCtx)
+		return errors.Join(server.Shutdown(closeCtx), adminServer.Shutdown(closeCtx))
 	}
 
 	return n, nil
@@ -247,6 +352,8 @@ func (n *Node) createGatewayContract(
 	signer keysigner.KeySigner,
 	contractAddr common.Address,
 	st *store.Store,
+	feeBump *txmonitor.FeeBumpPolicy,
+	confirmations uint64,
 ) error {
 	client, err := ethclient.Dial(rpcURLs[0])
 	if err != nil {
@@ -295,6 +402,11 @@ func (n *Node) createGatewayContract(
 		return fmt.Errorf("failed to create wrapped client: %w", err)
 	}
 
+	var monitorOpts []txmonitor.Option
+	if feeBump != nil {
+		monitorOpts = append(monitorOpts, txmonitor.WithFeeBumping(*feeBump, chainID, signer, client))
+	}
+
 	monitor := txmonitor.New(
 		signer.GetAddress(),
 		wrappedClient,
@@ -306,6 +418,7 @@ func (n *Node) createGatewayContract(
 		st,
 		logger.With("component", fmt.Sprintf("%s/txmonitor", component)),
 		1024,
+		monitorOpts...,
 	)
 
 	n.startables = append(
@@ -325,12 +438,24 @@ func (n *Node) createGatewayContract(
 	)
 	n.metrics.MustRegister(txtor.Metrics()...)
 
-	var gatewayTxtor gwcontract.GatewayTransactor
+	var (
+		gatewayTxtor  gwcontract.GatewayTransactor
+		gatewayCaller interface {
+			gwcontract.GatewayCaller
+			gwcontract.InitiatedIdxCaller
+		}
+	)
 	switch component {
 	case "l1":
 		gatewayTxtor, err = l1gateway.NewL1gatewayTransactor(contractAddr, txtor)
+		if err == nil {
+			gatewayCaller, err = l1gateway.NewL1gatewayCaller(contractAddr, client)
+		}
 	case "settlement":
 		gatewayTxtor, err = settlementgateway.NewSettlementgatewayTransactor(contractAddr, txtor)
+		if err == nil {
+			gatewayCaller, err = settlementgateway.NewSettlementgatewayCaller(contractAddr, client)
+		}
 	default:
 		return fmt.Errorf("unknown component: %s", component)
 	}
@@ -349,6 +474,7 @@ func (n *Node) createGatewayContract(
 		logger,
 		wrappedClient,
 		evtMgr,
+		publisher.WithConfirmations(confirmations),
 	)
 	n.startables = append(
 		n.startables,
@@ -362,24 +488,30 @@ func (n *Node) createGatewayContract(
 		},
 	)
 
+	scanner := gwcontract.NewScanner(wrappedClient, gatewayCaller, &parsedABI, contractAddr, confirmations)
+
 	switch component {
 	case "l1":
+		n.l1Scanner = scanner
 		n.l1Gateway = gwcontract.NewGateway[l1gateway.L1gatewayTransferInitiated](
 			logger,
 			monitor,
 			evtMgr,
 			gatewayTxtor,
+			gatewayCaller,
 			func(ctx context.Context) (*bind.TransactOpts, error) {
 				return signer.GetAuthWithCtx(ctx, chainID)
 			},
 			st,
 		)
 	case "settlement":
+		n.settlementScanner = scanner
 		n.settlementGateway = gwcontract.NewGateway[settlementgateway.SettlementgatewayTransferInitiated](
 			logger,
 			monitor,
 			evtMgr,
 			gatewayTxtor,
+			gatewayCaller,
 			func(ctx context.Context) (*bind.TransactOpts, error) {
 				return signer.GetAuthWithCtx(ctx, chainID)
 			},
@@ -0,0 +1,42 @@
+package reconciler
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type metrics struct {
+	runs                    prometheus.Counter
+	failedRuns              prometheus.Counter
+	unexpectedFinalizations *prometheus.CounterVec
+
+	unmatchedTransfers *prometheus.GaugeVec
+	amountMismatches   *prometheus.GaugeVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		runs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bridge_reconciler",
+			Name:      "runs",
+			Help:      "Number of reconciliation runs",
+		}),
+		failedRuns: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bridge_reconciler",
+			Name:      "failed_runs",
+			Help:      "Number of failed reconciliation runs",
+		}),
+		unexpectedFinalizations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bridge_reconciler",
+			Name:      "unexpected_finalizations",
+			Help:      "Number of finalizations of transfers which were never initiated",
+		}, []string{"direction"}),
+		unmatchedTransfers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bridge_reconciler",
+			Name:      "unmatched_transfers",
+			Help:      "Number of initiated transfers without a finalization",
+		}, []string{"direction"}),
+		amountMismatches: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bridge_reconciler",
+			Name:      "amount_mismatches",
+			Help:      "Number of transfers finalized with a different amount",
+		}, []string{"direction"}),
+	}
+}
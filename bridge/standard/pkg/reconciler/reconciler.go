@@ -0,0 +1,468 @@
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/primev/mev-commit/bridge/standard/pkg/gwcontract"
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Scanner interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	TransferInitiatedIdx(ctx context.Context) (*big.Int, error)
+	TransfersInitiated(ctx context.Context, from, to uint64) ([]*gwcontract.TransferLog, error)
+	TransfersFinalized(ctx context.Context, from, to uint64) ([]*gwcontract.TransferLog, error)
+}
+
+type Store interface {
+	RecordTransferInitiated(ctx context.Context, t *store.ReconciledTransfer) error
+	RecordTransferFinalized(ctx context.Context, transferIdx, amount *big.Int) (bool, error)
+	UnreconciledTransfers(ctx context.Context) ([]*store.ReconciledTransfer, error)
+	PruneReconciledTransfers(ctx context.Context) error
+	ReconcileCheckpoint(ctx context.Context) (uint64, error)
+	SetReconcileCheckpoint(ctx context.Context, blockNum uint64) error
+}
+
+type Queue interface {
+	EnqueueFinalization(ctx context.Context, f *store.Finalization) error
+}
+
+type Gateway interface {
+	IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error)
+}
+
+// Chain is one side of the bridge. The store tracks the transfers initiated
+// on the chain and the scan checkpoint of the chain, the queue and the
+// gateway finalize the transfers initiated on the other chain.
+type Chain struct {
+	Name    string
+	Scanner Scanner
+	Store   Store
+	Queue   Queue
+	Gateway Gateway
+}
+
+// DirectionReport describes the transfers initiated on one chain and
+// finalized on the other one.
+type DirectionReport struct {
+	Direction string `json:"direction"`
+	// The transfers initiated and finalized in the blocks scanned by the
+	// last reconciliation.
+	InitiatedCount  int      `json:"initiated_count"`
+	InitiatedVolume *big.Int `json:"initiated_volume"`
+	FinalizedCount  int      `json:"finalized_count"`
+	FinalizedVolume *big.Int `json:"finalized_volume"`
+	// Unmatched are the transfers without a finalization, which are queued
+	// for finalization.
+	Unmatched       []*store.ReconciledTransfer `json:"unmatched"`
+	UnmatchedVolume *big.Int                    `json:"unmatched_volume"`
+	// AmountMismatches are the transfers finalized with a different amount
+	// than the initiated one.
+	AmountMismatches []*store.ReconciledTransfer `json:"amount_mismatches"`
+	MismatchVolume   *big.Int                    `json:"mismatch_volume"`
+	// UnexpectedFinalizations are the finalizations of transfers which were
+	// never initiated, seen since the relayer started. They are dropped once
+	// the transfer is initiated on the source gateway.
+	UnexpectedFinalizations []*gwcontract.TransferLog `json:"unexpected_finalizations"`
+	UnexpectedVolume        *big.Int                  `json:"unexpected_volume"`
+}
+
+type Report struct {
+	Time       time.Time          `json:"time"`
+	Directions []*DirectionReport `json:"directions"`
+}
+
+type direction struct {
+	name     string
+	src, dst *Chain
+}
+
+// Reconciler scans the transfer logs of both gateways to find the transfers
+// which were missed by the relayer, for example while it was stopped or its
+// subscription was down, and queues them for finalization.
+type Reconciler struct {
+	logger     *slog.Logger
+	chains     []*Chain
+	directions []*direction
+	interval   time.Duration
+	lookback   uint64
+	metrics    *metrics
+
+	mu         sync.Mutex
+	report     *Report
+	unexpected map[string][]*gwcontract.TransferLog
+}
+
+// New returns a reconciler running every interval. Without a checkpoint, the
+// scan of a chain starts lookback blocks before its head.
+func New(
+	logger *slog.Logger,
+	l1 *Chain,
+	settlement *Chain,
+	interval time.Duration,
+	lookback uint64,
+) *Reconciler {
+	return &Reconciler{
+		logger: logger,
+		chains: []*Chain{l1, settlement},
+		directions: []*direction{
+			{name: fmt.Sprintf("%s_to_%s", l1.Name, settlement.Name), src: l1, dst: settlement},
+			{name: fmt.Sprintf("%s_to_%s", settlement.Name, l1.Name), src: settlement, dst: l1},
+		},
+		interval:   interval,
+		lookback:   lookback,
+		metrics:    newMetrics(),
+		unexpected: make(map[string][]*gwcontract.TransferLog),
+	}
+}
+
+func (r *Reconciler) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		r.metrics.runs,
+		r.metrics.failedRuns,
+		r.metrics.unmatchedTransfers,
+		r.metrics.amountMismatches,
+		r.metrics.unexpectedFinalizations,
+	}
+}
+
+func (r *Reconciler) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			if _, err := r.Reconcile(ctx); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				r.logger.Error("reconciliation failed", "error", err)
+				r.metrics.failedRuns.Inc()
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return done
+}
+
+// Report returns the report of the last reconciliation, nil if none
+// completed yet.
+func (r *Reconciler) Report() *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report
+}
+
+// Handler serves the report of the last reconciliation.
+func (r *Reconciler) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := r.Report()
+		if report == nil {
+			http.Error(w, "no reconciliation completed yet", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			r.logger.Error("failed to encode reconciliation report", "error", err)
+		}
+	})
+}
+
+type scanRange struct {
+	from, to uint64
+}
+
+// Reconcile scans the blocks of both chains since their checkpoints and
+// matches the initiated transfers with their finalizations on the other
+// chain by the transfer index. The scan is idempotent, so the checkpoints
+// are only moved once all the logs are recorded.
+func (r *Reconciler) Reconcile(ctx context.Context) (*Report, error) {
+	r.metrics.runs.Inc()
+
+	ranges := make(map[*Chain]*scanRange)
+	for _, c := range r.chains {
+		rng, err := r.scanRange(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		if rng != nil {
+			ranges[c] = rng
+		}
+	}
+
+	reports := make(map[*Chain]*DirectionReport)
+	for _, d := range r.directions {
+		reports[d.src] = &DirectionReport{
+			Direction:        d.name,
+			InitiatedVolume:  new(big.Int),
+			FinalizedVolume:  new(big.Int),
+			UnmatchedVolume:  new(big.Int),
+			MismatchVolume:   new(big.Int),
+			UnexpectedVolume: new(big.Int),
+		}
+	}
+
+	// All the initiated transfers are recorded before the finalizations, so
+	// that the finalizations on the other chain find them.
+	for _, d := range r.directions {
+		rng, found := ranges[d.src]
+		if !found {
+			continue
+		}
+		transfers, err := d.src.Scanner.TransfersInitiated(ctx, rng.from, rng.to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s initiated transfers: %w", d.src.Name, err)
+		}
+		rep := reports[d.src]
+		for _, t := range transfers {
+			err := d.src.Store.RecordTransferInitiated(ctx, &store.ReconciledTransfer{
+				TransferIdx:     t.TransferIdx,
+				Amount:          t.Amount,
+				Recipient:       t.Recipient,
+				FinalizationFee: t.FinalizationFee,
+				InitiatedBlock:  t.BlockNumber,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to record %s initiated transfer: %w", d.src.Name, err)
+			}
+			rep.InitiatedCount++
+			rep.InitiatedVolume.Add(rep.InitiatedVolume, t.Amount)
+		}
+	}
+
+	for _, d := range r.directions {
+		rng, found := ranges[d.dst]
+		if !found {
+			continue
+		}
+		if err := r.matchFinalized(ctx, d, rng, reports[d.src]); err != nil {
+			return nil, err
+		}
+	}
+
+	for c, rng := range ranges {
+		if err := c.Store.SetReconcileCheckpoint(ctx, rng.to); err != nil {
+			return nil, fmt.Errorf("failed to set %s reconcile checkpoint: %w", c.Name, err)
+		}
+	}
+
+	report := &Report{Time: time.Now()}
+	for _, d := range r.directions {
+		rep := reports[d.src]
+		if err := r.pruneUnexpected(ctx, d); err != nil {
+			return nil, err
+		}
+		if err := r.reconcileTracked(ctx, d, rep); err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		rep.UnexpectedFinalizations = append(rep.UnexpectedFinalizations, r.unexpected[d.name]...)
+		r.mu.Unlock()
+		for _, t := range rep.UnexpectedFinalizations {
+			rep.UnexpectedVolume.Add(rep.UnexpectedVolume, t.Amount)
+		}
+
+		r.metrics.unmatchedTransfers.WithLabelValues(d.name).Set(float64(len(rep.Unmatched)))
+		r.metrics.amountMismatches.WithLabelValues(d.name).Set(float64(len(rep.AmountMismatches)))
+		report.Directions = append(report.Directions, rep)
+		r.logger.Info(
+			"reconciliation completed",
+			"direction", d.name,
+			"initiated", rep.InitiatedCount,
+			"finalized", rep.FinalizedCount,
+			"unmatched", len(rep.Unmatched),
+			"amountMismatches", len(rep.AmountMismatches),
+			"unexpectedFinalizations", len(rep.UnexpectedFinalizations),
+		)
+	}
+
+	r.mu.Lock()
+	r.report = report
+	r.mu.Unlock()
+	return report, nil
+}
+
+func (r *Reconciler) scanRange(ctx context.Context, c *Chain) (*scanRange, error) {
+	head, err := c.Scanner.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s block number: %w", c.Name, err)
+	}
+	checkpoint, err := c.Store.ReconcileCheckpoint(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s reconcile checkpoint: %w", c.Name, err)
+	}
+
+	from := checkpoint + 1
+	if checkpoint == 0 && head > r.lookback {
+		from = head - r.lookback
+	}
+	if from > head {
+		return nil, nil
+	}
+	return &scanRange{from: from, to: head}, nil
+}
+
+// matchFinalized records the finalizations on the destination chain of the
+// direction. A finalization without a tracked transfer was either initiated
+// before the scanned blocks or never initiated at all, which the index of the
+// next transfer on the source gateway tells apart.
+func (r *Reconciler) matchFinalized(
+	ctx context.Context,
+	d *direction,
+	rng *scanRange,
+	rep *DirectionReport,
+) error {
+	finalized, err := d.dst.Scanner.TransfersFinalized(ctx, rng.from, rng.to)
+	if err != nil {
+		return fmt.Errorf("failed to scan %s finalized transfers: %w", d.dst.Name, err)
+	}
+
+	var nextIdx *big.Int
+	for _, t := range finalized {
+		rep.FinalizedCount++
+		rep.FinalizedVolume.Add(rep.FinalizedVolume, t.Amount)
+
+		found, err := d.src.Store.RecordTransferFinalized(ctx, t.TransferIdx, t.Amount)
+		if err != nil {
+			return fmt.Errorf("failed to record %s finalized transfer: %w", d.dst.Name, err)
+		}
+		if found {
+			continue
+		}
+
+		if nextIdx == nil {
+			if nextIdx, err = d.src.Scanner.TransferInitiatedIdx(ctx); err != nil {
+				return fmt.Errorf("failed to get %s initiated transfer index: %w", d.src.Name, err)
+			}
+		}
+		if t.TransferIdx.Cmp(nextIdx) < 0 {
+			continue
+		}
+
+		r.logger.Error(
+			"finalization of a transfer which was never initiated",
+			"direction", d.name,
+			"transferIdx", t.TransferIdx,
+			"recipient", t.Recipient,
+			"amount", t.Amount,
+			"block", t.BlockNumber,
+		)
+		r.metrics.unexpectedFinalizations.WithLabelValues(d.name).Inc()
+		r.mu.Lock()
+		r.unexpected[d.name] = append(r.unexpected[d.name], t)
+		r.mu.Unlock()
+	}
+	return nil
+}
+
+// pruneUnexpected drops the unexpected finalizations of the direction whose
+// transfers were initiated on the source gateway since, for example after a
+// reorg of the source chain, and records them as finalized.
+func (r *Reconciler) pruneUnexpected(ctx context.Context, d *direction) error {
+	r.mu.Lock()
+	unexpected := r.unexpected[d.name]
+	r.mu.Unlock()
+	if len(unexpected) == 0 {
+		return nil
+	}
+
+	nextIdx, err := d.src.Scanner.TransferInitiatedIdx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get %s initiated transfer index: %w", d.src.Name, err)
+	}
+
+	remaining := make([]*gwcontract.TransferLog, 0, len(unexpected))
+	for _, t := range unexpected {
+		if t.TransferIdx.Cmp(nextIdx) >= 0 {
+			remaining = append(remaining, t)
+			continue
+		}
+		if _, err := d.src.Store.RecordTransferFinalized(ctx, t.TransferIdx, t.Amount); err != nil {
+			return fmt.Errorf("failed to record %s finalized transfer: %w", d.dst.Name, err)
+		}
+		r.logger.Info(
+			"unexpected finalization matched an initiated transfer",
+			"direction", d.name,
+			"transferIdx", t.TransferIdx,
+		)
+	}
+
+	r.mu.Lock()
+	if len(remaining) == 0 {
+		delete(r.unexpected, d.name)
+	} else {
+		r.unexpected[d.name] = remaining
+	}
+	r.mu.Unlock()
+	return nil
+}
+
+// reconcileTracked queues the tracked transfers which are not finalized and
+// reports the ones finalized with a different amount. The transfers found
+// finalized on the destination gateway are matched even if their
+// finalization was not scanned.
+func (r *Reconciler) reconcileTracked(ctx context.Context, d *direction, rep *DirectionReport) error {
+	tracked, err := d.src.Store.UnreconciledTransfers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get %s unreconciled transfers: %w", d.name, err)
+	}
+
+	for _, t := range tracked {
+		if t.Finalized {
+			rep.AmountMismatches = append(rep.AmountMismatches, t)
+			diff := new(big.Int).Sub(t.Amount, t.FinalizedAmount)
+			rep.MismatchVolume.Add(rep.MismatchVolume, diff.Abs(diff))
+			r.logger.Error(
+				"transfer finalized with a different amount",
+				"direction", d.name,
+				"transferIdx", t.TransferIdx,
+				"amount", t.Amount,
+				"finalizedAmount", t.FinalizedAmount,
+			)
+			continue
+		}
+
+		settled, err := d.dst.Gateway.IsSettled(ctx, t.TransferIdx)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s transfer is settled: %w", d.name, err)
+		}
+		if settled {
+			if _, err := d.src.Store.RecordTransferFinalized(ctx, t.TransferIdx, nil); err != nil {
+				return fmt.Errorf("failed to record %s finalized transfer: %w", d.name, err)
+			}
+			continue
+		}
+
+		err = d.dst.Queue.EnqueueFinalization(ctx, &store.Finalization{
+			TransferIdx:     t.TransferIdx,
+			Amount:          t.Amount,
+			Recipient:       t.Recipient,
+			FinalizationFee: t.FinalizationFee,
+			NextAttempt:     time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to queue %s finalization: %w", d.name, err)
+		}
+		rep.Unmatched = append(rep.Unmatched, t)
+		rep.UnmatchedVolume.Add(rep.UnmatchedVolume, t.Amount)
+	}
+
+	if err := d.src.Store.PruneReconciledTransfers(ctx); err != nil {
+		return fmt.Errorf("failed to prune %s reconciled transfers: %w", d.name, err)
+	}
+	return nil
+}
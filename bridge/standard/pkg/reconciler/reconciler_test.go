@@ -0,0 +1,313 @@
+package reconciler_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/bridge/standard/pkg/gwcontract"
+	"github.com/primev/mev-commit/bridge/standard/pkg/reconciler"
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
+	"github.com/primev/mev-commit/x/util"
+)
+
+type testScanner struct {
+	mu        sync.Mutex // mu guards head, nextIdx and the logs.
+	head      uint64
+	nextIdx   int64
+	initiated []*gwcontract.TransferLog
+	finalized []*gwcontract.TransferLog
+}
+
+func (s *testScanner) BlockNumber(_ context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.head, nil
+}
+
+func (s *testScanner) TransferInitiatedIdx(_ context.Context) (*big.Int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return big.NewInt(s.nextIdx), nil
+}
+
+func (s *testScanner) TransfersInitiated(_ context.Context, from, to uint64) ([]*gwcontract.TransferLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return inRange(s.initiated, from, to), nil
+}
+
+func (s *testScanner) initiate(l *gwcontract.TransferLog, nextIdx int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.initiated = append(s.initiated, l)
+	s.nextIdx = nextIdx
+	s.head = l.BlockNumber
+}
+
+func (s *testScanner) TransfersFinalized(_ context.Context, from, to uint64) ([]*gwcontract.TransferLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return inRange(s.finalized, from, to), nil
+}
+
+func (s *testScanner) finalize(l *gwcontract.TransferLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finalized = append(s.finalized, l)
+	s.head = l.BlockNumber
+}
+
+func inRange(logs []*gwcontract.TransferLog, from, to uint64) []*gwcontract.TransferLog {
+	var res []*gwcontract.TransferLog
+	for _, l := range logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+type testStore struct {
+	transfers  map[uint64]*store.ReconciledTransfer
+	checkpoint uint64
+}
+
+func newTestStore() *testStore {
+	return &testStore{transfers: make(map[uint64]*store.ReconciledTransfer)}
+}
+
+func (s *testStore) RecordTransferInitiated(_ context.Context, t *store.ReconciledTransfer) error {
+	if _, found := s.transfers[t.TransferIdx.Uint64()]; !found {
+		s.transfers[t.TransferIdx.Uint64()] = t
+	}
+	return nil
+}
+
+func (s *testStore) RecordTransferFinalized(_ context.Context, transferIdx, amount *big.Int) (bool, error) {
+	t, found := s.transfers[transferIdx.Uint64()]
+	if !found {
+		return false, nil
+	}
+	t.Finalized = true
+	t.FinalizedAmount = amount
+	return true, nil
+}
+
+func (s *testStore) UnreconciledTransfers(_ context.Context) ([]*store.ReconciledTransfer, error) {
+	var res []*store.ReconciledTransfer
+	for _, t := range s.transfers {
+		if !t.Finalized || (t.FinalizedAmount != nil && t.FinalizedAmount.Cmp(t.Amount) != 0) {
+			res = append(res, t)
+		}
+	}
+	slices.SortFunc(res, func(a, b *store.ReconciledTransfer) int {
+		return a.TransferIdx.Cmp(b.TransferIdx)
+	})
+	return res, nil
+}
+
+func (s *testStore) PruneReconciledTransfers(_ context.Context) error {
+	for idx, t := range s.transfers {
+		if t.Finalized && (t.FinalizedAmount == nil || t.FinalizedAmount.Cmp(t.Amount) == 0) {
+			delete(s.transfers, idx)
+		}
+	}
+	return nil
+}
+
+func (s *testStore) ReconcileCheckpoint(_ context.Context) (uint64, error) {
+	return s.checkpoint, nil
+}
+
+func (s *testStore) SetReconcileCheckpoint(_ context.Context, blockNum uint64) error {
+	s.checkpoint = blockNum
+	return nil
+}
+
+type testQueue struct {
+	queued map[uint64]*store.Finalization
+}
+
+func (q *testQueue) EnqueueFinalization(_ context.Context, f *store.Finalization) error {
+	if _, found := q.queued[f.TransferIdx.Uint64()]; !found {
+		q.queued[f.TransferIdx.Uint64()] = f
+	}
+	return nil
+}
+
+type testGateway struct {
+	settled map[uint64]bool
+}
+
+func (g *testGateway) IsSettled(_ context.Context, transferIdx *big.Int) (bool, error) {
+	return g.settled[transferIdx.Uint64()], nil
+}
+
+func transferLog(idx, amount int64, block uint64) *gwcontract.TransferLog {
+	return &gwcontract.TransferLog{
+		TransferIdx:     big.NewInt(idx),
+		Recipient:       common.BigToAddress(big.NewInt(idx)),
+		Amount:          big.NewInt(amount),
+		FinalizationFee: big.NewInt(1),
+		BlockNumber:     block,
+	}
+}
+
+func TestReconciler(t *testing.T) {
+	t.Parallel()
+
+	l1Scanner := &testScanner{
+		head:    100,
+		nextIdx: 4,
+		initiated: []*gwcontract.TransferLog{
+			transferLog(1, 100, 10),
+			transferLog(2, 200, 20),
+			transferLog(3, 300, 30),
+		},
+		finalized: []*gwcontract.TransferLog{
+			transferLog(1, 50, 40),
+			// The settlement gateway never initiated the transfer.
+			transferLog(7, 70, 50),
+		},
+	}
+	settlementScanner := &testScanner{
+		head:    200,
+		nextIdx: 3,
+		initiated: []*gwcontract.TransferLog{
+			transferLog(1, 50, 110),
+			transferLog(2, 60, 120),
+		},
+		finalized: []*gwcontract.TransferLog{
+			transferLog(1, 100, 130),
+			transferLog(2, 150, 140),
+		},
+	}
+
+	l1Store, settlementStore := newTestStore(), newTestStore()
+	l1Queue := &testQueue{queued: make(map[uint64]*store.Finalization)}
+	settlementQueue := &testQueue{queued: make(map[uint64]*store.Finalization)}
+
+	r := reconciler.New(
+		util.NewTestLogger(os.Stdout),
+		&reconciler.Chain{
+			Name:    "l1",
+			Scanner: l1Scanner,
+			Store:   l1Store,
+			Queue:   l1Queue,
+			// The finalization of the settlement transfer 2 was missed by the
+			// scan, but the gateway already finalized it.
+			Gateway: &testGateway{settled: map[uint64]bool{1: true, 2: true}},
+		},
+		&reconciler.Chain{
+			Name:    "settlement",
+			Scanner: settlementScanner,
+			Store:   settlementStore,
+			Queue:   settlementQueue,
+			Gateway: &testGateway{settled: map[uint64]bool{1: true, 2: true}},
+		},
+		1,
+		1000,
+	)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/reconciliation", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d before the first run, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	report, err := r.Reconcile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Directions) != 2 {
+		t.Fatalf("expected 2 directions, got %d", len(report.Directions))
+	}
+
+	toSettlement := report.Directions[0]
+	if toSettlement.Direction != "l1_to_settlement" ||
+		toSettlement.InitiatedCount != 3 ||
+		toSettlement.InitiatedVolume.Int64() != 600 ||
+		toSettlement.FinalizedCount != 2 ||
+		toSettlement.FinalizedVolume.Int64() != 250 {
+		t.Fatalf("unexpected l1 to settlement report %+v", toSettlement)
+	}
+	if len(toSettlement.Unmatched) != 1 ||
+		toSettlement.Unmatched[0].TransferIdx.Int64() != 3 ||
+		toSettlement.UnmatchedVolume.Int64() != 300 {
+		t.Fatalf("unexpected unmatched transfers %+v", toSettlement.Unmatched)
+	}
+	if len(toSettlement.AmountMismatches) != 1 ||
+		toSettlement.AmountMismatches[0].TransferIdx.Int64() != 2 ||
+		toSettlement.MismatchVolume.Int64() != 50 {
+		t.Fatalf("unexpected amount mismatches %+v", toSettlement.AmountMismatches)
+	}
+	if f, found := settlementQueue.queued[3]; !found || f.Amount.Int64() != 300 || len(settlementQueue.queued) != 1 {
+		t.Fatalf("expected transfer 3 queued for finalization, got %v", settlementQueue.queued)
+	}
+
+	toL1 := report.Directions[1]
+	if toL1.Direction != "settlement_to_l1" ||
+		toL1.InitiatedCount != 2 ||
+		toL1.InitiatedVolume.Int64() != 110 ||
+		len(toL1.Unmatched) != 0 ||
+		len(toL1.AmountMismatches) != 0 {
+		t.Fatalf("unexpected settlement to l1 report %+v", toL1)
+	}
+	if len(toL1.UnexpectedFinalizations) != 1 ||
+		toL1.UnexpectedFinalizations[0].TransferIdx.Int64() != 7 ||
+		toL1.UnexpectedVolume.Int64() != 70 {
+		t.Fatalf("unexpected finalizations %+v", toL1.UnexpectedFinalizations)
+	}
+	if len(l1Queue.queued) != 0 {
+		t.Fatalf("expected no l1 finalizations queued, got %v", l1Queue.queued)
+	}
+	if l1Store.checkpoint != 100 || settlementStore.checkpoint != 200 {
+		t.Fatalf("unexpected checkpoints %d, %d", l1Store.checkpoint, settlementStore.checkpoint)
+	}
+
+	// The next run only scans the new blocks.
+	settlementScanner.finalize(transferLog(3, 300, 210))
+	report, err = r.Reconcile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	toSettlement = report.Directions[0]
+	if toSettlement.InitiatedCount != 0 ||
+		toSettlement.FinalizedCount != 1 ||
+		len(toSettlement.Unmatched) != 0 ||
+		len(toSettlement.AmountMismatches) != 1 {
+		t.Fatalf("unexpected l1 to settlement report %+v", toSettlement)
+	}
+	if len(report.Directions[1].UnexpectedFinalizations) != 1 {
+		t.Fatalf("expected the unexpected finalization to be reported until restart")
+	}
+
+	if settlementStore.checkpoint != 210 {
+		t.Fatalf("expected settlement checkpoint 210, got %d", settlementStore.checkpoint)
+	}
+	// The unexpected finalization is dropped once the transfer is initiated.
+	settlementScanner.initiate(transferLog(7, 70, 220), 8)
+	report, err = r.Reconcile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Directions[1].UnexpectedFinalizations) != 0 {
+		t.Fatalf("expected no unexpected finalizations, got %+v", report.Directions[1].UnexpectedFinalizations)
+	}
+	if len(l1Queue.queued) != 0 {
+		t.Fatalf("expected no l1 finalizations queued, got %v", l1Queue.queued)
+	}
+
+	rec = httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/reconciliation", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
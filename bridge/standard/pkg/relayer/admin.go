@@ -0,0 +1,189 @@
+package relayer
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
+)
+
+// AdminHandler returns the handler of the admin endpoints used by operators
+// to inspect the finalization queues, to retry, abandon or approve stuck
+// transfers and to pause the finalizations. The gateway in the path is the
+// gateway finalizing the transfer, either "l1" or "settlement". The requests
+// are authenticated with the admin token as a bearer token, and the node
+// serves the handler on a loopback only listener.
+//
+// The gateways finalize the transfers strictly in the order of their indexes,
+// so an abandoned transfer blocks the queue: the later transfers fail to
+// finalize until the abandoned one is finalized out of band, after which the
+// relayer resumes with them.
+//
+//	GET  /admin/finalizations/{gateway}
+//	POST /admin/finalizations/{gateway}/{transferIdx}/retry
+//	POST /admin/finalizations/{gateway}/{transferIdx}/abandon
+//	POST /admin/finalizations/{gateway}/{transferIdx}/approve
+//	GET  /admin/limits/{gateway}
+//	POST /admin/limits/{gateway}/pause
+//	POST /admin/limits/{gateway}/resume
+func (r *Relayer) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/finalizations/{gateway}", func(w http.ResponseWriter, req *http.Request) {
+		f, ok := r.finalizerFromRequest(w, req)
+		if !ok {
+			return
+		}
+		finalizations, err := f.queue.Finalizations(req.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if finalizations == nil {
+			finalizations = []*store.Finalization{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(finalizations); err != nil {
+			r.logger.Error("failed to encode finalizations", "error", err)
+		}
+	})
+	mux.HandleFunc("POST /admin/finalizations/{gateway}/{transferIdx}/retry", func(w http.ResponseWriter, req *http.Request) {
+		r.setFinalizationStatus(w, req, store.FinalizationPending)
+	})
+	mux.HandleFunc("POST /admin/finalizations/{gateway}/{transferIdx}/abandon", func(w http.ResponseWriter, req *http.Request) {
+		r.setFinalizationStatus(w, req, store.FinalizationAbandoned)
+	})
+	mux.HandleFunc("POST /admin/finalizations/{gateway}/{transferIdx}/approve", func(w http.ResponseWriter, req *http.Request) {
+		r.setFinalizationStatus(w, req, store.FinalizationApproved)
+	})
+	mux.HandleFunc("GET /admin/limits/{gateway}", func(w http.ResponseWriter, req *http.Request) {
+		f, ok := r.finalizerFromRequest(w, req)
+		if !ok {
+			return
+		}
+		limits := f.limiter.limits
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(struct {
+			Direction         string   `json:"direction"`
+			Paused            bool     `json:"paused"`
+			MaxTransferAmount *big.Int `json:"max_transfer_amount"`
+			MaxWindowVolume   *big.Int `json:"max_window_volume"`
+			Window            string   `json:"window"`
+			WindowVolume      *big.Int `json:"window_volume"`
+		}{
+			Direction:         f.direction,
+			Paused:            f.limiter.paused.Load(),
+			MaxTransferAmount: limits.MaxTransferAmount,
+			MaxWindowVolume:   limits.MaxWindowVolume,
+			Window:            limits.Window.String(),
+			WindowVolume:      f.limiter.windowVolume(time.Now()),
+		})
+		if err != nil {
+			r.logger.Error("failed to encode limits", "error", err)
+		}
+	})
+	mux.HandleFunc("POST /admin/limits/{gateway}/pause", func(w http.ResponseWriter, req *http.Request) {
+		r.setPaused(w, req, true)
+	})
+	mux.HandleFunc("POST /admin/limits/{gateway}/resume", func(w http.ResponseWriter, req *http.Request) {
+		r.setPaused(w, req, false)
+	})
+	return r.authenticate(mux)
+}
+
+func (r *Relayer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if r.adminToken == "" {
+			http.Error(w, "admin API disabled, no admin token configured", http.StatusForbidden)
+			return
+		}
+		token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), []byte(r.adminToken)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (r *Relayer) finalizerFromRequest(w http.ResponseWriter, req *http.Request) (*finalizer, bool) {
+	switch req.PathValue("gateway") {
+	case r.l1Finalizer.name:
+		return r.l1Finalizer, true
+	case r.settlementFinalizer.name:
+		return r.settlementFinalizer, true
+	default:
+		http.Error(w, "unknown gateway", http.StatusNotFound)
+		return nil, false
+	}
+}
+
+// setFinalizationStatus moves a queued finalization to the status. A retried
+// finalization is attempted right away regardless of its backoff.
+func (r *Relayer) setFinalizationStatus(w http.ResponseWriter, req *http.Request, status store.FinalizationStatus) {
+	f, ok := r.finalizerFromRequest(w, req)
+	if !ok {
+		return
+	}
+	transferIdx, ok := new(big.Int).SetString(req.PathValue("transferIdx"), 10)
+	if !ok || transferIdx.Sign() < 0 {
+		http.Error(w, "invalid transfer index", http.StatusBadRequest)
+		return
+	}
+
+	err := f.queue.SetFinalizationStatus(req.Context(), transferIdx, status, time.Now())
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		http.Error(w, "transfer not queued", http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	r.logger.Info("finalization status changed by admin", "gateway", f.name, "transferIdx", transferIdx, "status", status)
+	if status == store.FinalizationAbandoned {
+		r.logger.Warn(
+			"abandoned transfer blocks the later finalizations until it is finalized out of band",
+			"gateway", f.name,
+			"transferIdx", transferIdx,
+		)
+		r.alert(req.Context(), fmt.Sprintf(
+			"Bridge relayer: %s transfer %s abandoned, the later transfers are blocked until it is finalized out of band",
+			f.direction, transferIdx,
+		))
+	} else {
+		f.wake()
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Relayer) setPaused(w http.ResponseWriter, req *http.Request, paused bool) {
+	f, ok := r.finalizerFromRequest(w, req)
+	if !ok {
+		return
+	}
+	changed, err := f.limiter.setPaused(req.Context(), paused)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !changed {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	r.logger.Warn("finalizations pause changed by admin", "direction", f.direction, "paused", paused)
+	if paused {
+		r.alert(req.Context(), fmt.Sprintf("Bridge relayer: %s finalizations paused", f.direction))
+	} else {
+		r.alert(req.Context(), fmt.Sprintf("Bridge relayer: %s finalizations resumed", f.direction))
+		f.wake()
+	}
+	w.WriteHeader(http.StatusOK)
+}
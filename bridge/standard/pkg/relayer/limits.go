@@ -0,0 +1,120 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
+)
+
+// Limits are the safety limits of the finalizations in one direction. They
+// bound the funds the relayer releases if the gateway of the other chain is
+// compromised. A nil amount is not limited.
+type Limits struct {
+	// MaxTransferAmount is the maximum amount of a single finalization.
+	MaxTransferAmount *big.Int
+	// MaxWindowVolume is the maximum volume finalized in the rolling
+	// window.
+	MaxWindowVolume *big.Int
+	Window          time.Duration
+	// Paused holds all the finalizations until they are resumed.
+	Paused bool
+}
+
+// LimiterStore persists the state of the limits of a direction, so that a
+// restart neither resumes paused finalizations nor resets the window volume.
+type LimiterStore interface {
+	FinalizationsPaused(ctx context.Context) (bool, error)
+	SetFinalizationsPaused(ctx context.Context, paused bool) error
+	RecordFinalizedVolume(ctx context.Context, amount *big.Int, at, windowStart time.Time) error
+	FinalizedVolumes(ctx context.Context, windowStart time.Time) ([]*store.FinalizedVolume, error)
+}
+
+// limiter enforces the limits of one direction.
+type limiter struct {
+	limits Limits
+	st     LimiterStore
+	paused atomic.Bool
+
+	mu        sync.Mutex
+	finalized []*store.FinalizedVolume
+}
+
+func newLimiter(limits Limits) *limiter {
+	return &limiter{limits: limits}
+}
+
+// load restores the pause and the volume finalized in the window from the
+// store. The finalizations are paused if either the limits or an operator
+// paused them.
+func (l *limiter) load(ctx context.Context, st LimiterStore, now time.Time) error {
+	paused, err := st.FinalizationsPaused(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pause: %w", err)
+	}
+	finalized, err := st.FinalizedVolumes(ctx, now.Add(-l.limits.Window))
+	if err != nil {
+		return fmt.Errorf("failed to get finalized volume: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.st = st
+	l.paused.Store(paused || l.limits.Paused)
+	l.finalized = finalized
+	return nil
+}
+
+// setPaused pauses or resumes the finalizations and returns whether the pause
+// changed.
+func (l *limiter) setPaused(ctx context.Context, paused bool) (bool, error) {
+	if err := l.st.SetFinalizationsPaused(ctx, paused); err != nil {
+		return false, err
+	}
+	return l.paused.Swap(paused) != paused, nil
+}
+
+// check returns the reason the finalization of the amount trips the limits,
+// empty if it is within them.
+func (l *limiter) check(amount *big.Int, now time.Time) string {
+	if limit := l.limits.MaxTransferAmount; limit != nil && amount.Cmp(limit) > 0 {
+		return fmt.Sprintf("amount %s exceeds the transfer limit %s", amount, limit)
+	}
+	if limit := l.limits.MaxWindowVolume; limit != nil {
+		volume := new(big.Int).Add(l.windowVolume(now), amount)
+		if volume.Cmp(limit) > 0 {
+			return fmt.Sprintf("volume %s exceeds the limit %s per %s", volume, limit, l.limits.Window)
+		}
+	}
+	return ""
+}
+
+func (l *limiter) record(ctx context.Context, amount *big.Int, now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.finalized = append(l.finalized, &store.FinalizedVolume{Time: now, Amount: amount})
+	return l.st.RecordFinalizedVolume(ctx, amount, now, now.Add(-l.limits.Window))
+}
+
+// windowVolume returns the volume finalized in the window before now.
+func (l *limiter) windowVolume(now time.Time) *big.Int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	start := now.Add(-l.limits.Window)
+	i := 0
+	for i < len(l.finalized) && !l.finalized[i].Time.After(start) {
+		i++
+	}
+	l.finalized = l.finalized[i:]
+
+	volume := new(big.Int)
+	for _, f := range l.finalized {
+		volume.Add(volume, f.Amount)
+	}
+	return volume
+}
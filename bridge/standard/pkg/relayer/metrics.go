@@ -6,6 +6,10 @@ type metrics struct {
 	initiatedTransfers  *prometheus.CounterVec
 	finalizedTransfers  *prometheus.CounterVec
 	failedFinalizations *prometheus.CounterVec
+
+	heldFinalizations *prometheus.CounterVec
+
+	pendingFinalizations *prometheus.GaugeVec
 }
 
 func newMetrics() *metrics {
@@ -25,5 +29,15 @@ func newMetrics() *metrics {
 			Name:      "failed_finalizations",
 			Help:      "Number of failed finalizations",
 		}, []string{"gateway"}),
+		heldFinalizations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "bridge_relayer",
+			Name:      "held_finalizations",
+			Help:      "Number of finalizations held for approval by the safety limits",
+		}, []string{"gateway"}),
+		pendingFinalizations: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "bridge_relayer",
+			Name:      "pending_finalizations",
+			Help:      "Number of finalizations waiting in the retry queue",
+		}, []string{"gateway"}),
 	}
 }
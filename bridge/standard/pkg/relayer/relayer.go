@@ -2,44 +2,168 @@ package relayer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
 	l1gateway "github.com/primev/mev-commit/contracts-abi/clients/L1Gateway"
 	settlementgateway "github.com/primev/mev-commit/contracts-abi/clients/SettlementGateway"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 )
 
+const (
+	defaultRetryBackoff    = 10 * time.Second
+	defaultMaxRetryBackoff = 10 * time.Minute
+)
+
 type L1Gateway interface {
 	Subscribe(ctx context.Context) (<-chan *l1gateway.L1gatewayTransferInitiated, <-chan error)
 	FinalizeTransfer(ctx context.Context, recipient common.Address, amount *big.Int, transferIdx *big.Int, finalizationFee *big.Int) error
+	IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error)
 }
 
 type SettlementGateway interface {
 	Subscribe(ctx context.Context) (<-chan *settlementgateway.SettlementgatewayTransferInitiated, <-chan error)
 	FinalizeTransfer(ctx context.Context, recipient common.Address, amount *big.Int, transferIdx *big.Int, finalizationFee *big.Int) error
+	IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error)
+}
+
+// FinalizationQueue persists the finalizations of a gateway until they
+// succeed, so that they survive failures and restarts of the relayer, along
+// with the state of the limits of the finalizations.
+type FinalizationQueue interface {
+	LimiterStore
+
+	EnqueueFinalization(ctx context.Context, f *store.Finalization) error
+	PendingFinalizations(ctx context.Context) ([]*store.Finalization, error)
+	Finalizations(ctx context.Context) ([]*store.Finalization, error)
+	RecordFinalizationFailure(ctx context.Context, transferIdx *big.Int, reason string, nextAttempt time.Time) error
+	SetFinalizationStatus(ctx context.Context, transferIdx *big.Int, status store.FinalizationStatus, nextAttempt time.Time) error
+	HoldFinalization(ctx context.Context, transferIdx *big.Int, reason string) error
+	RemoveFinalization(ctx context.Context, transferIdx *big.Int) error
+}
+
+// Alerter notifies the operators of the finalizations held for approval.
+type Alerter interface {
+	Notify(ctx context.Context, text string) error
+}
+
+type Option func(*Relayer)
+
+// WithRetryBackoff sets the delay before the first retry of a failed
+// finalization. The delay doubles with every attempt up to the maximum.
+func WithRetryBackoff(initial, maximum time.Duration) Option {
+	return func(r *Relayer) {
+		r.retryBackoff = initial
+		r.maxRetryBackoff = maximum
+	}
+}
+
+// WithLimits sets the safety limits of the finalizations in each direction.
+// The finalizations tripping a limit are held until an operator approves
+// them through the admin API.
+func WithLimits(l1ToSettlement, settlementToL1 Limits) Option {
+	return func(r *Relayer) {
+		r.settlementFinalizer.limiter = newLimiter(l1ToSettlement)
+		r.l1Finalizer.limiter = newLimiter(settlementToL1)
+	}
+}
+
+// WithAlerter sets the alerter notified when a finalization is held or a
+// direction is paused.
+func WithAlerter(a Alerter) Option {
+	return func(r *Relayer) {
+		r.alerter = a
+	}
+}
+
+// WithAdminToken sets the bearer token of the admin API. The admin API is
+// disabled without a token.
+func WithAdminToken(token string) Option {
+	return func(r *Relayer) {
+		r.adminToken = token
+	}
+}
+
+// finalizer finalizes the transfers on one of the gateways.
+type finalizer struct {
+	name      string
+	direction string
+	gateway   interface {
+		FinalizeTransfer(ctx context.Context, recipient common.Address, amount *big.Int, transferIdx *big.Int, finalizationFee *big.Int) error
+		IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error)
+	}
+	queue   FinalizationQueue
+	limiter *limiter
+	notify  chan struct{}
+}
+
+func (f *finalizer) wake() {
+	select {
+	case f.notify <- struct{}{}:
+	default:
+	}
 }
 
 type Relayer struct {
-	logger            *slog.Logger
-	l1Gateway         L1Gateway
-	settlementGateway SettlementGateway
-	metrics           *metrics
+	logger              *slog.Logger
+	l1Gateway           L1Gateway
+	settlementGateway   SettlementGateway
+	l1Finalizer         *finalizer
+	settlementFinalizer *finalizer
+	retryBackoff        time.Duration
+	maxRetryBackoff     time.Duration
+	alerter             Alerter
+	adminToken          string
+	metrics             *metrics
 }
 
 func NewRelayer(
 	logger *slog.Logger,
 	l1Gateway L1Gateway,
 	settlementGateway SettlementGateway,
-) *Relayer {
-	return &Relayer{
+	l1Queue FinalizationQueue,
+	settlementQueue FinalizationQueue,
+	opts ...Option,
+) (*Relayer, error) {
+	r := &Relayer{
 		logger:            logger,
 		l1Gateway:         l1Gateway,
 		settlementGateway: settlementGateway,
-		metrics:           newMetrics(),
+		l1Finalizer: &finalizer{
+			name:      "l1",
+			direction: "settlement_to_l1",
+			gateway:   l1Gateway,
+			queue:     l1Queue,
+			limiter:   newLimiter(Limits{}),
+			notify:    make(chan struct{}, 1),
+		},
+		settlementFinalizer: &finalizer{
+			name:      "settlement",
+			direction: "l1_to_settlement",
+			gateway:   settlementGateway,
+			queue:     settlementQueue,
+			limiter:   newLimiter(Limits{}),
+			notify:    make(chan struct{}, 1),
+		},
+		retryBackoff:    defaultRetryBackoff,
+		maxRetryBackoff: defaultMaxRetryBackoff,
+		metrics:         newMetrics(),
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	for _, f := range []*finalizer{r.l1Finalizer, r.settlementFinalizer} {
+		if err := f.limiter.load(context.Background(), f.queue, time.Now()); err != nil {
+			return nil, fmt.Errorf("failed to load %s limits: %w", f.direction, err)
+		}
+	}
+	return r, nil
 }
 
 func (r *Relayer) Metrics() []prometheus.Collector {
@@ -47,6 +171,8 @@ func (r *Relayer) Metrics() []prometheus.Collector {
 		r.metrics.initiatedTransfers,
 		r.metrics.finalizedTransfers,
 		r.metrics.failedFinalizations,
+		r.metrics.pendingFinalizations,
+		r.metrics.heldFinalizations,
 	}
 }
 
@@ -67,25 +193,12 @@ func (r *Relayer) Start(ctx context.Context) <-chan struct{} {
 				return err
 			case upd := <-l1Transfers:
 				r.metrics.initiatedTransfers.WithLabelValues("l1").Inc()
-				err := r.settlementGateway.FinalizeTransfer(
-					egCtx,
-					upd.Recipient,
-					upd.Amount,
-					upd.TransferIdx,
-					upd.CounterpartyFinalizationFee,
-				)
-				if err != nil {
-					r.logger.Error(
-						"error in settlement finalization",
-						"recipient", upd.Recipient,
-						"amount", upd.Amount,
-						"transferIdx", upd.TransferIdx,
-						"error", err,
-					)
-					r.metrics.failedFinalizations.WithLabelValues("settlement").Inc()
-					continue
-				}
-				r.metrics.finalizedTransfers.WithLabelValues("settlement").Inc()
+				r.enqueue(egCtx, r.settlementFinalizer, &store.Finalization{
+					TransferIdx:     upd.TransferIdx,
+					Amount:          upd.Amount,
+					Recipient:       upd.Recipient,
+					FinalizationFee: upd.CounterpartyFinalizationFee,
+				})
 			}
 		}
 	})
@@ -100,29 +213,32 @@ func (r *Relayer) Start(ctx context.Context) <-chan struct{} {
 				return err
 			case upd := <-settlementTransfers:
 				r.metrics.initiatedTransfers.WithLabelValues("settlement").Inc()
-				err := r.l1Gateway.FinalizeTransfer(
-					egCtx,
-					upd.Recipient,
-					upd.Amount,
-					upd.TransferIdx,
-					upd.CounterpartyFinalizationFee,
-				)
-				if err != nil {
-					r.logger.Error(
-						"error in l1 finalization",
-						"recipient", upd.Recipient,
-						"amount", upd.Amount,
-						"transferIdx", upd.TransferIdx,
-						"error", err,
-					)
-					r.metrics.failedFinalizations.WithLabelValues("l1").Inc()
-					continue
-				}
-				r.metrics.finalizedTransfers.WithLabelValues("l1").Inc()
+				r.enqueue(egCtx, r.l1Finalizer, &store.Finalization{
+					TransferIdx:     upd.TransferIdx,
+					Amount:          upd.Amount,
+					Recipient:       upd.Recipient,
+					FinalizationFee: upd.CounterpartyFinalizationFee,
+				})
 			}
 		}
 	})
 
+	for _, f := range []*finalizer{r.l1Finalizer, r.settlementFinalizer} {
+		eg.Go(func() error {
+			ticker := time.NewTicker(r.retryBackoff)
+			defer ticker.Stop()
+			for {
+				r.processQueue(egCtx, f)
+				select {
+				case <-egCtx.Done():
+					return nil
+				case <-f.notify:
+				case <-ticker.C:
+				}
+			}
+		})
+	}
+
 	go func() {
 		defer close(done)
 		if err := eg.Wait(); err != nil {
@@ -132,3 +248,139 @@ func (r *Relayer) Start(ctx context.Context) <-chan struct{} {
 
 	return done
 }
+
+// enqueue persists the finalization before it is attempted, so that it is
+// retried if the attempt fails or the relayer stops before it is confirmed.
+func (r *Relayer) enqueue(ctx context.Context, f *finalizer, fin *store.Finalization) {
+	fin.NextAttempt = time.Now()
+	if err := f.queue.EnqueueFinalization(ctx, fin); err != nil {
+		r.logger.Error(
+			"failed to queue finalization",
+			"gateway", f.name,
+			"recipient", fin.Recipient,
+			"amount", fin.Amount,
+			"transferIdx", fin.TransferIdx,
+			"error", err,
+		)
+		r.metrics.failedFinalizations.WithLabelValues(f.name).Inc()
+		return
+	}
+	f.wake()
+}
+
+// processQueue attempts the due finalizations of the gateway. The gateway
+// only accepts the transfers in the order of their index, so the processing
+// stops at the first finalization which is not due, is held or fails.
+func (r *Relayer) processQueue(ctx context.Context, f *finalizer) {
+	if f.limiter.paused.Load() {
+		return
+	}
+
+	pending, err := f.queue.PendingFinalizations(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.Error("failed to get pending finalizations", "gateway", f.name, "error", err)
+		}
+		return
+	}
+	r.metrics.pendingFinalizations.WithLabelValues(f.name).Set(float64(len(pending)))
+
+	for _, fin := range pending {
+		now := time.Now()
+		if fin.Status == store.FinalizationAwaitingApproval || fin.NextAttempt.After(now) {
+			return
+		}
+
+		switch settled, err := f.gateway.IsSettled(ctx, fin.TransferIdx); {
+		case err != nil:
+			r.logger.Error("failed to check if transfer is settled", "gateway", f.name, "transferIdx", fin.TransferIdx, "error", err)
+			return
+		case settled:
+			r.logger.Info("transfer already settled", "gateway", f.name, "transferIdx", fin.TransferIdx)
+			r.remove(ctx, f, fin)
+			continue
+		}
+
+		if fin.Status != store.FinalizationApproved {
+			if reason := f.limiter.check(fin.Amount, now); reason != "" {
+				r.hold(ctx, f, fin, reason)
+				return
+			}
+		}
+
+		err := f.gateway.FinalizeTransfer(ctx, fin.Recipient, fin.Amount, fin.TransferIdx, fin.FinalizationFee)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+				return
+			}
+			backoff := r.backoff(fin.Attempts + 1)
+			r.logger.Error(
+				"error in "+f.name+" finalization",
+				"recipient", fin.Recipient,
+				"amount", fin.Amount,
+				"transferIdx", fin.TransferIdx,
+				"attempts", fin.Attempts+1,
+				"retryIn", backoff,
+				"error", err,
+			)
+			r.metrics.failedFinalizations.WithLabelValues(f.name).Inc()
+			if err := f.queue.RecordFinalizationFailure(ctx, fin.TransferIdx, err.Error(), now.Add(backoff)); err != nil {
+				r.logger.Error("failed to record finalization failure", "gateway", f.name, "transferIdx", fin.TransferIdx, "error", err)
+			}
+			return
+		}
+		r.metrics.finalizedTransfers.WithLabelValues(f.name).Inc()
+		if err := f.limiter.record(ctx, fin.Amount, time.Now()); err != nil {
+			r.logger.Error("failed to record finalized volume", "gateway", f.name, "transferIdx", fin.TransferIdx, "error", err)
+		}
+		r.remove(ctx, f, fin)
+	}
+}
+
+// hold puts the finalization on hold until an operator approves it.
+func (r *Relayer) hold(ctx context.Context, f *finalizer, fin *store.Finalization, reason string) {
+	if err := f.queue.HoldFinalization(ctx, fin.TransferIdx, reason); err != nil {
+		r.logger.Error("failed to hold finalization", "gateway", f.name, "transferIdx", fin.TransferIdx, "error", err)
+		return
+	}
+	r.logger.Warn(
+		"finalization held for approval",
+		"direction", f.direction,
+		"recipient", fin.Recipient,
+		"amount", fin.Amount,
+		"transferIdx", fin.TransferIdx,
+		"reason", reason,
+	)
+	r.metrics.heldFinalizations.WithLabelValues(f.name).Inc()
+	r.alert(ctx, fmt.Sprintf(
+		"Bridge relayer: %s transfer %s of %s wei to %s held for approval, %s",
+		f.direction, fin.TransferIdx, fin.Amount, fin.Recipient.Hex(), reason,
+	))
+}
+
+func (r *Relayer) alert(ctx context.Context, text string) {
+	if r.alerter == nil {
+		return
+	}
+	if err := r.alerter.Notify(ctx, text); err != nil {
+		r.logger.Error("failed to send alert", "text", text, "error", err)
+	}
+}
+
+func (r *Relayer) remove(ctx context.Context, f *finalizer, fin *store.Finalization) {
+	if err := f.queue.RemoveFinalization(ctx, fin.TransferIdx); err != nil {
+		// The finalization is removed once it is found settled on the
+		// next attempt.
+		r.logger.Error("failed to remove finalization", "gateway", f.name, "transferIdx", fin.TransferIdx, "error", err)
+		return
+	}
+	r.metrics.pendingFinalizations.WithLabelValues(f.name).Dec()
+}
+
+func (r *Relayer) backoff(attempts int) time.Duration {
+	backoff := r.retryBackoff
+	for i := 1; i < attempts && backoff < r.maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.maxRetryBackoff)
+}
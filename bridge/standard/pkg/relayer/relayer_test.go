@@ -2,8 +2,13 @@ package relayer_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -11,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/go-cmp/cmp"
 	"github.com/primev/mev-commit/bridge/standard/pkg/relayer"
+	"github.com/primev/mev-commit/bridge/standard/pkg/store"
 	l1gateway "github.com/primev/mev-commit/contracts-abi/clients/L1Gateway"
 	settlementgateway "github.com/primev/mev-commit/contracts-abi/clients/SettlementGateway"
 	"github.com/primev/mev-commit/x/util"
@@ -26,8 +32,17 @@ type Transfer struct {
 type testL1Gateway struct {
 	initiated chan *l1gateway.L1gatewayTransferInitiated
 	err       chan error
-	mu        sync.Mutex // mu guards finalized.
+	mu        sync.Mutex // mu guards finalized and failures.
 	finalized []Transfer
+	failures  int
+}
+
+func (t *testL1Gateway) IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.ContainsFunc(t.finalized, func(tr Transfer) bool {
+		return tr.TransferIdx.Cmp(transferIdx) == 0
+	}), nil
 }
 
 func (t *testL1Gateway) Subscribe(ctx context.Context) (<-chan *l1gateway.L1gatewayTransferInitiated, <-chan error) {
@@ -36,6 +51,11 @@ func (t *testL1Gateway) Subscribe(ctx context.Context) (<-chan *l1gateway.L1gate
 
 func (t *testL1Gateway) FinalizeTransfer(ctx context.Context, recipient common.Address, amount *big.Int, transferIdx *big.Int, finalizationFee *big.Int) error {
 	t.mu.Lock()
+	if t.failures > 0 {
+		t.failures--
+		t.mu.Unlock()
+		return errors.New("finalization failed")
+	}
 	t.finalized = append(t.finalized, Transfer{
 		Recipient:       recipient,
 		Amount:          amount,
@@ -49,8 +69,17 @@ func (t *testL1Gateway) FinalizeTransfer(ctx context.Context, recipient common.A
 type testSettlementGateway struct {
 	initiated chan *settlementgateway.SettlementgatewayTransferInitiated
 	err       chan error
-	mu        sync.Mutex // mu guards finalized.
+	mu        sync.Mutex // mu guards finalized and failures.
 	finalized []Transfer
+	failures  int
+}
+
+func (t *testSettlementGateway) IsSettled(ctx context.Context, transferIdx *big.Int) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.ContainsFunc(t.finalized, func(tr Transfer) bool {
+		return tr.TransferIdx.Cmp(transferIdx) == 0
+	}), nil
 }
 
 func (t *testSettlementGateway) Subscribe(ctx context.Context) (<-chan *settlementgateway.SettlementgatewayTransferInitiated, <-chan error) {
@@ -59,6 +88,11 @@ func (t *testSettlementGateway) Subscribe(ctx context.Context) (<-chan *settleme
 
 func (t *testSettlementGateway) FinalizeTransfer(ctx context.Context, recipient common.Address, amount *big.Int, transferIdx *big.Int, finalizationFee *big.Int) error {
 	t.mu.Lock()
+	if t.failures > 0 {
+		t.failures--
+		t.mu.Unlock()
+		return errors.New("finalization failed")
+	}
 	t.finalized = append(t.finalized, Transfer{
 		Recipient:       recipient,
 		Amount:          amount,
@@ -69,6 +103,176 @@ func (t *testSettlementGateway) FinalizeTransfer(ctx context.Context, recipient
 	return nil
 }
 
+type testQueue struct {
+	mu            sync.Mutex
+	finalizations map[uint64]*store.Finalization
+	paused        bool
+	volumes       []*store.FinalizedVolume
+}
+
+func newTestQueue() *testQueue {
+	return &testQueue{finalizations: make(map[uint64]*store.Finalization)}
+}
+
+func (q *testQueue) EnqueueFinalization(_ context.Context, f *store.Finalization) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, found := q.finalizations[f.TransferIdx.Uint64()]; !found {
+		cp := *f
+		cp.Status = store.FinalizationPending
+		q.finalizations[f.TransferIdx.Uint64()] = &cp
+	}
+	return nil
+}
+
+func (q *testQueue) list(statuses ...store.FinalizationStatus) []*store.Finalization {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var list []*store.Finalization
+	for _, f := range q.finalizations {
+		if len(statuses) == 0 || slices.Contains(statuses, f.Status) {
+			cp := *f
+			list = append(list, &cp)
+		}
+	}
+	slices.SortFunc(list, func(a, b *store.Finalization) int {
+		return a.TransferIdx.Cmp(b.TransferIdx)
+	})
+	return list
+}
+
+func (q *testQueue) PendingFinalizations(_ context.Context) ([]*store.Finalization, error) {
+	return q.list(store.FinalizationPending, store.FinalizationAwaitingApproval, store.FinalizationApproved), nil
+}
+
+func (q *testQueue) Finalizations(_ context.Context) ([]*store.Finalization, error) {
+	return q.list(), nil
+}
+
+func (q *testQueue) RecordFinalizationFailure(_ context.Context, transferIdx *big.Int, reason string, nextAttempt time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f, found := q.finalizations[transferIdx.Uint64()]
+	if !found {
+		return store.ErrNotFound
+	}
+	f.Attempts++
+	f.LastError = reason
+	f.NextAttempt = nextAttempt
+	return nil
+}
+
+func (q *testQueue) SetFinalizationStatus(_ context.Context, transferIdx *big.Int, status store.FinalizationStatus, nextAttempt time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f, found := q.finalizations[transferIdx.Uint64()]
+	if !found {
+		return store.ErrNotFound
+	}
+	f.Status = status
+	f.NextAttempt = nextAttempt
+	return nil
+}
+
+func (q *testQueue) HoldFinalization(_ context.Context, transferIdx *big.Int, reason string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f, found := q.finalizations[transferIdx.Uint64()]
+	if !found {
+		return store.ErrNotFound
+	}
+	f.Status = store.FinalizationAwaitingApproval
+	f.LastError = reason
+	return nil
+}
+
+func (q *testQueue) RemoveFinalization(_ context.Context, transferIdx *big.Int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.finalizations, transferIdx.Uint64())
+	return nil
+}
+
+func (q *testQueue) FinalizationsPaused(_ context.Context) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.paused, nil
+}
+
+func (q *testQueue) SetFinalizationsPaused(_ context.Context, paused bool) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.paused = paused
+	return nil
+}
+
+func (q *testQueue) RecordFinalizedVolume(_ context.Context, amount *big.Int, at, windowStart time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.volumes = slices.DeleteFunc(q.volumes, func(v *store.FinalizedVolume) bool {
+		return !v.Time.After(windowStart)
+	})
+	q.volumes = append(q.volumes, &store.FinalizedVolume{Time: at, Amount: amount})
+	return nil
+}
+
+func (q *testQueue) FinalizedVolumes(_ context.Context, windowStart time.Time) ([]*store.FinalizedVolume, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var volumes []*store.FinalizedVolume
+	for _, v := range q.volumes {
+		if v.Time.After(windowStart) {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes, nil
+}
+
+type testAlerter struct {
+	mu     sync.Mutex
+	alerts []string
+}
+
+func (a *testAlerter) Notify(_ context.Context, text string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.alerts = append(a.alerts, text)
+	return nil
+}
+
+func (a *testAlerter) count() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.alerts)
+}
+
+const testAdminToken = "secret"
+
+func adminRequest(t *testing.T, method, url string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	start := time.Now()
+	for !cond() {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestRelayer(t *testing.T) {
 	l1Gateway := &testL1Gateway{
 		initiated: make(chan *l1gateway.L1gatewayTransferInitiated),
@@ -79,7 +283,16 @@ func TestRelayer(t *testing.T) {
 		err:       make(chan error),
 	}
 
-	relayer := relayer.NewRelayer(util.NewTestLogger(os.Stdout), l1Gateway, settlementGateway)
+	relayer, err := relayer.NewRelayer(
+		util.NewTestLogger(os.Stdout),
+		l1Gateway,
+		settlementGateway,
+		newTestQueue(),
+		newTestQueue(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	done := relayer.Start(ctx)
@@ -179,3 +392,278 @@ func TestRelayer(t *testing.T) {
 		t.Fatal("timeout waiting for relayer to finish")
 	}
 }
+
+func TestRelayerRetry(t *testing.T) {
+	l1Gateway := &testL1Gateway{
+		initiated: make(chan *l1gateway.L1gatewayTransferInitiated),
+		err:       make(chan error),
+	}
+	settlementGateway := &testSettlementGateway{
+		initiated: make(chan *settlementgateway.SettlementgatewayTransferInitiated),
+		err:       make(chan error),
+		failures:  2,
+	}
+	settlementQueue := newTestQueue()
+
+	r, err := relayer.NewRelayer(
+		util.NewTestLogger(os.Stdout),
+		l1Gateway,
+		settlementGateway,
+		newTestQueue(),
+		settlementQueue,
+		relayer.WithRetryBackoff(10*time.Millisecond, 20*time.Millisecond),
+		relayer.WithAdminToken(testAdminToken),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := r.Start(ctx)
+
+	for i := int64(1); i <= 2; i++ {
+		l1Gateway.initiated <- &l1gateway.L1gatewayTransferInitiated{
+			Recipient:                   common.HexToAddress("0x1234"),
+			Amount:                      big.NewInt(100 * i),
+			TransferIdx:                 big.NewInt(i),
+			CounterpartyFinalizationFee: big.NewInt(10),
+		}
+	}
+
+	// The transfers are finalized in order once the failures are over.
+	waitFor(t, func() bool {
+		settlementGateway.mu.Lock()
+		defer settlementGateway.mu.Unlock()
+		return len(settlementGateway.finalized) == 2
+	})
+	settlementGateway.mu.Lock()
+	if settlementGateway.finalized[0].TransferIdx.Int64() != 1 || settlementGateway.finalized[1].TransferIdx.Int64() != 2 {
+		t.Errorf("unexpected finalization order %v", settlementGateway.finalized)
+	}
+	settlementGateway.mu.Unlock()
+	waitFor(t, func() bool {
+		return len(settlementQueue.list()) == 0
+	})
+
+	// An abandoned transfer is not retried until an operator retries it.
+	settlementGateway.mu.Lock()
+	settlementGateway.failures = 1_000_000
+	settlementGateway.mu.Unlock()
+	l1Gateway.initiated <- &l1gateway.L1gatewayTransferInitiated{
+		Recipient:                   common.HexToAddress("0x1234"),
+		Amount:                      big.NewInt(300),
+		TransferIdx:                 big.NewInt(3),
+		CounterpartyFinalizationFee: big.NewInt(10),
+	}
+	waitFor(t, func() bool {
+		list := settlementQueue.list()
+		return len(list) == 1 && list[0].Attempts > 0
+	})
+
+	srv := httptest.NewServer(r.AdminHandler())
+	defer srv.Close()
+
+	resp := adminRequest(t, http.MethodPost, srv.URL+"/admin/finalizations/settlement/3/abandon")
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+
+	resp = adminRequest(t, http.MethodGet, srv.URL+"/admin/finalizations/settlement")
+	var listed []*store.Finalization
+	if err := json.NewDecoder(resp.Body).Decode(&listed); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if len(listed) != 1 || listed[0].Status != store.FinalizationAbandoned || listed[0].LastError == "" {
+		t.Fatalf("unexpected finalizations %+v", listed)
+	}
+
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/finalizations/settlement/4/retry")
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected not found for unknown transfer, got %d", resp.StatusCode)
+	}
+
+	settlementGateway.mu.Lock()
+	settlementGateway.failures = 0
+	settlementGateway.mu.Unlock()
+
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/finalizations/settlement/3/retry")
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+	waitFor(t, func() bool {
+		return len(settlementQueue.list()) == 0
+	})
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for relayer to finish")
+	}
+}
+
+func TestRelayerLimits(t *testing.T) {
+	l1Gateway := &testL1Gateway{
+		initiated: make(chan *l1gateway.L1gatewayTransferInitiated),
+		err:       make(chan error),
+	}
+	settlementGateway := &testSettlementGateway{
+		initiated: make(chan *settlementgateway.SettlementgatewayTransferInitiated),
+		err:       make(chan error),
+	}
+	settlementQueue := newTestQueue()
+	alerter := &testAlerter{}
+
+	r, err := relayer.NewRelayer(
+		util.NewTestLogger(os.Stdout),
+		l1Gateway,
+		settlementGateway,
+		newTestQueue(),
+		settlementQueue,
+		relayer.WithRetryBackoff(10*time.Millisecond, 20*time.Millisecond),
+		relayer.WithLimits(
+			relayer.Limits{
+				MaxTransferAmount: big.NewInt(500),
+				MaxWindowVolume:   big.NewInt(600),
+				Window:            time.Hour,
+			},
+			relayer.Limits{},
+		),
+		relayer.WithAlerter(alerter),
+		relayer.WithAdminToken(testAdminToken),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := r.Start(ctx)
+
+	srv := httptest.NewServer(r.AdminHandler())
+	defer srv.Close()
+
+	finalized := func() int {
+		settlementGateway.mu.Lock()
+		defer settlementGateway.mu.Unlock()
+		return len(settlementGateway.finalized)
+	}
+
+	// The second transfer trips the transfer limit and holds the next ones.
+	for i, amount := range []int64{300, 1000, 200} {
+		l1Gateway.initiated <- &l1gateway.L1gatewayTransferInitiated{
+			Recipient:                   common.HexToAddress("0x1234"),
+			Amount:                      big.NewInt(amount),
+			TransferIdx:                 big.NewInt(int64(i + 1)),
+			CounterpartyFinalizationFee: big.NewInt(10),
+		}
+	}
+	waitFor(t, func() bool {
+		return len(settlementQueue.list(store.FinalizationAwaitingApproval)) == 1
+	})
+	if finalized() != 1 || alerter.count() != 1 {
+		t.Fatalf("expected 1 finalization and 1 alert, got %d and %d", finalized(), alerter.count())
+	}
+
+	resp, err := http.Post(srv.URL+"/admin/finalizations/settlement/2/approve", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized, got %d", resp.StatusCode)
+	}
+
+	// The approved transfer trips the volume limit for the third one.
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/finalizations/settlement/2/approve")
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+	waitFor(t, func() bool {
+		held := settlementQueue.list(store.FinalizationAwaitingApproval)
+		return len(held) == 1 && held[0].TransferIdx.Int64() == 3
+	})
+	if finalized() != 2 || alerter.count() != 2 {
+		t.Fatalf("expected 2 finalizations and 2 alerts, got %d and %d", finalized(), alerter.count())
+	}
+
+	// Nothing is finalized while paused.
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/limits/settlement/pause")
+	_ = resp.Body.Close()
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/finalizations/settlement/3/approve")
+	_ = resp.Body.Close()
+	time.Sleep(100 * time.Millisecond)
+	if finalized() != 2 {
+		t.Fatalf("expected no finalization while paused, got %d", finalized())
+	}
+
+	resp = adminRequest(t, http.MethodGet, srv.URL+"/admin/limits/settlement")
+	var limits struct {
+		Paused       bool     `json:"paused"`
+		WindowVolume *big.Int `json:"window_volume"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&limits); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if !limits.Paused || limits.WindowVolume.Int64() != 1300 {
+		t.Fatalf("unexpected limits %+v", limits)
+	}
+
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/limits/settlement/resume")
+	_ = resp.Body.Close()
+	waitFor(t, func() bool {
+		return finalized() == 3
+	})
+	if alerter.count() != 4 {
+		t.Fatalf("expected 4 alerts, got %d", alerter.count())
+	}
+
+	resp = adminRequest(t, http.MethodPost, srv.URL+"/admin/limits/settlement/pause")
+	_ = resp.Body.Close()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for relayer to finish")
+	}
+
+	// The pause and the window volume survive a restart.
+	r, err = relayer.NewRelayer(
+		util.NewTestLogger(os.Stdout),
+		l1Gateway,
+		settlementGateway,
+		newTestQueue(),
+		settlementQueue,
+		relayer.WithLimits(
+			relayer.Limits{
+				MaxTransferAmount: big.NewInt(500),
+				MaxWindowVolume:   big.NewInt(600),
+				Window:            time.Hour,
+			},
+			relayer.Limits{},
+		),
+		relayer.WithAdminToken(testAdminToken),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted := httptest.NewServer(r.AdminHandler())
+	defer restarted.Close()
+
+	resp = adminRequest(t, http.MethodGet, restarted.URL+"/admin/limits/settlement")
+	if err := json.NewDecoder(resp.Body).Decode(&limits); err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if !limits.Paused || limits.WindowVolume.Int64() != 1500 {
+		t.Fatalf("unexpected limits after restart %+v", limits)
+	}
+}
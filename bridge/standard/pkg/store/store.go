@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	_ "github.com/lib/pq"
@@ -31,6 +32,35 @@ CREATE TABLE IF NOT EXISTS sent_transactions (
 	status TEXT
 );`
 
+var finalizationsTable = `
+CREATE TABLE IF NOT EXISTS %s_finalizations (
+	transfer_idx BIGINT PRIMARY KEY,
+	amount NUMERIC(24, 0),
+	recipient TEXT,
+	finalization_fee NUMERIC(24, 0),
+	status TEXT,
+	attempts INTEGER,
+	next_attempt TIMESTAMPTZ,
+	last_error TEXT
+);`
+
+var reconciliationTable = `
+CREATE TABLE IF NOT EXISTS %s_reconciliation (
+	transfer_idx BIGINT PRIMARY KEY,
+	amount NUMERIC(24, 0),
+	recipient TEXT,
+	finalization_fee NUMERIC(24, 0),
+	initiated_block BIGINT,
+	finalized BOOLEAN,
+	finalized_amount NUMERIC(24, 0)
+);`
+
+var finalizedVolumeTable = `
+CREATE TABLE IF NOT EXISTS %s_finalized_volume (
+	finalized_at TIMESTAMPTZ,
+	amount NUMERIC(24, 0)
+);`
+
 var integerTable = `
 CREATE TABLE IF NOT EXISTS integers (
 	key TEXT PRIMARY KEY,
@@ -39,14 +69,70 @@ CREATE TABLE IF NOT EXISTS integers (
 
 var ErrNotFound = fmt.Errorf("not found")
 
+type FinalizationStatus string
+
+const (
+	// FinalizationPending is a finalization which is retried until it
+	// succeeds.
+	FinalizationPending FinalizationStatus = "pending"
+	// FinalizationAbandoned is a finalization an operator gave up on. It is
+	// kept for reference and not retried. As the gateways finalize the
+	// transfers in order, the later transfers cannot be finalized until the
+	// abandoned one is finalized out of band.
+	FinalizationAbandoned FinalizationStatus = "abandoned"
+	// FinalizationAwaitingApproval is a finalization held by the safety
+	// limits of the relayer until an operator approves it.
+	FinalizationAwaitingApproval FinalizationStatus = "awaiting_approval"
+	// FinalizationApproved is a finalization approved by an operator, which
+	// is retried regardless of the safety limits.
+	FinalizationApproved FinalizationStatus = "approved"
+)
+
+// Finalization is a transfer initiated on the counterparty gateway which is
+// not yet finalized by the relayer.
+type Finalization struct {
+	TransferIdx     *big.Int           `json:"transfer_idx"`
+	Amount          *big.Int           `json:"amount"`
+	Recipient       common.Address     `json:"recipient"`
+	FinalizationFee *big.Int           `json:"finalization_fee"`
+	Status          FinalizationStatus `json:"status"`
+	Attempts        int                `json:"attempts"`
+	NextAttempt     time.Time          `json:"next_attempt"`
+	LastError       string             `json:"last_error,omitempty"`
+}
+
+// FinalizedVolume is the amount of a finalization counted in the rolling
+// volume limit of the relayer.
+type FinalizedVolume struct {
+	Time   time.Time
+	Amount *big.Int
+}
+
 type Store struct {
 	db        *sql.DB
 	component string
 }
 
+// ReconciledTransfer is a transfer initiated on the gateway of the store,
+// tracked until it is matched with its finalization on the counterparty
+// gateway. FinalizedAmount is nil if the transfer was found finalized
+// without its TransferFinalized event.
+type ReconciledTransfer struct {
+	TransferIdx     *big.Int       `json:"transfer_idx"`
+	Amount          *big.Int       `json:"amount"`
+	Recipient       common.Address `json:"recipient"`
+	FinalizationFee *big.Int       `json:"finalization_fee"`
+	InitiatedBlock  uint64         `json:"initiated_block"`
+	Finalized       bool           `json:"finalized"`
+	FinalizedAmount *big.Int       `json:"finalized_amount,omitempty"`
+}
+
 func NewStore(db *sql.DB, component string) (*Store, error) {
 	for _, table := range []string{
 		fmt.Sprintf(transfers, strings.ToLower(component)),
+		fmt.Sprintf(finalizationsTable, strings.ToLower(component)),
+		fmt.Sprintf(reconciliationTable, strings.ToLower(component)),
+		fmt.Sprintf(finalizedVolumeTable, strings.ToLower(component)),
 		transactionsTable,
 		integerTable,
 	} {
@@ -221,3 +307,391 @@ func (s *Store) SetLastBlock(blockNum uint64) error {
 	}
 	return nil
 }
+
+// EnqueueFinalization adds the finalization to the queue as pending. It is a
+// no-op if the transfer is already queued.
+func (s *Store) EnqueueFinalization(ctx context.Context, f *Finalization) error {
+	insertQuery := fmt.Sprintf(
+		`INSERT INTO %s_finalizations
+		(transfer_idx, amount, recipient, finalization_fee, status, attempts, next_attempt, last_error)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, '')
+		ON CONFLICT (transfer_idx) DO NOTHING`,
+		s.component,
+	)
+	_, err := s.db.ExecContext(
+		ctx,
+		insertQuery,
+		f.TransferIdx.Uint64(),
+		f.Amount.String(),
+		base64.StdEncoding.EncodeToString(f.Recipient.Bytes()),
+		f.FinalizationFee.String(),
+		FinalizationPending,
+		f.NextAttempt,
+	)
+	return err
+}
+
+// PendingFinalizations returns the finalizations which are not abandoned
+// ordered by the transfer index.
+func (s *Store) PendingFinalizations(ctx context.Context) ([]*Finalization, error) {
+	return s.queryFinalizations(ctx, "WHERE status <> $1", FinalizationAbandoned)
+}
+
+// Finalizations returns all the queued finalizations ordered by the transfer
+// index.
+func (s *Store) Finalizations(ctx context.Context) ([]*Finalization, error) {
+	return s.queryFinalizations(ctx, "")
+}
+
+func (s *Store) queryFinalizations(ctx context.Context, where string, args ...any) ([]*Finalization, error) {
+	query := fmt.Sprintf(
+		`SELECT transfer_idx, amount, recipient, finalization_fee, status, attempts, next_attempt, last_error
+		FROM %s_finalizations %s ORDER BY transfer_idx`,
+		s.component,
+		where,
+	)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer rows.Close()
+
+	var finalizations []*Finalization
+	for rows.Next() {
+		var (
+			transferIdx     uint64
+			amount          string
+			recipientBase64 string
+			fee             string
+			f               Finalization
+		)
+		err := rows.Scan(
+			&transferIdx,
+			&amount,
+			&recipientBase64,
+			&fee,
+			&f.Status,
+			&f.Attempts,
+			&f.NextAttempt,
+			&f.LastError,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		recipient, err := base64.StdEncoding.DecodeString(recipientBase64)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if f.Amount, ok = new(big.Int).SetString(amount, 10); !ok {
+			return nil, fmt.Errorf("invalid amount %q of transfer %d", amount, transferIdx)
+		}
+		if f.FinalizationFee, ok = new(big.Int).SetString(fee, 10); !ok {
+			return nil, fmt.Errorf("invalid finalization fee %q of transfer %d", fee, transferIdx)
+		}
+		f.TransferIdx = new(big.Int).SetUint64(transferIdx)
+		f.Recipient = common.BytesToAddress(recipient)
+		finalizations = append(finalizations, &f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return finalizations, nil
+}
+
+// RecordFinalizationFailure increments the attempts of the finalization and
+// schedules the next one.
+func (s *Store) RecordFinalizationFailure(
+	ctx context.Context,
+	transferIdx *big.Int,
+	reason string,
+	nextAttempt time.Time,
+) error {
+	updateQuery := fmt.Sprintf(
+		"UPDATE %s_finalizations SET attempts = attempts + 1, last_error = $1, next_attempt = $2 WHERE transfer_idx = $3",
+		s.component,
+	)
+	return s.execUpdate(ctx, updateQuery, reason, nextAttempt, transferIdx.Uint64())
+}
+
+// SetFinalizationStatus changes the status of the finalization and schedules
+// the next attempt. It returns ErrNotFound if the transfer is not queued.
+func (s *Store) SetFinalizationStatus(
+	ctx context.Context,
+	transferIdx *big.Int,
+	status FinalizationStatus,
+	nextAttempt time.Time,
+) error {
+	updateQuery := fmt.Sprintf(
+		"UPDATE %s_finalizations SET status = $1, next_attempt = $2 WHERE transfer_idx = $3",
+		s.component,
+	)
+	return s.execUpdate(ctx, updateQuery, status, nextAttempt, transferIdx.Uint64())
+}
+
+// HoldFinalization puts the finalization on hold until an operator approves
+// it, keeping the reason as its last error.
+func (s *Store) HoldFinalization(ctx context.Context, transferIdx *big.Int, reason string) error {
+	updateQuery := fmt.Sprintf(
+		"UPDATE %s_finalizations SET status = $1, last_error = $2 WHERE transfer_idx = $3",
+		s.component,
+	)
+	return s.execUpdate(ctx, updateQuery, FinalizationAwaitingApproval, reason, transferIdx.Uint64())
+}
+
+// RemoveFinalization removes the finalization from the queue once the
+// transfer is finalized.
+func (s *Store) RemoveFinalization(ctx context.Context, transferIdx *big.Int) error {
+	deleteQuery := fmt.Sprintf("DELETE FROM %s_finalizations WHERE transfer_idx = $1", s.component)
+	_, err := s.db.ExecContext(ctx, deleteQuery, transferIdx.Uint64())
+	return err
+}
+
+func (s *Store) execUpdate(ctx context.Context, query string, args ...any) error {
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordTransferInitiated starts tracking the transfer for reconciliation. It
+// is a no-op if the transfer is already tracked.
+func (s *Store) RecordTransferInitiated(ctx context.Context, t *ReconciledTransfer) error {
+	insertQuery := fmt.Sprintf(
+		`INSERT INTO %s_reconciliation
+		(transfer_idx, amount, recipient, finalization_fee, initiated_block, finalized)
+		VALUES ($1, $2, $3, $4, $5, false)
+		ON CONFLICT (transfer_idx) DO NOTHING`,
+		s.component,
+	)
+	_, err := s.db.ExecContext(
+		ctx,
+		insertQuery,
+		t.TransferIdx.Uint64(),
+		t.Amount.String(),
+		base64.StdEncoding.EncodeToString(t.Recipient.Bytes()),
+		t.FinalizationFee.String(),
+		t.InitiatedBlock,
+	)
+	return err
+}
+
+// RecordTransferFinalized matches the tracked transfer with its finalization.
+// The amount is nil if the finalized amount is not known. It returns false if
+// the transfer is not tracked.
+func (s *Store) RecordTransferFinalized(ctx context.Context, transferIdx, amount *big.Int) (bool, error) {
+	var finalizedAmount sql.NullString
+	if amount != nil {
+		finalizedAmount = sql.NullString{String: amount.String(), Valid: true}
+	}
+	updateQuery := fmt.Sprintf(
+		"UPDATE %s_reconciliation SET finalized = true, finalized_amount = $1 WHERE transfer_idx = $2",
+		s.component,
+	)
+	err := s.execUpdate(ctx, updateQuery, finalizedAmount, transferIdx.Uint64())
+	switch {
+	case err == ErrNotFound:
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+// UnreconciledTransfers returns the tracked transfers which are not finalized
+// or finalized with a different amount, ordered by the transfer index.
+func (s *Store) UnreconciledTransfers(ctx context.Context) ([]*ReconciledTransfer, error) {
+	query := fmt.Sprintf(
+		`SELECT transfer_idx, amount, recipient, finalization_fee, initiated_block, finalized, finalized_amount
+		FROM %s_reconciliation
+		WHERE NOT finalized OR finalized_amount <> amount
+		ORDER BY transfer_idx`,
+		s.component,
+	)
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer rows.Close()
+
+	var transfers []*ReconciledTransfer
+	for rows.Next() {
+		var (
+			transferIdx     uint64
+			amount          string
+			recipientBase64 string
+			fee             string
+			finalizedAmount sql.NullString
+			t               ReconciledTransfer
+		)
+		err := rows.Scan(
+			&transferIdx,
+			&amount,
+			&recipientBase64,
+			&fee,
+			&t.InitiatedBlock,
+			&t.Finalized,
+			&finalizedAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		recipient, err := base64.StdEncoding.DecodeString(recipientBase64)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if t.Amount, ok = new(big.Int).SetString(amount, 10); !ok {
+			return nil, fmt.Errorf("invalid amount %q of transfer %d", amount, transferIdx)
+		}
+		if t.FinalizationFee, ok = new(big.Int).SetString(fee, 10); !ok {
+			return nil, fmt.Errorf("invalid finalization fee %q of transfer %d", fee, transferIdx)
+		}
+		if finalizedAmount.Valid {
+			if t.FinalizedAmount, ok = new(big.Int).SetString(finalizedAmount.String, 10); !ok {
+				return nil, fmt.Errorf("invalid finalized amount %q of transfer %d", finalizedAmount.String, transferIdx)
+			}
+		}
+		t.TransferIdx = new(big.Int).SetUint64(transferIdx)
+		t.Recipient = common.BytesToAddress(recipient)
+		transfers = append(transfers, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+// PruneReconciledTransfers stops tracking the transfers which are finalized
+// with the initiated amount.
+func (s *Store) PruneReconciledTransfers(ctx context.Context) error {
+	deleteQuery := fmt.Sprintf(
+		"DELETE FROM %s_reconciliation WHERE finalized AND (finalized_amount IS NULL OR finalized_amount = amount)",
+		s.component,
+	)
+	_, err := s.db.ExecContext(ctx, deleteQuery)
+	return err
+}
+
+// ReconcileCheckpoint returns the last block of the chain of the store which
+// was scanned by the reconciliation, 0 if there was no scan yet.
+func (s *Store) ReconcileCheckpoint(ctx context.Context) (uint64, error) {
+	var checkpoint sql.NullInt64
+	query := fmt.Sprintf("SELECT value FROM integers WHERE key = 'reconcile_block_%s'", s.component)
+	err := s.db.QueryRowContext(ctx, query).Scan(&checkpoint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if !checkpoint.Valid {
+		return 0, nil
+	}
+	return uint64(checkpoint.Int64), nil
+}
+
+func (s *Store) SetReconcileCheckpoint(ctx context.Context, blockNum uint64) error {
+	query := fmt.Sprintf(
+		"INSERT INTO integers (key, value) VALUES ('reconcile_block_%s', $1) ON CONFLICT (key) DO UPDATE SET value = $1",
+		s.component,
+	)
+	_, err := s.db.ExecContext(ctx, query, blockNum)
+	return err
+}
+
+// FinalizationsPaused returns whether the finalizations of the gateway of the
+// store were paused by an operator.
+func (s *Store) FinalizationsPaused(ctx context.Context) (bool, error) {
+	var paused sql.NullInt64
+	query := fmt.Sprintf("SELECT value FROM integers WHERE key = 'paused_%s'", s.component)
+	err := s.db.QueryRowContext(ctx, query).Scan(&paused)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return paused.Valid && paused.Int64 != 0, nil
+}
+
+func (s *Store) SetFinalizationsPaused(ctx context.Context, paused bool) error {
+	value := 0
+	if paused {
+		value = 1
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO integers (key, value) VALUES ('paused_%s', $1) ON CONFLICT (key) DO UPDATE SET value = $1",
+		s.component,
+	)
+	_, err := s.db.ExecContext(ctx, query, value)
+	return err
+}
+
+// RecordFinalizedVolume records the amount of a finalization and deletes the
+// amounts finalized before the start of the window.
+func (s *Store) RecordFinalizedVolume(ctx context.Context, amount *big.Int, at, windowStart time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	insertQuery := fmt.Sprintf("INSERT INTO %s_finalized_volume (finalized_at, amount) VALUES ($1, $2)", s.component)
+	if _, err := tx.ExecContext(ctx, insertQuery, at, amount.String()); err != nil {
+		return err
+	}
+	deleteQuery := fmt.Sprintf("DELETE FROM %s_finalized_volume WHERE finalized_at <= $1", s.component)
+	if _, err := tx.ExecContext(ctx, deleteQuery, windowStart); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// FinalizedVolumes returns the amounts finalized after the start of the
+// window ordered by time.
+func (s *Store) FinalizedVolumes(ctx context.Context, windowStart time.Time) ([]*FinalizedVolume, error) {
+	query := fmt.Sprintf(
+		"SELECT finalized_at, amount FROM %s_finalized_volume WHERE finalized_at > $1 ORDER BY finalized_at",
+		s.component,
+	)
+	rows, err := s.db.QueryContext(ctx, query, windowStart)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer rows.Close()
+
+	var volumes []*FinalizedVolume
+	for rows.Next() {
+		var (
+			v      FinalizedVolume
+			amount string
+			ok     bool
+		)
+		if err := rows.Scan(&v.Time, &amount); err != nil {
+			return nil, err
+		}
+		if v.Amount, ok = new(big.Int).SetString(amount, 10); !ok {
+			return nil, fmt.Errorf("invalid finalized amount %q", amount)
+		}
+		volumes = append(volumes, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return volumes, nil
+}
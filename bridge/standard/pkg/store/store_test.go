@@ -261,4 +261,207 @@ func TestStore(t *testing.T) {
 			t.Fatalf("Expected no pending txns, got %d", len(pendingTxns))
 		}
 	})
+
+	t.Run("Finalizations", func(t *testing.T) {
+		st, err := store.NewStore(db, "test")
+		if err != nil {
+			t.Fatalf("Failed to create store: %s", err)
+		}
+		ctx := context.Background()
+
+		now := time.Now().Truncate(time.Millisecond)
+		for _, transfer := range transfers[:3] {
+			err = st.EnqueueFinalization(ctx, &store.Finalization{
+				TransferIdx:     transfer.TransferIdx,
+				Amount:          transfer.Amount,
+				Recipient:       transfer.Recipient,
+				FinalizationFee: big.NewInt(1),
+				NextAttempt:     now,
+			})
+			if err != nil {
+				t.Fatalf("Failed to enqueue finalization: %s", err)
+			}
+		}
+		// Queuing a transfer again is a no-op.
+		err = st.EnqueueFinalization(ctx, &store.Finalization{
+			TransferIdx:     transfers[0].TransferIdx,
+			Amount:          big.NewInt(1),
+			Recipient:       transfers[0].Recipient,
+			FinalizationFee: big.NewInt(1),
+			NextAttempt:     now,
+		})
+		if err != nil {
+			t.Fatalf("Failed to enqueue finalization: %s", err)
+		}
+
+		err = st.RecordFinalizationFailure(ctx, transfers[0].TransferIdx, "failed", now.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("Failed to record finalization failure: %s", err)
+		}
+		err = st.SetFinalizationStatus(ctx, transfers[1].TransferIdx, store.FinalizationAbandoned, now)
+		if err != nil {
+			t.Fatalf("Failed to set finalization status: %s", err)
+		}
+		err = st.SetFinalizationStatus(ctx, transfers[5].TransferIdx, store.FinalizationAbandoned, now)
+		if err != store.ErrNotFound {
+			t.Fatalf("Expected not found error, got %v", err)
+		}
+		if err := st.RemoveFinalization(ctx, transfers[2].TransferIdx); err != nil {
+			t.Fatalf("Failed to remove finalization: %s", err)
+		}
+
+		pending, err := st.PendingFinalizations(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get pending finalizations: %s", err)
+		}
+		if len(pending) != 1 {
+			t.Fatalf("Expected 1 pending finalization, got %d", len(pending))
+		}
+		f := pending[0]
+		if f.TransferIdx.Cmp(transfers[0].TransferIdx) != 0 ||
+			f.Amount.Cmp(transfers[0].Amount) != 0 ||
+			f.Recipient != transfers[0].Recipient ||
+			f.Attempts != 1 ||
+			f.LastError != "failed" ||
+			!f.NextAttempt.Equal(now.Add(time.Minute)) {
+			t.Fatalf("Unexpected pending finalization %+v", f)
+		}
+
+		all, err := st.Finalizations(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get finalizations: %s", err)
+		}
+		if len(all) != 2 || all[1].Status != store.FinalizationAbandoned {
+			t.Fatalf("Unexpected finalizations %+v", all)
+		}
+
+		err = st.HoldFinalization(ctx, transfers[0].TransferIdx, "limit")
+		if err != nil {
+			t.Fatalf("Failed to hold finalization: %s", err)
+		}
+		pending, err = st.PendingFinalizations(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get pending finalizations: %s", err)
+		}
+		if len(pending) != 1 ||
+			pending[0].Status != store.FinalizationAwaitingApproval ||
+			pending[0].LastError != "limit" {
+			t.Fatalf("Unexpected held finalizations %+v", pending)
+		}
+	})
+
+	t.Run("Reconciliation", func(t *testing.T) {
+		st, err := store.NewStore(db, "test")
+		if err != nil {
+			t.Fatalf("Failed to create store: %s", err)
+		}
+		ctx := context.Background()
+
+		for i, transfer := range transfers[:3] {
+			err = st.RecordTransferInitiated(ctx, &store.ReconciledTransfer{
+				TransferIdx:     transfer.TransferIdx,
+				Amount:          transfer.Amount,
+				Recipient:       transfer.Recipient,
+				FinalizationFee: big.NewInt(1),
+				InitiatedBlock:  uint64(100 + i),
+			})
+			if err != nil {
+				t.Fatalf("Failed to record initiated transfer: %s", err)
+			}
+		}
+
+		found, err := st.RecordTransferFinalized(ctx, transfers[0].TransferIdx, transfers[0].Amount)
+		if err != nil || !found {
+			t.Fatalf("Failed to record finalized transfer: %v, %s", found, err)
+		}
+		found, err = st.RecordTransferFinalized(ctx, transfers[1].TransferIdx, big.NewInt(1))
+		if err != nil || !found {
+			t.Fatalf("Failed to record finalized transfer: %v, %s", found, err)
+		}
+		found, err = st.RecordTransferFinalized(ctx, transfers[5].TransferIdx, transfers[5].Amount)
+		if err != nil || found {
+			t.Fatalf("Expected untracked transfer, got %v, %v", found, err)
+		}
+		if err := st.PruneReconciledTransfers(ctx); err != nil {
+			t.Fatalf("Failed to prune reconciled transfers: %s", err)
+		}
+
+		unreconciled, err := st.UnreconciledTransfers(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get unreconciled transfers: %s", err)
+		}
+		if len(unreconciled) != 2 {
+			t.Fatalf("Expected 2 unreconciled transfers, got %d", len(unreconciled))
+		}
+		mismatch, pending := unreconciled[0], unreconciled[1]
+		if mismatch.TransferIdx.Cmp(transfers[1].TransferIdx) != 0 ||
+			!mismatch.Finalized ||
+			mismatch.FinalizedAmount.Cmp(big.NewInt(1)) != 0 {
+			t.Fatalf("Unexpected mismatched transfer %+v", mismatch)
+		}
+		if pending.TransferIdx.Cmp(transfers[2].TransferIdx) != 0 ||
+			pending.Amount.Cmp(transfers[2].Amount) != 0 ||
+			pending.Recipient != transfers[2].Recipient ||
+			pending.InitiatedBlock != 102 ||
+			pending.Finalized ||
+			pending.FinalizedAmount != nil {
+			t.Fatalf("Unexpected pending transfer %+v", pending)
+		}
+
+		checkpoint, err := st.ReconcileCheckpoint(ctx)
+		if err != nil || checkpoint != 0 {
+			t.Fatalf("Expected no checkpoint, got %d, %v", checkpoint, err)
+		}
+		if err := st.SetReconcileCheckpoint(ctx, 200); err != nil {
+			t.Fatalf("Failed to set reconcile checkpoint: %s", err)
+		}
+		checkpoint, err = st.ReconcileCheckpoint(ctx)
+		if err != nil || checkpoint != 200 {
+			t.Fatalf("Expected checkpoint 200, got %d, %v", checkpoint, err)
+		}
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		st, err := store.NewStore(db, "test")
+		if err != nil {
+			t.Fatalf("Failed to create store: %s", err)
+		}
+		ctx := context.Background()
+
+		paused, err := st.FinalizationsPaused(ctx)
+		if err != nil || paused {
+			t.Fatalf("Expected finalizations not paused, got %v, %v", paused, err)
+		}
+		if err := st.SetFinalizationsPaused(ctx, true); err != nil {
+			t.Fatalf("Failed to pause finalizations: %s", err)
+		}
+		paused, err = st.FinalizationsPaused(ctx)
+		if err != nil || !paused {
+			t.Fatalf("Expected finalizations paused, got %v, %v", paused, err)
+		}
+
+		now := time.Now().Truncate(time.Second)
+		for i, amount := range []int64{100, 200, 300} {
+			at := now.Add(time.Duration(i-2) * time.Hour)
+			if err := st.RecordFinalizedVolume(ctx, big.NewInt(amount), at, at.Add(-90*time.Minute)); err != nil {
+				t.Fatalf("Failed to record finalized volume: %s", err)
+			}
+		}
+
+		// The first amount is deleted when the last one is recorded.
+		volumes, err := st.FinalizedVolumes(ctx, time.Time{})
+		if err != nil {
+			t.Fatalf("Failed to get finalized volumes: %s", err)
+		}
+		if len(volumes) != 2 || volumes[0].Amount.Int64() != 200 || volumes[1].Amount.Int64() != 300 {
+			t.Fatalf("Unexpected finalized volumes %+v", volumes)
+		}
+		volumes, err = st.FinalizedVolumes(ctx, now.Add(-time.Minute))
+		if err != nil {
+			t.Fatalf("Failed to get finalized volumes: %s", err)
+		}
+		if len(volumes) != 1 || !volumes[0].Time.Equal(now) {
+			t.Fatalf("Unexpected finalized volumes %+v", volumes)
+		}
+	})
 }
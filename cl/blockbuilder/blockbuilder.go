@@ -411,6 +411,49 @@ func (bb *BlockBuilder) FinalizeBlock(ctx context.Context, payloadIDStr, executi
 	return nil
 }
 
+// StartCheckpointSync makes the execution client sync up to the checkpoint
+// block from its peers. The head is not validated, the block is trusted by its
+// hash, so the payload only has to match the checkpoint hash. The sync runs in
+// the background, SYNCING and ACCEPTED are the expected statuses.
+func (bb *BlockBuilder) StartCheckpointSync(ctx context.Context, executionPayloadStr string, checkpointHash common.Hash) error {
+	executionPayloadBytes, err := base64.StdEncoding.DecodeString(executionPayloadStr)
+	if err != nil {
+		return fmt.Errorf("failed to decode ExecutionPayload: %w", err)
+	}
+
+	var executionPayload engine.ExecutableData
+	if err := msgpack.Unmarshal(executionPayloadBytes, &executionPayload); err != nil {
+		return fmt.Errorf("failed to deserialize ExecutionPayload: %w", err)
+	}
+
+	if executionPayload.BlockHash != checkpointHash {
+		return fmt.Errorf("checkpoint payload hash mismatch: %s, expected: %s", executionPayload.BlockHash, checkpointHash)
+	}
+
+	retryFunc := bb.selectRetryFunction(ctx, "")
+
+	if err := bb.pushNewPayload(ctx, executionPayload, retryFunc); err != nil {
+		return fmt.Errorf("failed to push checkpoint payload: %w", err)
+	}
+
+	fcs := engine.ForkchoiceStateV1{
+		HeadBlockHash:      checkpointHash,
+		SafeBlockHash:      checkpointHash,
+		FinalizedBlockHash: checkpointHash,
+	}
+
+	if err := bb.updateForkChoice(ctx, fcs, retryFunc); err != nil {
+		return fmt.Errorf("failed to update fork choice to checkpoint: %w", err)
+	}
+
+	bb.logger.Info(
+		"Checkpoint sync started",
+		"height", executionPayload.Number,
+		"hash", checkpointHash,
+	)
+	return nil
+}
+
 func (bb *BlockBuilder) validateExecutionPayload(executionPayload engine.ExecutableData) error {
 	if executionPayload.Number != bb.executionHead.BlockHeight+1 {
 		return fmt.Errorf("invalid block height: %d, expected: %d", executionPayload.Number, bb.executionHead.BlockHeight+1)
@@ -442,7 +485,9 @@ func (bb *BlockBuilder) selectRetryFunction(ctx context.Context, msgID string) f
 
 func (bb *BlockBuilder) pushNewPayload(ctx context.Context, executionPayload engine.ExecutableData, retryFunc func(f func() error) error) error {
 	emptyVersionHashes := []common.Hash{}
-	parentHash := common.BytesToHash(bb.executionHead.BlockHash)
+	// The parent hash equals the execution head once the payload is
+	// validated, a checkpoint payload is pushed without an execution head.
+	parentHash := executionPayload.ParentHash
 	return retryFunc(func() error {
 		status, err := bb.engineCl.NewPayloadV4(ctx, executionPayload, emptyVersionHashes, &parentHash, []hexutil.Bytes{})
 		bb.logger.Debug("newPayload result",
@@ -650,3 +650,48 @@ func matchPayloadAttributes(expectedHash common.Hash, executionHeadTime uint64)
 		return true
 	}
 }
+
+func TestBlockBuilder_StartCheckpointSync(t *testing.T) {
+	ctx := context.Background()
+
+	mockEngineClient := new(MockEngineClient)
+	blockBuilder := NewMemberBlockBuilder(mockEngineClient, stLog)
+
+	executionPayload := engine.ExecutableData{
+		ParentHash:    common.HexToHash("0x0bf39bc18be059c1dcb872ac8c0b0c845655a01c2b7d8fd01c4becde6b3f93d7"),
+		StateRoot:     common.HexToHash("0xcdc166a6c2e7f8b873889a7256873144e61121f9fc1f027d79b8fa310b91ff0f"),
+		Number:        1000,
+		GasLimit:      30000000,
+		Timestamp:     1728051707,
+		BaseFeePerGas: big.NewInt(670970451),
+		BlockHash:     common.HexToHash("0x9a9b2f7e98934f8544c22cdcb00526f48886170b15c4e4e96bd43af189b5aac4"),
+		Transactions:  [][]byte{},
+		Withdrawals:   []*etypes.Withdrawal{},
+	}
+	msgpackData, err := msgpack.Marshal(executionPayload)
+	require.NoError(t, err)
+	encodedPayload := base64.StdEncoding.EncodeToString(msgpackData)
+
+	// A checkpoint payload with a different hash is never pushed.
+	err = blockBuilder.StartCheckpointSync(ctx, encodedPayload, common.HexToHash("0x01"))
+	require.Error(t, err)
+	mockEngineClient.AssertNotCalled(t, "NewPayloadV4", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	syncing := engine.PayloadStatusV1{Status: engine.SYNCING}
+	parentHash := executionPayload.ParentHash
+	mockEngineClient.On("NewPayloadV4", mock.Anything, mock.Anything, []common.Hash{}, &parentHash, mock.Anything).Return(syncing, nil)
+
+	hash := executionPayload.BlockHash
+	fcs := engine.ForkchoiceStateV1{
+		HeadBlockHash:      hash,
+		SafeBlockHash:      hash,
+		FinalizedBlockHash: hash,
+	}
+	mockEngineClient.On("ForkchoiceUpdatedV3", mock.Anything, fcs, (*engine.PayloadAttributes)(nil)).Return(engine.ForkChoiceResponse{PayloadStatus: syncing}, nil)
+
+	err = blockBuilder.StartCheckpointSync(ctx, encodedPayload, hash)
+	require.NoError(t, err)
+	assert.Nil(t, blockBuilder.GetExecutionHead())
+
+	mockEngineClient.AssertExpectations(t)
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
@@ -13,11 +15,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/primev/mev-commit/cl/blockbuilder"
 	"github.com/primev/mev-commit/cl/ethclient"
 	"github.com/primev/mev-commit/cl/singlenode"
+	"github.com/primev/mev-commit/cl/singlenode/api"
 	"github.com/primev/mev-commit/cl/singlenode/follower"
+	"github.com/primev/mev-commit/cl/singlenode/membernode"
 	"github.com/primev/mev-commit/cl/singlenode/payloadstore"
+	"github.com/primev/mev-commit/cl/types"
 	"github.com/primev/mev-commit/x/util"
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/cli/v2/altsrc"
@@ -200,6 +208,20 @@ var (
 		Category: categoryDatabase,
 	})
 
+	pebblePathFlag = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "pebble-path",
+		Usage:    "Directory of the embedded pebble database for storing payloads, used when neither postgres-dsn nor redis-url is set",
+		EnvVars:  []string{"LEADER_PEBBLE_PATH"},
+		Category: categoryDatabase,
+	})
+
+	payloadRetentionFlag = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "payload-retention",
+		Usage:    "Number of latest heights kept in the pebble database, older payloads are pruned (0 keeps all)",
+		EnvVars:  []string{"LEADER_PAYLOAD_RETENTION"},
+		Category: categoryDatabase,
+	})
+
 	apiAddrFlag = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:    "api-addr",
 		Usage:   "Address for member node API endpoint (e.g., ':9090'). If empty, API is disabled.",
@@ -223,6 +245,115 @@ var (
 		Value:   5 * time.Millisecond,
 	})
 
+	payloadSigningKeyFlag = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "payload-signing-key",
+		Usage:   "Hex-encoded private key used to sign the payloads served to member nodes",
+		EnvVars: []string{"LEADER_PAYLOAD_SIGNING_KEY"},
+		Action: func(_ *cli.Context, s string) error {
+			if s == "" {
+				return nil
+			}
+			if _, err := crypto.HexToECDSA(strings.TrimPrefix(s, "0x")); err != nil {
+				return fmt.Errorf("invalid payload-signing-key: %v", err)
+			}
+			return nil
+		},
+	})
+
+	leaderElectionFlag = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name: "leader-election",
+		Usage: "Run in HA mode: instances sharing the postgres-dsn elect the block producer through a lease " +
+			"and the others follow its payloads. The instance-id must be unique per instance.",
+		EnvVars:  []string{"LEADER_ELECTION"},
+		Category: categoryDatabase,
+	})
+
+	leaseDurationFlag = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:     "lease-duration",
+		Usage:    "Validity of the leader lease; a standby takes over once the leader did not renew it for this long",
+		EnvVars:  []string{"LEADER_LEASE_DURATION"},
+		Value:    singlenode.DefaultLeaseDuration,
+		Category: categoryDatabase,
+	})
+
+	// Member node specific flags
+	leaderAPIURLFlag = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "leader-api-url",
+		Usage:    "URL of the leader node payload API (e.g., http://leader:9090)",
+		EnvVars:  []string{"MEMBER_LEADER_API_URL"},
+		Required: true,
+		Action: func(_ *cli.Context, s string) error {
+			if _, err := url.Parse(s); err != nil {
+				return fmt.Errorf("invalid leader-api-url: %v", err)
+			}
+			return nil
+		},
+		Category: categoryMember,
+	})
+
+	leaderSignersFlag = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:     "leader-signers",
+		Usage:    "Addresses of the leader payload signing keys; payloads signed by any other key are rejected",
+		EnvVars:  []string{"MEMBER_LEADER_SIGNERS"},
+		Required: true,
+		Action: func(_ *cli.Context, addrs []string) error {
+			for _, addr := range addrs {
+				if !common.IsHexAddress(addr) {
+					return fmt.Errorf("invalid leader-signers address %q", addr)
+				}
+			}
+			return nil
+		},
+		Category: categoryMember,
+	})
+
+	pollIntervalFlag = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:     "poll-interval",
+		Usage:    "Interval for polling the leader for new payloads",
+		EnvVars:  []string{"MEMBER_POLL_INTERVAL"},
+		Value:    time.Second,
+		Category: categoryMember,
+	})
+
+	seedFileFlag = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "seed-file",
+		Usage:    "Payloads exported by the leader with the export command, applied before following the leader",
+		EnvVars:  []string{"MEMBER_SEED_FILE"},
+		Category: categoryMember,
+	})
+
+	checkpointHeightFlag = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "checkpoint-height",
+		Usage:    "Height of a trusted block local geth syncs to from its peers before following the leader",
+		EnvVars:  []string{"MEMBER_CHECKPOINT_HEIGHT"},
+		Category: categoryMember,
+	})
+
+	checkpointHashFlag = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "checkpoint-hash",
+		Usage:   "Hash of the trusted block at the checkpoint height",
+		EnvVars: []string{"MEMBER_CHECKPOINT_HASH"},
+		Action: func(_ *cli.Context, s string) error {
+			if b, err := hexutil.Decode(s); err != nil || len(b) != common.HashLength {
+				return fmt.Errorf("invalid checkpoint-hash %q", s)
+			}
+			return nil
+		},
+		Category: categoryMember,
+	})
+
+	// Export specific flags
+	exportOutputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "File the payloads are exported to, '-' for stdout",
+		Value: "-",
+	}
+
+	exportFromHeightFlag = &cli.Uint64Flag{
+		Name:  "from-height",
+		Usage: "Lowest height of the exported payloads",
+	}
+
 	// Follower node specific flags
 	syncBatchSizeFlag = altsrc.NewUint64Flag(&cli.Uint64Flag{
 		Name:    "sync-batch-size",
@@ -247,9 +378,45 @@ func main() {
 		healthAddrPortFlag,
 		postgresDSNFlag,
 		redisURLFlag,
+		pebblePathFlag,
+		payloadRetentionFlag,
 		apiAddrFlag,
 		nonAuthRpcUrlFlag,
 		txPoolPollingIntervalFlag,
+		payloadSigningKeyFlag,
+		leaderElectionFlag,
+		leaseDurationFlag,
+	}
+
+	memberFlags := []cli.Flag{
+		configFlag,
+		instanceIDFlag,
+		ethClientURLFlag,
+		jwtSecretFlag,
+		logFmtFlag,
+		logLevelFlag,
+		logTagsFlag,
+		healthAddrPortFlag,
+		leaderAPIURLFlag,
+		leaderSignersFlag,
+		pollIntervalFlag,
+		seedFileFlag,
+		checkpointHeightFlag,
+		checkpointHashFlag,
+	}
+
+	exportFlags := []cli.Flag{
+		logFmtFlag,
+		logLevelFlag,
+		logTagsFlag,
+		&cli.StringFlag{
+			Name:     pebblePathFlag.Name,
+			Usage:    "Directory of the pebble payload database; the leader using it must be stopped",
+			Required: true,
+		},
+		payloadSigningKeyFlag,
+		exportOutputFlag,
+		exportFromHeightFlag,
 	}
 
 	followerFlags := []cli.Flag{
@@ -302,6 +469,30 @@ func main() {
 					return startFollowerNode(c)
 				},
 			},
+			{
+				Name:  "member",
+				Usage: "Start as member node (applies the payloads signed by the leader)",
+				Flags: memberFlags,
+				Before: altsrc.InitInputSourceWithContext(memberFlags,
+					func(c *cli.Context) (altsrc.InputSourceContext, error) {
+						configFile := c.String(configFlag.Name)
+						if configFile != "" {
+							return altsrc.NewYamlSourceFromFile(configFile)
+						}
+						return &altsrc.MapInputSource{}, nil
+					}),
+				Action: func(c *cli.Context) error {
+					return startMemberNode(c)
+				},
+			},
+			{
+				Name:  "export",
+				Usage: "Export the payloads of a pebble database to seed new member nodes",
+				Flags: exportFlags,
+				Action: func(c *cli.Context) error {
+					return exportPayloads(c)
+				},
+			},
 			// Keep the old "start" command for backward compatibility
 			{
 				Name:  "start",
@@ -350,12 +541,25 @@ func startLeaderNode(c *cli.Context) error {
 		HealthAddr:               c.String(healthAddrPortFlag.Name),
 		PostgresDSN:              c.String(postgresDSNFlag.Name),
 		RedisURL:                 c.String(redisURLFlag.Name),
+		PebblePath:               c.String(pebblePathFlag.Name),
+		PayloadRetention:         c.Uint64(payloadRetentionFlag.Name),
 		APIAddr:                  c.String(apiAddrFlag.Name),
 		NonAuthRpcURL:            c.String(nonAuthRpcUrlFlag.Name),
 		TxPoolPollingInterval:    c.Duration(txPoolPollingIntervalFlag.Name),
+		PayloadSigningKey:        strings.TrimPrefix(c.String(payloadSigningKeyFlag.Name), "0x"),
+		LeaderElection:           c.Bool(leaderElectionFlag.Name),
+		LeaseDuration:            c.Duration(leaseDurationFlag.Name),
 	}
 
-	logger.Info("Starting leader node with configuration", "config", cfg)
+	logger.Info(
+		"Starting leader node with configuration",
+		"instance_id", cfg.InstanceID,
+		"eth_client_url", cfg.EthClientURL,
+		"api_addr", cfg.APIAddr,
+		"health_addr", cfg.HealthAddr,
+		"payload_signing", cfg.PayloadSigningKey != "",
+		"leader_election", cfg.LeaderElection,
+	)
 
 	// Create a root context that can be cancelled for graceful shutdown
 	rootCtx, rootCancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -378,6 +582,132 @@ func startLeaderNode(c *cli.Context) error {
 	return nil
 }
 
+func startMemberNode(c *cli.Context) error {
+	logger, err := util.NewLogger(
+		c.String(logLevelFlag.Name),
+		c.String(logFmtFlag.Name),
+		c.String(logTagsFlag.Name),
+		c.App.Writer,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	logger = logger.With("app", "snode", "role", "member")
+
+	var signers []common.Address
+	for _, addr := range c.StringSlice(leaderSignersFlag.Name) {
+		signers = append(signers, common.HexToAddress(addr))
+	}
+
+	checkpointHeight := c.Uint64(checkpointHeightFlag.Name)
+	checkpointHash := c.String(checkpointHashFlag.Name)
+	if (checkpointHeight == 0) != (checkpointHash == "") {
+		return fmt.Errorf("checkpoint-height and checkpoint-hash must be set together")
+	}
+
+	cfg := membernode.Config{
+		InstanceID:       c.String(instanceIDFlag.Name),
+		LeaderAPIURL:     c.String(leaderAPIURLFlag.Name),
+		EthClientURL:     c.String(ethClientURLFlag.Name),
+		JWTSecret:        c.String(jwtSecretFlag.Name),
+		HealthAddr:       c.String(healthAddrPortFlag.Name),
+		PollInterval:     c.Duration(pollIntervalFlag.Name),
+		LeaderSigners:    signers,
+		SeedFile:         c.String(seedFileFlag.Name),
+		CheckpointHeight: checkpointHeight,
+		CheckpointHash:   common.HexToHash(checkpointHash),
+	}
+
+	logger.Info("Starting member node with configuration", "config", cfg)
+
+	rootCtx, rootCancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer rootCancel()
+
+	member, err := membernode.NewMemberNodeApp(rootCtx, cfg, logger)
+	if err != nil {
+		logger.Error("Failed to initialize MemberNodeApp", "error", err)
+		return err
+	}
+
+	member.Start()
+
+	<-rootCtx.Done()
+
+	logger.Info("Shutdown signal received, stopping member node...")
+	member.Stop()
+	logger.Info("Member node shutdown completed.")
+	return nil
+}
+
+func exportPayloads(c *cli.Context) error {
+	logger, err := util.NewLogger(
+		c.String(logLevelFlag.Name),
+		c.String(logFmtFlag.Name),
+		c.String(logTagsFlag.Name),
+		c.App.ErrWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	var signer *api.PayloadSigner
+	if key := c.String(payloadSigningKeyFlag.Name); key != "" {
+		signer, err = api.NewPayloadSigner(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return err
+		}
+	} else {
+		logger.Warn("Payload signing key not provided, member nodes will reject the exported payloads.")
+	}
+
+	repo, err := payloadstore.NewPebbleRepository(c.String(pebblePathFlag.Name), 0, logger)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer repo.Close()
+
+	out := c.App.Writer
+	if path := c.String(exportOutputFlag.Name); path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		//nolint:errcheck
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	enc := json.NewEncoder(w)
+
+	count := 0
+	err = repo.Export(c.Context, c.Uint64(exportFromHeightFlag.Name), func(p *types.PayloadInfo) error {
+		resp := api.PayloadResponse{
+			PayloadID:        p.PayloadID,
+			ExecutionPayload: p.ExecutionPayload,
+			BlockHeight:      p.BlockHeight,
+			Timestamp:        p.InsertedAt.Unix(),
+		}
+		if signer != nil {
+			if err := signer.Sign(&resp); err != nil {
+				return fmt.Errorf("failed to sign payload at height %d: %w", p.BlockHeight, err)
+			}
+		}
+		count++
+		return enc.Encode(resp)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export payloads: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write payloads: %w", err)
+	}
+
+	logger.Info("Exported payloads", "count", count)
+	return nil
+}
+
 func startFollowerNode(c *cli.Context) error {
 	logger, err := util.NewLogger(
 		c.String(logLevelFlag.Name),
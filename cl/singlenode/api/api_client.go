@@ -16,6 +16,11 @@ type PayloadResponse struct {
 	ExecutionPayload string `json:"execution_payload"`
 	BlockHeight      uint64 `json:"block_height"`
 	Timestamp        int64  `json:"timestamp"`
+	// BlockHash, ParentHash and Signature are set when the leader signs
+	// its payloads.
+	BlockHash  string `json:"block_hash,omitempty"`
+	ParentHash string `json:"parent_hash,omitempty"`
+	Signature  string `json:"signature,omitempty"`
 }
 
 // PayloadListResponse represents the response for multiple payloads
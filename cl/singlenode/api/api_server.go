@@ -24,6 +24,16 @@ type PayloadServer struct {
 	stateManager StateManager
 	payloadRepo  types.PayloadRepository
 	server       *http.Server
+	signer       *PayloadSigner
+}
+
+type ServerOption func(*PayloadServer)
+
+// WithPayloadSigner signs every payload served to the member nodes.
+func WithPayloadSigner(signer *PayloadSigner) ServerOption {
+	return func(ps *PayloadServer) {
+		ps.signer = signer
+	}
 }
 
 // NewPayloadServer creates a new payload API server
@@ -32,6 +42,7 @@ func NewPayloadServer(
 	stateManager StateManager,
 	payloadRepo types.PayloadRepository,
 	logger *slog.Logger,
+	opts ...ServerOption,
 ) *PayloadServer {
 	mux := http.NewServeMux()
 
@@ -47,6 +58,9 @@ func NewPayloadServer(
 			IdleTimeout:  60 * time.Second,
 		},
 	}
+	for _, opt := range opts {
+		opt(ps)
+	}
 
 	// Register endpoints
 	mux.HandleFunc("/api/v1/payload/latest", ps.handleGetLatestPayload)
@@ -93,6 +107,24 @@ func convertToResponse(payload *types.PayloadInfo) PayloadResponse {
 	}
 }
 
+// sign signs the response if a signer is configured. Payloads which cannot
+// be signed are not served, as the member nodes would reject them anyway.
+func (ps *PayloadServer) sign(resp *PayloadResponse) error {
+	if ps.signer == nil {
+		return nil
+	}
+	if err := ps.signer.Sign(resp); err != nil {
+		ps.logger.Error(
+			"Failed to sign payload",
+			"payload_id", resp.PayloadID,
+			"height", resp.BlockHeight,
+			"error", err,
+		)
+		return err
+	}
+	return nil
+}
+
 // handleGetLatestPayload returns the latest payload from the current block build state
 func (ps *PayloadServer) handleGetLatestPayload(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -107,6 +139,10 @@ func (ps *PayloadServer) handleGetLatestPayload(w http.ResponseWriter, r *http.R
 	if ps.payloadRepo != nil {
 		if payload, err := ps.payloadRepo.GetLatestPayload(ctx); err == nil && payload != nil {
 			response := convertToResponse(payload)
+			if err := ps.sign(&response); err != nil {
+				ps.writeError(w, "Failed to sign payload", http.StatusInternalServerError)
+				return
+			}
 			ps.writeJSON(w, response, http.StatusOK)
 			ps.logger.Debug(
 				"Served latest payload from repository",
@@ -131,6 +167,10 @@ func (ps *PayloadServer) handleGetLatestPayload(w http.ResponseWriter, r *http.R
 		BlockHeight:      0, // We don't have height from state manager
 		Timestamp:        time.Now().Unix(),
 	}
+	if err := ps.sign(&response); err != nil {
+		ps.writeError(w, "Failed to sign payload", http.StatusInternalServerError)
+		return
+	}
 
 	ps.writeJSON(w, response, http.StatusOK)
 	ps.logger.Debug("Served latest payload from state", "payload_id", state.PayloadID)
@@ -196,6 +236,10 @@ func (ps *PayloadServer) handleGetPayloadsSince(w http.ResponseWriter, r *http.R
 	var nextHeight uint64
 	for i, payload := range payloads {
 		responsePayloads[i] = convertToResponse(&payload)
+		if err := ps.sign(&responsePayloads[i]); err != nil {
+			ps.writeError(w, "Failed to sign payload", http.StatusInternalServerError)
+			return
+		}
 		if i == len(payloads)-1 {
 			nextHeight = payload.BlockHeight + 1
 		}
@@ -263,6 +307,10 @@ func (ps *PayloadServer) handleGetPayloadByHeight(w http.ResponseWriter, r *http
 	}
 
 	response := convertToResponse(payload)
+	if err := ps.sign(&response); err != nil {
+		ps.writeError(w, "Failed to sign payload", http.StatusInternalServerError)
+		return
+	}
 	ps.writeJSON(w, response, http.StatusOK)
 	ps.logger.Debug(
 		"Served payload by height",
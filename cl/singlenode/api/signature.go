@@ -0,0 +1,161 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// payloadSignaturePrefix separates the payload signatures from any other
+// message signed with the leader key.
+const payloadSignaturePrefix = "mev-commit-singlenode-payload"
+
+var (
+	ErrMissingSignature = errors.New("payload is not signed")
+	ErrInvalidSignature = errors.New("invalid payload signature")
+	ErrUnknownSigner    = errors.New("payload signed by unknown leader")
+	ErrPayloadMismatch  = errors.New("signed fields do not match the execution payload")
+)
+
+// PayloadDigest returns the hash signed by the leader for a payload. The block
+// hash commits to the whole block, as the execution client rejects a payload
+// whose contents do not hash to it.
+func PayloadDigest(height uint64, blockHash, parentHash common.Hash) common.Hash {
+	var heightBytes [8]byte
+	binary.BigEndian.PutUint64(heightBytes[:], height)
+	return crypto.Keccak256Hash(
+		[]byte(payloadSignaturePrefix),
+		heightBytes[:],
+		blockHash.Bytes(),
+		parentHash.Bytes(),
+	)
+}
+
+// decodeExecutionPayload decodes the execution payload the same way it is
+// decoded by the block builder before it is applied.
+func decodeExecutionPayload(executionPayload string) (*engine.ExecutableData, error) {
+	data, err := base64.StdEncoding.DecodeString(executionPayload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode execution payload: %w", err)
+	}
+	var payload engine.ExecutableData
+	if err := msgpack.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize execution payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// PayloadSigner signs the payloads served to the member nodes.
+type PayloadSigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewPayloadSigner creates a signer from the hex encoded leader private key.
+func NewPayloadSigner(hexKey string) (*PayloadSigner, error) {
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload signing key: %w", err)
+	}
+	return &PayloadSigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}, nil
+}
+
+// Address returns the address the member nodes should pin for this signer.
+func (s *PayloadSigner) Address() common.Address {
+	return s.address
+}
+
+// Sign fills the block hash, parent hash and signature of the response from
+// its execution payload. A response without a height, as served from the
+// build state, takes the height of the execution payload.
+func (s *PayloadSigner) Sign(resp *PayloadResponse) error {
+	payload, err := decodeExecutionPayload(resp.ExecutionPayload)
+	if err != nil {
+		return err
+	}
+	if resp.BlockHeight == 0 {
+		resp.BlockHeight = payload.Number
+	}
+	if resp.BlockHeight != payload.Number {
+		return fmt.Errorf("%w: height %d, payload number %d", ErrPayloadMismatch, resp.BlockHeight, payload.Number)
+	}
+
+	digest := PayloadDigest(resp.BlockHeight, payload.BlockHash, payload.ParentHash)
+	sig, err := crypto.Sign(digest.Bytes(), s.key)
+	if err != nil {
+		return fmt.Errorf("failed to sign payload: %w", err)
+	}
+
+	resp.BlockHash = payload.BlockHash.Hex()
+	resp.ParentHash = payload.ParentHash.Hex()
+	resp.Signature = hexutil.Encode(sig)
+	return nil
+}
+
+// PayloadVerifier checks that the payloads were signed by one of the pinned
+// leader keys.
+type PayloadVerifier struct {
+	signers map[common.Address]struct{}
+}
+
+// NewPayloadVerifier creates a verifier accepting the signatures of the given
+// leader addresses.
+func NewPayloadVerifier(signers []common.Address) (*PayloadVerifier, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("at least one leader signer must be pinned")
+	}
+	v := &PayloadVerifier{signers: make(map[common.Address]struct{}, len(signers))}
+	for _, signer := range signers {
+		v.signers[signer] = struct{}{}
+	}
+	return v, nil
+}
+
+// Verify recovers the signer of the response and checks that the signed
+// fields are the ones of the execution payload which is going to be applied.
+// It returns the address of the leader which signed the payload.
+func (v *PayloadVerifier) Verify(resp *PayloadResponse) (common.Address, error) {
+	if resp.Signature == "" {
+		return common.Address{}, ErrMissingSignature
+	}
+	sig, err := hexutil.Decode(resp.Signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	// The hashes are taken from the execution payload rather than from the
+	// response, so that a valid signature cannot be attached to a different
+	// block.
+	payload, err := decodeExecutionPayload(resp.ExecutionPayload)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if resp.BlockHeight != payload.Number {
+		return common.Address{}, fmt.Errorf("%w: height %d, payload number %d", ErrPayloadMismatch, resp.BlockHeight, payload.Number)
+	}
+	if resp.BlockHash != "" && common.HexToHash(resp.BlockHash) != payload.BlockHash {
+		return common.Address{}, fmt.Errorf("%w: block hash %s, payload block hash %s", ErrPayloadMismatch, resp.BlockHash, payload.BlockHash)
+	}
+	if resp.ParentHash != "" && common.HexToHash(resp.ParentHash) != payload.ParentHash {
+		return common.Address{}, fmt.Errorf("%w: parent hash %s, payload parent hash %s", ErrPayloadMismatch, resp.ParentHash, payload.ParentHash)
+	}
+
+	digest := PayloadDigest(payload.Number, payload.BlockHash, payload.ParentHash)
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+	if _, ok := v.signers[signer]; !ok {
+		return signer, fmt.Errorf("%w: %s", ErrUnknownSigner, signer.Hex())
+	}
+	return signer, nil
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/mev-commit/cl/types"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func createTestExecutionPayload(t *testing.T, height uint64, blockHash, parentHash common.Hash) string {
+	t.Helper()
+
+	data, err := msgpack.Marshal(engine.ExecutableData{
+		Number:     height,
+		BlockHash:  blockHash,
+		ParentHash: parentHash,
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal execution payload: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func createTestSigner(t *testing.T) *PayloadSigner {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	signer, err := NewPayloadSigner(hex.EncodeToString(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	return signer
+}
+
+func TestPayloadSignature(t *testing.T) {
+	leader := createTestSigner(t)
+	attacker := createTestSigner(t)
+
+	verifier, err := NewPayloadVerifier([]common.Address{leader.Address()})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+
+	blockHash := common.HexToHash("0xb1")
+	parentHash := common.HexToHash("0xa1")
+	signed := func(signer *PayloadSigner) PayloadResponse {
+		resp := PayloadResponse{
+			PayloadID:        "payload-10",
+			ExecutionPayload: createTestExecutionPayload(t, 10, blockHash, parentHash),
+			BlockHeight:      10,
+		}
+		if err := signer.Sign(&resp); err != nil {
+			t.Fatalf("Failed to sign payload: %v", err)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name    string
+		resp    func() PayloadResponse
+		wantErr error
+	}{
+		{
+			name: "valid",
+			resp: func() PayloadResponse { return signed(leader) },
+		},
+		{
+			name: "unsigned",
+			resp: func() PayloadResponse {
+				resp := signed(leader)
+				resp.Signature = ""
+				return resp
+			},
+			wantErr: ErrMissingSignature,
+		},
+		{
+			name:    "unknown signer",
+			resp:    func() PayloadResponse { return signed(attacker) },
+			wantErr: ErrUnknownSigner,
+		},
+		{
+			name: "malformed signature",
+			resp: func() PayloadResponse {
+				resp := signed(leader)
+				resp.Signature = "0x1234"
+				return resp
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "different height",
+			resp: func() PayloadResponse {
+				resp := signed(leader)
+				resp.BlockHeight = 11
+				return resp
+			},
+			wantErr: ErrPayloadMismatch,
+		},
+		{
+			name: "forged execution payload",
+			resp: func() PayloadResponse {
+				resp := signed(leader)
+				resp.ExecutionPayload = createTestExecutionPayload(t, 10, common.HexToHash("0xb2"), parentHash)
+				resp.BlockHash = ""
+				return resp
+			},
+			wantErr: ErrUnknownSigner,
+		},
+		{
+			name: "different parent hash",
+			resp: func() PayloadResponse {
+				resp := signed(leader)
+				resp.ParentHash = common.HexToHash("0xa2").Hex()
+				return resp
+			},
+			wantErr: ErrPayloadMismatch,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := tc.resp()
+			signer, err := verifier.Verify(&resp)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr == nil && signer != leader.Address() {
+				t.Errorf("Expected signer %s, got %s", leader.Address(), signer)
+			}
+		})
+	}
+}
+
+func TestNewPayloadVerifier_NoSigners(t *testing.T) {
+	if _, err := NewPayloadVerifier(nil); err == nil {
+		t.Fatal("Expected error without pinned signers")
+	}
+}
+
+func TestHandleGetPayloadByHeight_Signed(t *testing.T) {
+	signer := createTestSigner(t)
+	payloadRepo := &mockPayloadRepository{
+		payloadsByHeight: map[uint64]*types.PayloadInfo{
+			150: {
+				PayloadID:        "height-payload",
+				ExecutionPayload: createTestExecutionPayload(t, 150, common.HexToHash("0xb1"), common.HexToHash("0xa1")),
+				BlockHeight:      150,
+				InsertedAt:       time.Now(),
+			},
+		},
+	}
+
+	server := NewPayloadServer(
+		"localhost:8080",
+		&mockStateManager{},
+		payloadRepo,
+		createTestLogger(),
+		WithPayloadSigner(signer),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/payload/height/150", nil)
+	w := httptest.NewRecorder()
+
+	server.handleGetPayloadByHeight(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response PayloadResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	verifier, err := NewPayloadVerifier([]common.Address{signer.Address()})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+	if _, err := verifier.Verify(&response); err != nil {
+		t.Errorf("Expected valid signature, got %v", err)
+	}
+}
+
+func TestHandleGetPayloadByHeight_SignFailure(t *testing.T) {
+	payloadRepo := &mockPayloadRepository{
+		payloadsByHeight: map[uint64]*types.PayloadInfo{
+			150: createTestPayloadInfo("height-payload", 150),
+		},
+	}
+
+	server := NewPayloadServer(
+		"localhost:8080",
+		&mockStateManager{},
+		payloadRepo,
+		createTestLogger(),
+		WithPayloadSigner(createTestSigner(t)),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/payload/height/150", nil)
+	w := httptest.NewRecorder()
+
+	server.handleGetPayloadByHeight(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
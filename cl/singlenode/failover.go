@@ -0,0 +1,231 @@
+package singlenode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/primev/mev-commit/cl/blockbuilder"
+	"github.com/primev/mev-commit/cl/singlenode/payloadstore"
+	"github.com/primev/mev-commit/cl/types"
+)
+
+const (
+	// DefaultLeaseDuration is how long the leader lease is valid without being
+	// renewed, i.e. the time a standby waits before it takes over from a
+	// leader which died.
+	DefaultLeaseDuration = 5 * time.Second
+
+	// followBatchSize is the number of payloads a standby applies per query.
+	followBatchSize = 100
+)
+
+// LeaderLease elects a single block producer among the instances sharing the
+// payload store. The term returned on acquisition fences the payload writes of
+// the leader, so that a leader which lost the lease can never save a payload.
+type LeaderLease interface {
+	AcquireLease(ctx context.Context, holder string, ttl time.Duration) (uint64, error)
+	RenewLease(ctx context.Context, holder string, term uint64, ttl time.Duration) error
+	ReleaseLease(ctx context.Context, holder string, term uint64) error
+	SaveFencedPayload(ctx context.Context, info *types.PayloadInfo, holder string, term uint64) error
+}
+
+// isFenced reports whether the error means that another instance has become
+// the leader.
+func isFenced(err error) bool {
+	return errors.Is(err, payloadstore.ErrNotLeader) || errors.Is(err, payloadstore.ErrPayloadConflict)
+}
+
+// runFailoverLoop alternates between following the payloads of the current
+// leader and producing blocks while holding the leader lease.
+func (app *SingleNodeApp) runFailoverLoop() {
+	app.logger.Info(
+		"SingleNodeApp failover loop started",
+		"instanceID", app.cfg.InstanceID,
+		"lease_duration", app.cfg.LeaseDuration,
+	)
+
+	for {
+		term, err := app.waitForLease()
+		if err != nil {
+			app.logger.Info("SingleNodeApp failover loop stopping", "reason", err)
+			return
+		}
+		app.lead(term)
+		if app.appCtx.Err() != nil {
+			return
+		}
+	}
+}
+
+// waitForLease follows the payloads saved by the current leader until the
+// lease is acquired.
+func (app *SingleNodeApp) waitForLease() (uint64, error) {
+	ticker := time.NewTicker(app.cfg.LeaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		term, err := app.leaderLease.AcquireLease(app.appCtx, app.cfg.InstanceID, app.cfg.LeaseDuration)
+		switch {
+		case err == nil:
+			return term, nil
+		case !errors.Is(err, payloadstore.ErrNotLeader):
+			app.logger.Warn("Failed to acquire leader lease", "error", err)
+		}
+
+		if err := app.followPayloads(); err != nil {
+			app.logger.Warn("Failed to follow leader payloads", "error", err)
+		}
+
+		select {
+		case <-app.appCtx.Done():
+			return 0, app.appCtx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// followPayloads applies the payloads saved after the execution head, the
+// same way the follower does.
+func (app *SingleNodeApp) followPayloads() error {
+	if app.blockBuilder.GetExecutionHead() == nil {
+		if err := app.blockBuilder.SetExecutionHeadFromRPC(app.appCtx); err != nil {
+			return fmt.Errorf("failed to set execution head from rpc: %w", err)
+		}
+	}
+
+	for {
+		height := app.blockBuilder.GetExecutionHead().BlockHeight
+		ctx, cancel := context.WithTimeout(app.appCtx, 5*time.Second)
+		payloads, err := app.payloadRepo.GetPayloadsSince(ctx, height+1, followBatchSize)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to get payloads since height %d: %w", height+1, err)
+		}
+		if len(payloads) == 0 {
+			return nil
+		}
+
+		for _, p := range payloads {
+			if err := app.blockBuilder.FinalizeBlock(app.appCtx, p.PayloadID, p.ExecutionPayload, ""); err != nil {
+				return fmt.Errorf("failed to apply payload at height %d: %w", p.BlockHeight, err)
+			}
+			app.logger.Debug("Applied leader payload", "height", p.BlockHeight)
+		}
+	}
+}
+
+// lead produces blocks until the lease is lost or the app is stopped.
+func (app *SingleNodeApp) lead(term uint64) {
+	app.logger.Info("Became leader", "term", term)
+
+	// The previous leader could have saved payloads which were not applied
+	// yet, the chain is resumed from the latest saved payload.
+	if err := app.followPayloads(); err != nil {
+		app.logger.Error("Failed to catch up with saved payloads, releasing lease", "error", err)
+		app.releaseLease(term)
+		return
+	}
+	if err := app.blockBuilder.SetExecutionHeadFromRPC(app.appCtx); err != nil {
+		app.logger.Error("Failed to set execution head from rpc, releasing lease", "error", err)
+		app.releaseLease(term)
+		return
+	}
+
+	app.leaderTerm.Store(term)
+	defer app.leaderTerm.Store(0)
+
+	leaderCtx, cancel := context.WithCancel(app.appCtx)
+	defer cancel()
+
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		app.renewLease(leaderCtx, cancel, term)
+	}()
+	defer func() {
+		cancel()
+		<-renewed
+		// Let a standby take over right away instead of waiting for the
+		// lease to expire.
+		if app.appCtx.Err() != nil {
+			app.releaseLease(term)
+		}
+	}()
+
+	if app.resetBlockProduction("Initializing block production state", "term", term) {
+		return
+	}
+
+	for leaderCtx.Err() == nil {
+		err := app.produceBlock()
+		app.setConnectionStatus(err)
+
+		if err != nil {
+			switch {
+			case isFenced(err):
+				app.logger.Warn("Leader lease lost, stepping down", "term", term, "error", err)
+				return
+			case errors.Is(err, blockbuilder.ErrEmptyBlock):
+				time.Sleep(app.cfg.TxPoolPollingInterval)
+				continue
+			case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+				app.logger.Info("context canceled or deadline exceeded, stopping block production")
+				return
+			}
+			app.logger.Error(
+				"block production cycle failed",
+				"error", err,
+			)
+		}
+		if app.resetBlockProduction("Block production successful. Resetting state for next block.") {
+			return
+		}
+	}
+	if app.appCtx.Err() == nil {
+		app.logger.Warn("Stepped down as leader", "term", term)
+	}
+}
+
+// renewLease keeps the lease alive while leading, cancelling the leader
+// context once the lease could not be renewed before it expires.
+func (app *SingleNodeApp) renewLease(ctx context.Context, cancel context.CancelFunc, term uint64) {
+	ticker := time.NewTicker(app.cfg.LeaseDuration / 3)
+	defer ticker.Stop()
+
+	lastRenewal := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		renewCtx, renewCancel := context.WithTimeout(ctx, app.cfg.LeaseDuration/3)
+		err := app.leaderLease.RenewLease(renewCtx, app.cfg.InstanceID, term, app.cfg.LeaseDuration)
+		renewCancel()
+		switch {
+		case err == nil:
+			lastRenewal = time.Now()
+		case errors.Is(err, payloadstore.ErrNotLeader):
+			app.logger.Warn("Leader lease taken over by another instance", "term", term)
+			cancel()
+			return
+		case time.Since(lastRenewal) >= app.cfg.LeaseDuration:
+			app.logger.Error("Leader lease expired, stepping down", "term", term, "error", err)
+			cancel()
+			return
+		default:
+			app.logger.Warn("Failed to renew leader lease", "term", term, "error", err)
+		}
+	}
+}
+
+func (app *SingleNodeApp) releaseLease(term uint64) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := app.leaderLease.ReleaseLease(ctx, app.cfg.InstanceID, term); err != nil {
+		app.logger.Warn("Failed to release leader lease", "term", term, "error", err)
+	}
+}
@@ -0,0 +1,239 @@
+package singlenode
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/primev/mev-commit/cl/singlenode/payloadstore"
+	localstate "github.com/primev/mev-commit/cl/singlenode/state"
+	"github.com/primev/mev-commit/cl/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBuilder builds and applies the payloads by advancing its head.
+type fakeBuilder struct {
+	mu       sync.Mutex
+	stateMgr *localstate.LocalStateManager
+	head     *types.ExecutionHead
+}
+
+func (b *fakeBuilder) GetPayload(ctx context.Context) error {
+	b.mu.Lock()
+	height := b.head.BlockHeight + 1
+	b.mu.Unlock()
+	return b.stateMgr.SaveBlockStateAndPublishToStream(ctx, &types.BlockBuildState{
+		CurrentStep:      types.StepFinalizeBlock,
+		PayloadID:        fmt.Sprintf("payload-%d", height),
+		ExecutionPayload: fmt.Sprintf("execution-payload-%d", height),
+	})
+}
+
+func (b *fakeBuilder) FinalizeBlock(_ context.Context, _, _, _ string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.head = &types.ExecutionHead{BlockHeight: b.head.BlockHeight + 1}
+	return nil
+}
+
+func (b *fakeBuilder) GetExecutionHead() *types.ExecutionHead {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.head
+}
+
+func (b *fakeBuilder) SetExecutionHeadFromRPC(_ context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.head == nil {
+		b.head = &types.ExecutionHead{}
+	}
+	return nil
+}
+
+func (b *fakeBuilder) height() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.head.BlockHeight
+}
+
+// fakeLeaseStore implements the lease and the payload repository backed by
+// the same Postgres database.
+type fakeLeaseStore struct {
+	mu       sync.Mutex
+	holder   string
+	term     uint64
+	expired  bool
+	payloads map[uint64]types.PayloadInfo
+}
+
+func (s *fakeLeaseStore) AcquireLease(_ context.Context, holder string, _ time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holder != holder && !s.expired {
+		return 0, payloadstore.ErrNotLeader
+	}
+	s.holder, s.expired = holder, false
+	s.term++
+	return s.term, nil
+}
+
+func (s *fakeLeaseStore) RenewLease(_ context.Context, holder string, term uint64, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holder != holder || s.term != term {
+		return payloadstore.ErrNotLeader
+	}
+	return nil
+}
+
+func (s *fakeLeaseStore) ReleaseLease(_ context.Context, holder string, term uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holder == holder && s.term == term {
+		s.expired = true
+	}
+	return nil
+}
+
+func (s *fakeLeaseStore) SaveFencedPayload(_ context.Context, info *types.PayloadInfo, holder string, term uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holder != holder || s.term != term || s.expired {
+		return payloadstore.ErrNotLeader
+	}
+	if p, ok := s.payloads[info.BlockHeight]; ok && p.PayloadID != info.PayloadID {
+		return payloadstore.ErrPayloadConflict
+	}
+	s.payloads[info.BlockHeight] = *info
+	return nil
+}
+
+func (s *fakeLeaseStore) SavePayload(_ context.Context, _ *types.PayloadInfo) error {
+	return fmt.Errorf("unfenced save in leader election mode")
+}
+
+func (s *fakeLeaseStore) GetPayloadsSince(_ context.Context, sinceHeight uint64, limit int) ([]types.PayloadInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var payloads []types.PayloadInfo
+	for h := sinceHeight; len(payloads) < limit; h++ {
+		p, ok := s.payloads[h]
+		if !ok {
+			break
+		}
+		payloads = append(payloads, p)
+	}
+	return payloads, nil
+}
+
+func (s *fakeLeaseStore) GetPayloadByHeight(_ context.Context, height uint64) (*types.PayloadInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.payloads[height]; ok {
+		return &p, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *fakeLeaseStore) GetLatestPayload(_ context.Context) (*types.PayloadInfo, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (s *fakeLeaseStore) Close() error {
+	return nil
+}
+
+func (s *fakeLeaseStore) takeOver(holder string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.holder, s.expired = holder, false
+	s.term++
+}
+
+func (s *fakeLeaseStore) latestHeight() (uint64, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var latest uint64
+	for h := range s.payloads {
+		latest = max(latest, h)
+	}
+	return latest, s.holder
+}
+
+func TestFailover(t *testing.T) {
+	logger := setupTestLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stateMgr := localstate.NewLocalStateManager(logger)
+	builder := &fakeBuilder{stateMgr: stateMgr}
+	store := &fakeLeaseStore{
+		holder:   "other",
+		term:     1,
+		payloads: make(map[uint64]types.PayloadInfo),
+	}
+	for h := uint64(1); h <= 3; h++ {
+		store.payloads[h] = types.PayloadInfo{PayloadID: fmt.Sprintf("other-%d", h), BlockHeight: h}
+	}
+
+	app := &SingleNodeApp{
+		logger: logger,
+		cfg: Config{
+			InstanceID:            "node-1",
+			LeaseDuration:         30 * time.Millisecond,
+			TxPoolPollingInterval: time.Millisecond,
+		},
+		appCtx:       ctx,
+		cancel:       cancel,
+		blockBuilder: builder,
+		stateManager: stateMgr,
+		payloadRepo:  store,
+		leaderLease:  store,
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		app.runFailoverLoop()
+	}()
+
+	// The standby follows the payloads of the current leader.
+	require.Eventually(t, func() bool { return builder.height() == 3 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, uint64(0), app.leaderTerm.Load())
+
+	// Once the lease expires the standby takes over and resumes the chain.
+	store.mu.Lock()
+	store.expired = true
+	store.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		latest, holder := store.latestHeight()
+		return holder == "node-1" && latest >= 6
+	}, time.Second, 5*time.Millisecond)
+
+	// A new leader takes over, the fenced instance steps down and follows it.
+	store.takeOver("other")
+	require.Eventually(t, func() bool { return app.leaderTerm.Load() == 0 }, time.Second, 5*time.Millisecond)
+	height, _ := store.latestHeight()
+
+	store.mu.Lock()
+	store.payloads[height+1] = types.PayloadInfo{PayloadID: "other-next", BlockHeight: height + 1}
+	store.mu.Unlock()
+	require.Eventually(t, func() bool { return builder.height() == height+1 }, time.Second, 5*time.Millisecond)
+
+	latest, holder := store.latestHeight()
+	assert.Equal(t, "other", holder)
+	assert.Equal(t, height+1, latest)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for failover loop to exit")
+	}
+}
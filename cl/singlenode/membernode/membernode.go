@@ -1,18 +1,27 @@
 package membernode
 
 import (
+	"bufio"
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"math/big"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/primev/mev-commit/cl/blockbuilder"
 	"github.com/primev/mev-commit/cl/ethclient"
 	"github.com/primev/mev-commit/cl/singlenode/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const (
@@ -26,6 +35,17 @@ const (
 	initRetryInterval = 2 * time.Second
 	// Threshold to exit catch-up mode
 	catchUpExitThreshold = batchSize / 2
+	// Interval for polling local geth while it syncs to the checkpoint
+	checkpointPollInterval = 5 * time.Second
+)
+
+var (
+	rejectedPayloads = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "mev_commit",
+		Subsystem: "membernode",
+		Name:      "rejected_payloads_total",
+		Help:      "Number of payloads rejected because of an invalid leader signature",
+	})
 )
 
 // Config holds the configuration for the MemberNodeApp
@@ -36,6 +56,18 @@ type Config struct {
 	JWTSecret    string
 	HealthAddr   string
 	PollInterval time.Duration
+	// LeaderSigners are the pinned addresses of the leader keys. Payloads
+	// which are not signed by one of them are never applied.
+	LeaderSigners []common.Address
+	// SeedFile is an optional export of the leader payloads which is applied
+	// before following the leader, so that a new member can sync heights
+	// the leader no longer serves.
+	SeedFile string
+	// CheckpointHeight and CheckpointHash are an optional trusted block. A
+	// member behind it lets geth sync up to it from its peers, so payloads
+	// are only requested from the leader above the checkpoint.
+	CheckpointHeight uint64
+	CheckpointHash   common.Hash
 }
 
 // MemberNodeApp represents a member node that follows the leader sequentially
@@ -44,6 +76,7 @@ type MemberNodeApp struct {
 	cfg              Config
 	blockBuilder     *blockbuilder.BlockBuilder
 	payloadClient    *api.PayloadClient
+	verifier         *api.PayloadVerifier
 	engineClient     blockbuilder.EngineClient
 	appCtx           context.Context
 	cancel           context.CancelFunc
@@ -65,6 +98,11 @@ func NewMemberNodeApp(
 	cfg Config,
 	logger *slog.Logger,
 ) (*MemberNodeApp, error) {
+	verifier, err := api.NewPayloadVerifier(cfg.LeaderSigners)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payload verifier: %w", err)
+	}
+
 	ctx, cancel := context.WithCancel(parentCtx)
 
 	// Decode JWT secret
@@ -92,6 +130,7 @@ func NewMemberNodeApp(
 		cfg:                 cfg,
 		blockBuilder:        bb,
 		payloadClient:       payloadClient,
+		verifier:            verifier,
 		engineClient:        engineClient,
 		appCtx:              ctx,
 		cancel:              cancel,
@@ -155,6 +194,42 @@ func (app *MemberNodeApp) initializeStartingHeight() {
 				}
 			}
 
+			if app.cfg.CheckpointHeight > 0 {
+				checkpointHeight, err := app.bootstrapFromCheckpoint(localHeight)
+				if err != nil {
+					app.logger.Error(
+						"Failed to bootstrap from checkpoint",
+						"checkpoint_height", app.cfg.CheckpointHeight,
+						"checkpoint_hash", app.cfg.CheckpointHash,
+						"error", err,
+					)
+					app.cancel()
+					return
+				}
+				localHeight = checkpointHeight
+			}
+
+			// The payloads are validated against the local execution head.
+			if err := app.blockBuilder.SetExecutionHeadFromRPC(app.appCtx); err != nil {
+				app.logger.Warn("Failed to set execution head from local geth, retrying...", "error", err)
+				select {
+				case <-app.appCtx.Done():
+					return
+				case <-time.After(initRetryInterval):
+					continue
+				}
+			}
+
+			if app.cfg.SeedFile != "" {
+				seededHeight, err := app.seedFromFile(localHeight)
+				if err != nil {
+					app.logger.Error("Failed to seed payloads from file", "file", app.cfg.SeedFile, "error", err)
+					app.cancel()
+					return
+				}
+				localHeight = seededHeight
+			}
+
 			// Set lastProcessedHeight to current local height
 			// The processing loop will request localHeight + 1
 			app.processingMutex.Lock()
@@ -172,6 +247,139 @@ func (app *MemberNodeApp) initializeStartingHeight() {
 	}
 }
 
+// bootstrapFromCheckpoint makes local geth sync up to the trusted checkpoint
+// from its peers and returns the new local height. A local chain which already
+// reached the checkpoint height must contain the checkpoint block.
+func (app *MemberNodeApp) bootstrapFromCheckpoint(localHeight uint64) (uint64, error) {
+	checkpointHeight := app.cfg.CheckpointHeight
+	checkpointHash := app.cfg.CheckpointHash
+
+	if localHeight >= checkpointHeight {
+		if err := app.checkCheckpointBlock(); err != nil {
+			return 0, err
+		}
+		app.logger.Info(
+			"Local geth is past the checkpoint, skipping checkpoint sync",
+			"local_height", localHeight,
+			"checkpoint_height", checkpointHeight,
+		)
+		return localHeight, nil
+	}
+
+	app.logger.Info(
+		"Bootstrapping from checkpoint",
+		"local_height", localHeight,
+		"checkpoint_height", checkpointHeight,
+		"checkpoint_hash", checkpointHash,
+	)
+
+	ctx, cancel := context.WithTimeout(app.appCtx, apiCallTimeout)
+	payload, err := app.payloadClient.GetPayloadByHeight(ctx, checkpointHeight)
+	cancel()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get checkpoint payload at height %d: %w", checkpointHeight, err)
+	}
+	if _, err := app.verifier.Verify(payload); err != nil {
+		rejectedPayloads.Inc()
+		return 0, fmt.Errorf("checkpoint payload verification failed: %w", err)
+	}
+
+	if err := app.blockBuilder.StartCheckpointSync(app.appCtx, payload.ExecutionPayload, checkpointHash); err != nil {
+		return 0, err
+	}
+
+	// Geth only moves its head once the sync has completed.
+	ticker := time.NewTicker(checkpointPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-app.appCtx.Done():
+			return 0, app.appCtx.Err()
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(app.appCtx, apiCallTimeout)
+		height, err := app.getLocalGethHeight(ctx)
+		cancel()
+		if err != nil {
+			app.logger.Warn("Failed to get local geth height during checkpoint sync", "error", err)
+			continue
+		}
+		if height < checkpointHeight {
+			app.logger.Info(
+				"Waiting for local geth to sync to the checkpoint",
+				"local_height", height,
+				"checkpoint_height", checkpointHeight,
+			)
+			continue
+		}
+
+		if err := app.checkCheckpointBlock(); err != nil {
+			return 0, err
+		}
+		app.logger.Info("Local geth synced to the checkpoint", "local_height", height)
+		return height, nil
+	}
+}
+
+// checkCheckpointBlock checks that the local block at the checkpoint height is
+// the checkpoint block.
+func (app *MemberNodeApp) checkCheckpointBlock() error {
+	ctx, cancel := context.WithTimeout(app.appCtx, apiCallTimeout)
+	defer cancel()
+
+	header, err := app.engineClient.HeaderByNumber(ctx, new(big.Int).SetUint64(app.cfg.CheckpointHeight))
+	if err != nil {
+		return fmt.Errorf("failed to get local header at checkpoint height %d: %w", app.cfg.CheckpointHeight, err)
+	}
+	if header.Hash() != app.cfg.CheckpointHash {
+		return fmt.Errorf(
+			"local block %s at checkpoint height %d does not match checkpoint hash %s",
+			header.Hash(), app.cfg.CheckpointHeight, app.cfg.CheckpointHash,
+		)
+	}
+	return nil
+}
+
+// seedFromFile applies the payloads of the seed file above the local height
+// and returns the new local height. The payloads are verified like the ones
+// received from the leader.
+func (app *MemberNodeApp) seedFromFile(localHeight uint64) (uint64, error) {
+	f, err := os.Open(app.cfg.SeedFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open seed file: %w", err)
+	}
+	//nolint:errcheck
+	defer f.Close()
+
+	app.logger.Info("Seeding payloads from file", "file", app.cfg.SeedFile, "local_height", localHeight)
+
+	height := localHeight
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for {
+		var payload api.PayloadResponse
+		if err := dec.Decode(&payload); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, fmt.Errorf("failed to decode seed payload after height %d: %w", height, err)
+		}
+		if payload.BlockHeight <= height {
+			continue
+		}
+		if payload.BlockHeight != height+1 {
+			return 0, fmt.Errorf("seed file is missing payloads between heights %d and %d", height+1, payload.BlockHeight)
+		}
+		if err := app.processPayload(app.appCtx, &payload); err != nil {
+			return 0, err
+		}
+		height = payload.BlockHeight
+	}
+
+	app.logger.Info("Seeded payloads from file", "applied", height-localHeight, "height", height)
+	return height, nil
+}
+
 // Start begins the member node operation
 func (app *MemberNodeApp) Start() {
 	app.logger.Info("Starting MemberNodeApp...")
@@ -182,6 +390,7 @@ func (app *MemberNodeApp) Start() {
 		defer app.wg.Done()
 		mux := http.NewServeMux()
 		mux.HandleFunc("/health", app.healthHandler)
+		mux.Handle("/metrics", promhttp.Handler())
 		addr := app.cfg.HealthAddr
 		server := &http.Server{Addr: addr, Handler: mux}
 		app.logger.Info("Health endpoint listening", "address", addr)
@@ -553,8 +762,23 @@ func (app *MemberNodeApp) processPayload(ctx context.Context, payload *api.Paylo
 		"block_height", payload.BlockHeight,
 	)
 
+	// Verify the leader signature before the payload reaches the engine API
+	signer, err := app.verifier.Verify(payload)
+	if err != nil {
+		rejectedPayloads.Inc()
+		app.logger.Error(
+			"Rejected payload not signed by a pinned leader key",
+			"payload_id", payload.PayloadID,
+			"block_height", payload.BlockHeight,
+			"block_hash", payload.BlockHash,
+			"signer", signer.Hex(),
+			"error", err,
+		)
+		return fmt.Errorf("payload verification failed for height %d: %w", payload.BlockHeight, err)
+	}
+
 	// Apply payload to local geth client
-	err := app.blockBuilder.FinalizeBlock(ctx, payload.PayloadID, payload.ExecutionPayload, "")
+	err = app.blockBuilder.FinalizeBlock(ctx, payload.PayloadID, payload.ExecutionPayload, "")
 	if err != nil {
 		app.logger.Error(
 			"Failed to finalize block",
@@ -0,0 +1,176 @@
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/primev/mev-commit/cl/types"
+)
+
+var (
+	// ErrNotLeader is returned when the leader lease is held by another
+	// instance or the term of the caller is outdated.
+	ErrNotLeader = errors.New("leader lease is not held")
+	// ErrPayloadConflict is returned when a different payload was already
+	// saved at the same height.
+	ErrPayloadConflict = errors.New("a different payload is already saved at this height")
+)
+
+// leaseSchema creates the single row table holding the leader lease. The term
+// is incremented every time the lease is acquired and is used as the fencing
+// token of the payload writes.
+const leaseSchema = `
+	CREATE TABLE IF NOT EXISTS leader_lease (
+		id INT PRIMARY KEY CHECK (id = 1),
+		holder TEXT NOT NULL,
+		term BIGINT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	);
+
+	INSERT INTO leader_lease (id, holder, term, expires_at)
+	VALUES (1, '', 0, to_timestamp(0))
+	ON CONFLICT (id) DO NOTHING;
+`
+
+// AcquireLease acquires the leader lease for the holder if it is free or has
+// expired. A new term is started even if the holder already held the lease, so
+// that the writes of a previous run of the same instance are fenced off.
+func (r *PostgresRepository) AcquireLease(ctx context.Context, holder string, ttl time.Duration) (uint64, error) {
+	query := `
+		UPDATE leader_lease
+		SET holder = $1,
+		    term = term + 1,
+		    expires_at = NOW() + $2::BIGINT * INTERVAL '1 millisecond'
+		WHERE id = 1 AND (holder = $1 OR expires_at < NOW())
+		RETURNING term;
+	`
+
+	var term int64
+	err := r.pool.QueryRow(ctx, query, holder, ttl.Milliseconds()).Scan(&term)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrNotLeader
+		}
+		return 0, fmt.Errorf("failed to acquire leader lease: %w", err)
+	}
+
+	r.logger.Info("Acquired leader lease", "holder", holder, "term", term)
+	return uint64(term), nil
+}
+
+// RenewLease extends the lease of the holder as long as no other instance took
+// it over since the term was started.
+func (r *PostgresRepository) RenewLease(ctx context.Context, holder string, term uint64, ttl time.Duration) error {
+	query := `
+		UPDATE leader_lease
+		SET expires_at = NOW() + $3::BIGINT * INTERVAL '1 millisecond'
+		WHERE id = 1 AND holder = $1 AND term = $2;
+	`
+
+	result, err := r.pool.Exec(ctx, query, holder, int64(term), ttl.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("failed to renew leader lease: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrNotLeader
+	}
+	return nil
+}
+
+// ReleaseLease expires the lease of the holder so that a standby can take over
+// without waiting for the lease to time out.
+func (r *PostgresRepository) ReleaseLease(ctx context.Context, holder string, term uint64) error {
+	query := `
+		UPDATE leader_lease
+		SET expires_at = to_timestamp(0)
+		WHERE id = 1 AND holder = $1 AND term = $2;
+	`
+
+	if _, err := r.pool.Exec(ctx, query, holder, int64(term)); err != nil {
+		return fmt.Errorf("failed to release leader lease: %w", err)
+	}
+	r.logger.Info("Released leader lease", "holder", holder, "term", term)
+	return nil
+}
+
+// SaveFencedPayload saves the payload only if the holder still holds an
+// unexpired lease for the given term. The lease row is locked until the
+// payload is written, so the lease cannot be taken over in between. Unlike
+// SavePayload, an existing payload at the same height is never replaced.
+func (r *PostgresRepository) SaveFencedPayload(
+	ctx context.Context,
+	info *types.PayloadInfo,
+	holder string,
+	term uint64,
+) (retErr error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if retErr != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var currentTerm int64
+	err = tx.QueryRow(ctx, `
+		SELECT term
+		FROM leader_lease
+		WHERE id = 1 AND holder = $1 AND expires_at > NOW()
+		FOR SHARE;
+	`, holder).Scan(&currentTerm)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return ErrNotLeader
+	case err != nil:
+		return fmt.Errorf("failed to read leader lease: %w", err)
+	case uint64(currentTerm) != term:
+		return ErrNotLeader
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO execution_payloads (payload_id, raw_execution_payload, block_height)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (block_height) DO NOTHING;
+	`, info.PayloadID, info.ExecutionPayload, info.BlockHeight)
+	if err != nil {
+		return fmt.Errorf("failed to insert payload into postgres: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		// A retried save of the same payload is not a conflict.
+		var payloadID string
+		err := tx.QueryRow(ctx, `
+			SELECT payload_id FROM execution_payloads WHERE block_height = $1;
+		`, info.BlockHeight).Scan(&payloadID)
+		if err != nil {
+			return fmt.Errorf("failed to query payload by height %d: %w", info.BlockHeight, err)
+		}
+		if payloadID != info.PayloadID {
+			r.logger.Error(
+				"Conflicting payload already saved",
+				"block_height", info.BlockHeight,
+				"payload_id", info.PayloadID,
+				"saved_payload_id", payloadID,
+				"term", term,
+			)
+			return ErrPayloadConflict
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit payload: %w", err)
+	}
+
+	r.logger.Debug(
+		"Fenced payload saved to database",
+		"payload_id", info.PayloadID,
+		"block_height", info.BlockHeight,
+		"term", term,
+	)
+	return nil
+}
@@ -0,0 +1,217 @@
+package payloadstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/primev/mev-commit/cl/types"
+)
+
+// payloadKeyPrefix prefixes the payload keys, which are followed by the big
+// endian block height so that the keys are ordered by height.
+const payloadKeyPrefix = "p/"
+
+func payloadKey(height uint64) []byte {
+	key := make([]byte, len(payloadKeyPrefix)+8)
+	copy(key, payloadKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(payloadKeyPrefix):], height)
+	return key
+}
+
+// payloadKeyUpperBound is the exclusive upper bound of all the payload keys.
+var payloadKeyUpperBound = []byte("p0")
+
+// PebbleRepository implements the types.PayloadRepository interface on an
+// embedded pebble database, so that no database service is needed.
+type PebbleRepository struct {
+	db     *pebble.DB
+	logger *slog.Logger
+	// retainHeights is the number of latest heights kept, older payloads are
+	// pruned when a new one is saved. Zero keeps all the payloads.
+	retainHeights uint64
+}
+
+// NewPebbleRepository opens or creates the pebble database at the given path.
+func NewPebbleRepository(path string, retainHeights uint64, logger *slog.Logger) (*PebbleRepository, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pebble database: %w", err)
+	}
+	l := logger.With("component", "PebbleRepository")
+	l.Info("Opened pebble payload database", "path", path, "retain_heights", retainHeights)
+	return &PebbleRepository{db: db, logger: l, retainHeights: retainHeights}, nil
+}
+
+// SavePayload saves the payload, replacing any payload at the same height,
+// and prunes the heights outside of the retention window.
+func (r *PebbleRepository) SavePayload(_ context.Context, info *types.PayloadInfo) error {
+	if info.InsertedAt.IsZero() {
+		info.InsertedAt = time.Now().UTC()
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	batch := r.db.NewBatch()
+	//nolint:errcheck
+	defer batch.Close()
+
+	if err := batch.Set(payloadKey(info.BlockHeight), data, nil); err != nil {
+		return fmt.Errorf("failed to set payload: %w", err)
+	}
+	if r.retainHeights > 0 && info.BlockHeight >= r.retainHeights {
+		oldest := info.BlockHeight - r.retainHeights + 1
+		if err := batch.DeleteRange(payloadKey(0), payloadKey(oldest), nil); err != nil {
+			return fmt.Errorf("failed to prune payloads: %w", err)
+		}
+	}
+
+	// The payload is finalized right after it is saved, so it must be
+	// durable before that.
+	if err := batch.Commit(pebble.Sync); err != nil {
+		r.logger.Error(
+			"Failed to save payload to pebble",
+			"payload_id", info.PayloadID,
+			"block_height", info.BlockHeight,
+			"error", err,
+		)
+		return fmt.Errorf("failed to save payload: %w", err)
+	}
+
+	r.logger.Debug(
+		"Payload saved to pebble",
+		"payload_id", info.PayloadID,
+		"block_height", info.BlockHeight,
+	)
+	return nil
+}
+
+// GetPayloadsSince retrieves payloads with block height >= sinceHeight, ordered by block height
+func (r *PebbleRepository) GetPayloadsSince(_ context.Context, sinceHeight uint64, limit int) ([]types.PayloadInfo, error) {
+	iter, err := r.db.NewIter(&pebble.IterOptions{
+		LowerBound: payloadKey(sinceHeight),
+		UpperBound: payloadKeyUpperBound,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	//nolint:errcheck
+	defer iter.Close()
+
+	var payloads []types.PayloadInfo
+	for iter.First(); iter.Valid() && len(payloads) < limit; iter.Next() {
+		var payload types.PayloadInfo
+		if err := json.Unmarshal(iter.Value(), &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal payload: %w", err)
+		}
+		payloads = append(payloads, payload)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate payloads: %w", err)
+	}
+	return payloads, nil
+}
+
+// GetPayloadByHeight retrieves a specific payload by block height
+func (r *PebbleRepository) GetPayloadByHeight(_ context.Context, height uint64) (*types.PayloadInfo, error) {
+	buf, closer, err := r.db.Get(payloadKey(height))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, fmt.Errorf("failed to get payload by height %d: %w", height, err)
+	}
+	//nolint:errcheck
+	defer closer.Close()
+
+	var payload types.PayloadInfo
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// GetLatestPayload retrieves the most recent payload
+func (r *PebbleRepository) GetLatestPayload(_ context.Context) (*types.PayloadInfo, error) {
+	iter, err := r.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte(payloadKeyPrefix),
+		UpperBound: payloadKeyUpperBound,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	//nolint:errcheck
+	defer iter.Close()
+
+	if !iter.Last() {
+		if err := iter.Error(); err != nil {
+			return nil, fmt.Errorf("failed to iterate payloads: %w", err)
+		}
+		return nil, sql.ErrNoRows
+	}
+
+	var payload types.PayloadInfo
+	if err := json.Unmarshal(iter.Value(), &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// GetLatestHeight returns the height of the latest payload, or zero if there
+// are no payloads.
+func (r *PebbleRepository) GetLatestHeight(ctx context.Context) (uint64, error) {
+	payload, err := r.GetLatestPayload(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return payload.BlockHeight, nil
+}
+
+// Export calls fn for every payload with block height >= fromHeight, in
+// order, from a consistent snapshot of the database.
+func (r *PebbleRepository) Export(ctx context.Context, fromHeight uint64, fn func(*types.PayloadInfo) error) error {
+	snap := r.db.NewSnapshot()
+	//nolint:errcheck
+	defer snap.Close()
+
+	iter, err := snap.NewIter(&pebble.IterOptions{
+		LowerBound: payloadKey(fromHeight),
+		UpperBound: payloadKeyUpperBound,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	//nolint:errcheck
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var payload types.PayloadInfo
+		if err := json.Unmarshal(iter.Value(), &payload); err != nil {
+			return fmt.Errorf("unmarshal payload: %w", err)
+		}
+		if err := fn(&payload); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Close flushes and closes the database.
+func (r *PebbleRepository) Close() error {
+	r.logger.Info("Closing pebble payload database")
+	return errors.Join(r.db.Flush(), r.db.Close())
+}
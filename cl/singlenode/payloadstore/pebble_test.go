@@ -0,0 +1,123 @@
+package payloadstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/primev/mev-commit/cl/types"
+)
+
+func newTestPebbleRepo(t *testing.T, retainHeights uint64) *PebbleRepository {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	repo, err := NewPebbleRepository(t.TempDir(), retainHeights, logger)
+	if err != nil {
+		t.Fatalf("NewPebbleRepository error: %v", err)
+	}
+	t.Cleanup(func() { _ = repo.Close() })
+	return repo
+}
+
+func savePebblePayloads(t *testing.T, repo *PebbleRepository, heights ...uint64) {
+	t.Helper()
+
+	for _, h := range heights {
+		err := repo.SavePayload(context.Background(), &types.PayloadInfo{
+			PayloadID:        fmt.Sprintf("id-%d", h),
+			ExecutionPayload: fmt.Sprintf("payload-%d", h),
+			BlockHeight:      h,
+		})
+		if err != nil {
+			t.Fatalf("SavePayload(%d) error: %v", h, err)
+		}
+	}
+}
+
+func TestPebbleRepository(t *testing.T) {
+	repo := newTestPebbleRepo(t, 0)
+	ctx := context.Background()
+
+	if _, err := repo.GetLatestPayload(ctx); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("expected sql.ErrNoRows for empty repo, got %v", err)
+	}
+	h, err := repo.GetLatestHeight(ctx)
+	if err != nil || h != 0 {
+		t.Fatalf("expected latest height 0 for empty repo, got %d, %v", h, err)
+	}
+
+	// heights above 255 check that the keys are ordered numerically
+	savePebblePayloads(t, repo, 1, 2, 3, 256, 257)
+
+	latest, err := repo.GetLatestPayload(ctx)
+	if err != nil {
+		t.Fatalf("GetLatestPayload error: %v", err)
+	}
+	if latest.BlockHeight != 257 || latest.PayloadID != "id-257" {
+		t.Fatalf("unexpected latest payload: %+v", latest)
+	}
+	if latest.InsertedAt.IsZero() {
+		t.Fatal("expected InsertedAt to be set")
+	}
+
+	since, err := repo.GetPayloadsSince(ctx, 2, 3)
+	if err != nil {
+		t.Fatalf("GetPayloadsSince error: %v", err)
+	}
+	var got []uint64
+	for _, p := range since {
+		got = append(got, p.BlockHeight)
+	}
+	if fmt.Sprint(got) != "[2 3 256]" {
+		t.Fatalf("unexpected payloads since 2: %v", got)
+	}
+
+	p, err := repo.GetPayloadByHeight(ctx, 3)
+	if err != nil {
+		t.Fatalf("GetPayloadByHeight error: %v", err)
+	}
+	if p.ExecutionPayload != "payload-3" {
+		t.Fatalf("unexpected payload at height 3: %+v", p)
+	}
+	if _, err := repo.GetPayloadByHeight(ctx, 4); err != sql.ErrNoRows {
+		t.Fatalf("expected sql.ErrNoRows for missing height, got %v", err)
+	}
+}
+
+func TestPebbleRepositoryPruning(t *testing.T) {
+	repo := newTestPebbleRepo(t, 3)
+	ctx := context.Background()
+
+	savePebblePayloads(t, repo, 1, 2, 3, 4, 5)
+
+	payloads, err := repo.GetPayloadsSince(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("GetPayloadsSince error: %v", err)
+	}
+	if len(payloads) != 3 || payloads[0].BlockHeight != 3 {
+		t.Fatalf("expected heights 3-5 to be retained, got %+v", payloads)
+	}
+}
+
+func TestPebbleRepositoryExport(t *testing.T) {
+	repo := newTestPebbleRepo(t, 0)
+
+	savePebblePayloads(t, repo, 1, 2, 3, 4)
+
+	var exported []uint64
+	err := repo.Export(context.Background(), 2, func(p *types.PayloadInfo) error {
+		exported = append(exported, p.BlockHeight)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Export error: %v", err)
+	}
+	if fmt.Sprint(exported) != "[2 3 4]" {
+		t.Fatalf("unexpected exported heights: %v", exported)
+	}
+}
@@ -64,6 +64,10 @@ func NewPostgresRepository(ctx context.Context, dsn string, logger *slog.Logger)
 		l.Error("Failed to close database connection after error", "error", err)
 		return nil, fmt.Errorf("failed to create execution_payloads table: %w", err)
 	}
+	if _, err := pool.Exec(ctx, leaseSchema); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create leader_lease table: %w", err)
+	}
 	l.Info("Successfully connected to PostgreSQL and ensured table exists.")
 	return &PostgresRepository{pool: pool, logger: l}, nil
 }
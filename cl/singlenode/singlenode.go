@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/rpc"
@@ -52,12 +53,27 @@ type Config struct {
 	APIAddr                  string
 	NonAuthRpcURL            string
 	TxPoolPollingInterval    time.Duration
+	// PayloadSigningKey is the hex encoded private key used to sign the
+	// payloads served to the member nodes.
+	PayloadSigningKey string
+	// LeaderElection enables the HA mode, in which the instances sharing the
+	// Postgres payload store elect the block producer through a lease and
+	// the others follow its payloads.
+	LeaderElection bool
+	LeaseDuration  time.Duration
+	// PebblePath is the directory of the embedded payload database, used
+	// when neither Postgres nor Redis is configured.
+	PebblePath string
+	// PayloadRetention is the number of latest heights kept in the embedded
+	// payload database. Zero keeps all the payloads.
+	PayloadRetention uint64
 }
 
 type BlockBuilder interface {
 	GetPayload(ctx context.Context) error
 	FinalizeBlock(ctx context.Context, payloadID string, executionPayload string, extraData string) error
 	GetExecutionHead() *types.ExecutionHead
+	SetExecutionHeadFromRPC(ctx context.Context) error
 }
 
 // SingleNodeApp orchestrates block production for a single node.
@@ -69,6 +85,8 @@ type SingleNodeApp struct {
 	// it's not anticipated to use DB as all the state already in geth client
 	stateManager      *localstate.LocalStateManager
 	payloadRepo       types.PayloadRepository
+	leaderLease       LeaderLease
+	leaderTerm        atomic.Uint64
 	payloadServer     *api.PayloadServer
 	appCtx            context.Context
 	cancel            context.CancelFunc
@@ -128,7 +146,18 @@ func NewSingleNodeApp(
 		rpcClient,
 	)
 
-	var pRepo types.PayloadRepository
+	if cfg.LeaderElection && cfg.PostgresDSN == "" {
+		cancel()
+		return nil, errors.New("leader election requires the postgres payload store")
+	}
+	if cfg.LeaderElection && cfg.LeaseDuration == 0 {
+		cfg.LeaseDuration = DefaultLeaseDuration
+	}
+
+	var (
+		pRepo types.PayloadRepository
+		lease LeaderLease
+	)
 	if cfg.PostgresDSN != "" {
 		repo, err := payloadstore.NewPostgresRepository(ctx, cfg.PostgresDSN, logger)
 		if err != nil {
@@ -140,6 +169,10 @@ func NewSingleNodeApp(
 			return nil, fmt.Errorf("failed to initialize payload repository: %w", err)
 		}
 		pRepo = repo
+		if cfg.LeaderElection {
+			lease = repo
+			logger.Info("Leader election enabled", "lease_duration", cfg.LeaseDuration)
+		}
 		logger.Info("Payload repository initialized, payloads will be saved to PostgreSQL.")
 	} else if cfg.RedisURL != "" {
 		repo, err := payloadstore.NewRedisRepositoryFromURL(ctx, cfg.RedisURL, logger)
@@ -153,17 +186,46 @@ func NewSingleNodeApp(
 		}
 		pRepo = repo
 		logger.Info("Payload repository initialized, payloads will be saved to Redis.")
+	} else if cfg.PebblePath != "" {
+		repo, err := payloadstore.NewPebbleRepository(cfg.PebblePath, cfg.PayloadRetention, logger)
+		if err != nil {
+			cancel()
+			logger.Error(
+				"failed to create payload repository",
+				"error", err,
+			)
+			return nil, fmt.Errorf("failed to initialize payload repository: %w", err)
+		}
+		pRepo = repo
+		logger.Info("Payload repository initialized, payloads will be saved to the embedded pebble database.")
 	} else {
-		logger.Info("PostgresDSN or RedisURL not provided, payload saving to DB is disabled.")
+		logger.Info("PostgresDSN, RedisURL or PebblePath not provided, payload saving to DB is disabled.")
 	}
 
 	var payloadServer *api.PayloadServer
 	if cfg.APIAddr != "" {
+		var serverOpts []api.ServerOption
+		if cfg.PayloadSigningKey != "" {
+			signer, err := api.NewPayloadSigner(cfg.PayloadSigningKey)
+			if err != nil {
+				cancel()
+				logger.Error(
+					"failed to create payload signer",
+					"error", err,
+				)
+				return nil, err
+			}
+			serverOpts = append(serverOpts, api.WithPayloadSigner(signer))
+			logger.Info("Payloads served to member nodes will be signed", "signer", signer.Address().Hex())
+		} else {
+			logger.Warn("Payload signing key not provided, member nodes will reject the served payloads.")
+		}
 		payloadServer = api.NewPayloadServer(
 			cfg.APIAddr,
 			stateMgr,
 			pRepo,
 			logger.With("component", "APIServer"),
+			serverOpts...,
 		)
 		logger.Info("API server initialized for member nodes", "addr", cfg.APIAddr)
 	} else {
@@ -176,6 +238,7 @@ func NewSingleNodeApp(
 		blockBuilder:      bb,
 		stateManager:      stateMgr,
 		payloadRepo:       pRepo,
+		leaderLease:       lease,
 		payloadServer:     payloadServer,
 		appCtx:            ctx,
 		cancel:            cancel,
@@ -284,6 +347,10 @@ func (app *SingleNodeApp) Start() {
 		defer app.wg.Done()
 		defer app.logger.Info("SingleNodeApp run loop finished.")
 		defer close(app.runLoopStopped)
+		if app.leaderLease != nil {
+			app.runFailoverLoop()
+			return
+		}
 		app.runLoop()
 	}()
 }
@@ -370,12 +437,19 @@ func (app *SingleNodeApp) produceBlock() error {
 		saveCtx, saveCancel := context.WithTimeout(app.appCtx, 30*time.Second)
 		defer saveCancel()
 
-		saveStart := time.Now()
-		if err := app.payloadRepo.SavePayload(saveCtx, &types.PayloadInfo{
+		info := &types.PayloadInfo{
 			PayloadID:        currentState.PayloadID,
 			ExecutionPayload: currentState.ExecutionPayload,
 			BlockHeight:      blockHeight,
-		}); err != nil {
+		}
+		saveStart := time.Now()
+		if app.leaderLease != nil {
+			// The payload is only saved, and thus finalized, while the
+			// lease of the term is held.
+			if err := app.leaderLease.SaveFencedPayload(saveCtx, info, app.cfg.InstanceID, app.leaderTerm.Load()); err != nil {
+				return fmt.Errorf("failed to save payload: %w", err)
+			}
+		} else if err := app.payloadRepo.SavePayload(saveCtx, info); err != nil {
 			return fmt.Errorf("failed to save payload: %w", err)
 		}
 		saveDuration := time.Since(saveStart)
@@ -43,6 +43,11 @@ func (m *MockBlockBuilder) GetExecutionHead() *types.ExecutionHead {
 	return nil
 }
 
+func (m *MockBlockBuilder) SetExecutionHeadFromRPC(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // MockConnectionRefused provides a safe implementation for testing
 type MockConnectionRefused struct{}
 
@@ -216,6 +216,24 @@ var (
 		Value:   filepath.Join(defaultConfigDir, defaultKeystore),
 	})
 
+	optionRemoteSignerURL = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-url",
+		Usage:   "URL of the Web3Signer compatible signing service, if set the private key is never loaded in the oracle",
+		EnvVars: []string{"MEV_ORACLE_REMOTE_SIGNER_URL"},
+	})
+
+	optionRemoteSignerAddress = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "remote-signer-address",
+		Usage:   "address of the key to use on the remote signing service, required if it holds more than one key",
+		EnvVars: []string{"MEV_ORACLE_REMOTE_SIGNER_ADDRESS"},
+		Action: func(_ *cli.Context, s string) error {
+			if s != "" && !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid remote signer address: %s", s)
+			}
+			return nil
+		},
+	})
+
 	optionRegistrationAuthToken = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "register-provider-auth-token",
 		Usage:    "Authorization token for provider registration",
@@ -244,6 +262,27 @@ var (
 		Value:   "60000000", // 0.06 gWEI
 	})
 
+	optionFeeBumpInterval = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:    "fee-bump-interval",
+		Usage:   "Replace transactions pending for longer than the interval with bumped fees, 0 disables the replacements",
+		EnvVars: []string{"MEV_COMMIT_FEE_BUMP_INTERVAL"},
+		Value:   10 * time.Second,
+	})
+
+	optionMaxGasTipCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "max-gas-tip-cap",
+		Usage:   "Maximum gas tip cap of the transactions replaced with bumped fees",
+		EnvVars: []string{"MEV_COMMIT_MAX_GAS_TIP_CAP"},
+		Value:   "500000000", // 0.5 gWEI
+	})
+
+	optionMaxGasFeeCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "max-gas-fee-cap",
+		Usage:   "Maximum gas fee cap of the transactions replaced with bumped fees",
+		EnvVars: []string{"MEV_COMMIT_MAX_GAS_FEE_CAP"},
+		Value:   "600000000", // 0.6 gWEI
+	})
+
 	optionRelayUrls = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
 		Name:    "relay-urls",
 		Usage:   "URLs for relay",
@@ -257,12 +296,98 @@ var (
 		),
 	})
 
+	optionRelayQuorum = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "relay-quorum",
+		Usage:   "Number of relays which need to agree on the builder of a block, 0 uses the first relay which delivered the block",
+		EnvVars: []string{"MEV_ORACLE_RELAY_QUORUM"},
+		Value:   0,
+		Action: func(_ *cli.Context, v int) error {
+			if v < 0 {
+				return fmt.Errorf("relay-quorum must not be negative")
+			}
+			return nil
+		},
+	})
+
+	optionRelayQuorumAttempts = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:    "relay-quorum-attempts",
+		Usage:   "Number of times a block is queried without the relays reaching quorum before the block is recorded without a winner",
+		EnvVars: []string{"MEV_ORACLE_RELAY_QUORUM_ATTEMPTS"},
+		Value:   10,
+		Action: func(_ *cli.Context, v int) error {
+			if v < 1 {
+				return fmt.Errorf("relay-quorum-attempts must be at least 1")
+			}
+			return nil
+		},
+	})
+
+	optionRelayVerifyBidTraces = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:    "relay-verify-bid-traces",
+		Usage:   "Verify the fee recipient and the value of the relay bid traces against the L1 block, always enabled with a relay-quorum",
+		EnvVars: []string{"MEV_ORACLE_RELAY_VERIFY_BID_TRACES"},
+		Value:   false,
+	})
+
 	optionBidOptionsSlashEnabled = altsrc.NewBoolFlag(&cli.BoolFlag{
 		Name:    "bid-options-slash-enabled",
 		Usage:   "Enable slashing based on bid options (position constraints, shutterised bids)",
 		EnvVars: []string{"MEV_ORACLE_BID_OPTIONS_SLASH_ENABLED"},
 		Value:   false,
 	})
+
+	optionL1Confirmations = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "l1-confirmations",
+		Usage:   "Number of blocks behind the L1 tip after which the winner of a block is recorded",
+		EnvVars: []string{"MEV_ORACLE_L1_CONFIRMATIONS"},
+		Value:   1,
+		Action: func(_ *cli.Context, v uint64) error {
+			if v == 0 {
+				return fmt.Errorf("l1-confirmations must be greater than 0")
+			}
+			return nil
+		},
+	})
+
+	optionL1ReorgLookback = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "l1-reorg-lookback",
+		Usage:   "Number of recorded L1 blocks checked for reorgs, 0 disables reorg handling",
+		EnvVars: []string{"MEV_ORACLE_L1_REORG_LOOKBACK"},
+		Value:   64,
+	})
+
+	optionSettlementConfirmations = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:    "settlement-confirmations",
+		Usage:   "Number of blocks the settlement chain events must be deep before they are processed",
+		EnvVars: []string{"MEV_ORACLE_SETTLEMENT_CONFIRMATIONS"},
+		Value:   0,
+	})
+)
+
+var (
+	optionAuditFromBlock = &cli.Uint64Flag{
+		Name:     "from-block",
+		Usage:    "first settlement chain block of the audited OpenedCommitmentStored events",
+		Required: true,
+	}
+
+	optionAuditToBlock = &cli.Uint64Flag{
+		Name:     "to-block",
+		Usage:    "last settlement chain block of the audited OpenedCommitmentStored events",
+		Required: true,
+	}
+
+	optionAuditFormat = &cli.StringFlag{
+		Name:   "format",
+		Usage:  "format of the audit report, options are 'csv' or 'json'",
+		Value:  node.AuditFormatCSV,
+		Action: stringInCheck("format", []string{node.AuditFormatCSV, node.AuditFormatJSON}),
+	}
+
+	optionAuditOutput = &cli.StringFlag{
+		Name:  "output",
+		Usage: "path of the audit report file, the report is written to stdout if not set",
+	}
 )
 
 func main() {
@@ -290,12 +415,23 @@ func main() {
 		optionOverrideWinners,
 		optionKeystorePath,
 		optionKeystorePassword,
+		optionRemoteSignerURL,
+		optionRemoteSignerAddress,
 		optionRegistrationAuthToken,
 		optionGasLimit,
 		optionGasTipCap,
 		optionGasFeeCap,
+		optionFeeBumpInterval,
+		optionMaxGasTipCap,
+		optionMaxGasFeeCap,
 		optionRelayUrls,
+		optionRelayQuorum,
+		optionRelayQuorumAttempts,
+		optionRelayVerifyBidTraces,
 		optionBidOptionsSlashEnabled,
+		optionL1Confirmations,
+		optionL1ReorgLookback,
+		optionSettlementConfirmations,
 	}
 	app := &cli.App{
 		Name:  "mev-oracle",
@@ -310,6 +446,21 @@ func main() {
 					return initializeApplication(c)
 				},
 			},
+			{
+				Name:  "audit",
+				Usage: "Run the settlement pipeline against past commitments without sending transactions and report the differences with the recorded settlements",
+				Flags: append(
+					flags,
+					optionAuditFromBlock,
+					optionAuditToBlock,
+					optionAuditFormat,
+					optionAuditOutput,
+				),
+				Before: altsrc.InitInputSourceWithContext(flags, altsrc.NewYamlSourceFromFlagFunc(optionConfig.Name)),
+				Action: func(c *cli.Context) error {
+					return runAudit(c)
+				},
+			},
 		},
 	}
 
@@ -393,6 +544,20 @@ func launchOracleWithConfig(c *cli.Context) error {
 		}
 	}
 
+	maxGasTipCap, ok := new(big.Int).SetString(c.String(optionMaxGasTipCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas tip cap %q", c.String(optionMaxGasTipCap.Name))
+	}
+	maxGasFeeCap, ok := new(big.Int).SetString(c.String(optionMaxGasFeeCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas fee cap %q", c.String(optionMaxGasFeeCap.Name))
+	}
+
+	relayUrls := c.StringSlice(optionRelayUrls.Name)
+	if quorum := c.Int(optionRelayQuorum.Name); quorum > len(relayUrls) {
+		return fmt.Errorf("relay quorum %d is greater than the number of relays %d", quorum, len(relayUrls))
+	}
+
 	nd, err := node.NewNode(&node.Options{
 		Logger:                       logger,
 		KeySigner:                    keySigner,
@@ -415,8 +580,17 @@ func launchOracleWithConfig(c *cli.Context) error {
 		DefaultGasLimit:              uint64(c.Int(optionGasLimit.Name)),
 		DefaultGasTipCap:             gasTipCap,
 		DefaultGasFeeCap:             gasFeeCap,
-		RelayUrls:                    c.StringSlice(optionRelayUrls.Name),
+		FeeBumpInterval:              c.Duration(optionFeeBumpInterval.Name),
+		MaxGasTipCap:                 maxGasTipCap,
+		MaxGasFeeCap:                 maxGasFeeCap,
+		RelayUrls:                    relayUrls,
+		RelayQuorum:                  c.Int(optionRelayQuorum.Name),
+		RelayQuorumAttempts:          c.Int(optionRelayQuorumAttempts.Name),
+		RelayVerifyBidTraces:         c.Bool(optionRelayVerifyBidTraces.Name),
 		BidOptionsSlashEnabled:       c.Bool(optionBidOptionsSlashEnabled.Name),
+		L1Confirmations:              c.Uint64(optionL1Confirmations.Name),
+		L1ReorgLookback:              c.Uint64(optionL1ReorgLookback.Name),
+		SettlementConfirmations:      c.Uint64(optionSettlementConfirmations.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("failed starting node: %w", err)
@@ -444,6 +618,66 @@ func launchOracleWithConfig(c *cli.Context) error {
 	return nil
 }
 
+// runAudit replays the settlement decisions for a settlement chain block range
+// and writes the audit report.
+func runAudit(c *cli.Context) error {
+	// The logs are written to stderr so that the report can be written to stdout.
+	logger, err := newLogger(
+		c.String(optionLogLevel.Name),
+		c.String(optionLogFmt.Name),
+		c.String(optionLogTags.Name),
+		c.App.ErrWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	rpcURL := c.String(optionSettlementRPCUrlHTTP.Name)
+	if c.IsSet(optionSettlementRPCUrlWS.Name) {
+		rpcURL = c.String(optionSettlementRPCUrlWS.Name)
+	}
+	if rpcURL == "" {
+		return fmt.Errorf("settlement rpc url is empty")
+	}
+
+	output := c.App.Writer
+	if path := c.String(optionAuditOutput.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create audit report file: %w", err)
+		}
+		//nolint:errcheck
+		defer f.Close()
+		output = f
+	}
+
+	err = node.Audit(c.Context, &node.Options{
+		Logger:                       logger,
+		SettlementRPCUrl:             rpcURL,
+		L1RPCUrls:                    c.StringSlice(optionL1RPCUrls.Name),
+		OracleContractAddr:           common.HexToAddress(c.String(optionOracleContractAddr.Name)),
+		PreconfContractAddr:          common.HexToAddress(c.String(optionPreconfContractAddr.Name)),
+		BlockTrackerContractAddr:     common.HexToAddress(c.String(optionBlockTrackerContractAddr.Name)),
+		ProviderRegistryContractAddr: common.HexToAddress(c.String(optionProviderRegistryContractAddr.Name)),
+		BidderRegistryContractAddr:   common.HexToAddress(c.String(optionBidderRegistryContractAddr.Name)),
+		PgHost:                       c.String(optionPgHost.Name),
+		PgPort:                       c.Int(optionPgPort.Name),
+		PgUser:                       c.String(optionPgUser.Name),
+		PgPassword:                   c.String(optionPgPassword.Name),
+		PgDbname:                     c.String(optionPgDbname.Name),
+		BidOptionsSlashEnabled:       c.Bool(optionBidOptionsSlashEnabled.Name),
+	}, &node.AuditOptions{
+		FromBlock: c.Uint64(optionAuditFromBlock.Name),
+		ToBlock:   c.Uint64(optionAuditToBlock.Name),
+		Format:    c.String(optionAuditFormat.Name),
+		Output:    output,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to audit settlements: %w", err)
+	}
+	return nil
+}
+
 // newLogger initializes a *slog.Logger with specified level, format, and sink.
 //   - lvl: string representation of slog.Level
 //   - logFmt: format of the log output: "text", "json", "none" defaults to "json"
@@ -492,6 +726,13 @@ func newLogger(lvl, logFmt, tags string, sink io.Writer) (*slog.Logger, error) {
 }
 
 func setupKeySigner(c *cli.Context) (keysigner.KeySigner, error) {
+	if c.IsSet(optionRemoteSignerURL.Name) {
+		return keysigner.NewRemoteSigner(
+			c.String(optionRemoteSignerURL.Name),
+			common.HexToAddress(c.String(optionRemoteSignerAddress.Name)),
+			0,
+		)
+	}
 	if c.IsSet(optionKeystorePath.Name) {
 		return keysigner.NewKeystoreSigner(c.String(optionKeystorePath.Name), c.String(optionKeystorePassword.Name))
 	}
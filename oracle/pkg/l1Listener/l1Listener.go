@@ -3,19 +3,15 @@ package l1Listener
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"math/big"
-	"net/http"
-	"net/url"
-	"strconv"
+	"slices"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	blocktracker "github.com/primev/mev-commit/contracts-abi/clients/BlockTracker"
 	"github.com/primev/mev-commit/oracle/pkg/store"
@@ -26,6 +22,16 @@ import (
 
 var checkInterval = 2 * time.Second
 
+const (
+	// defaultConfirmations is the number of blocks behind the tip after which
+	// a block is recorded. The tip itself is never recorded as its hash is
+	// only known from the parent hash of the next header.
+	defaultConfirmations = 1
+	// defaultReorgLookback is the number of recorded blocks for which the
+	// hash is remembered to detect reorgs.
+	defaultReorgLookback = 64
+)
+
 type L1Recorder interface {
 	RecordL1Block(blockNum *big.Int, winner []byte) (*types.Transaction, error)
 }
@@ -33,6 +39,8 @@ type L1Recorder interface {
 type WinnerRegister interface {
 	RegisterWinner(ctx context.Context, blockNum int64, winner []byte) error
 	LastWinnerBlock() (int64, error)
+	AddRecordedBlock(ctx context.Context, blockNum int64, blockHash common.Hash, builderPubKey string) error
+	RecordedBlocks(ctx context.Context, fromBlock int64) ([]store.RecordedBlock, error)
 }
 
 type EthClient interface {
@@ -50,6 +58,39 @@ type L1Listener struct {
 	metrics        *metrics
 	relayQuerier   RelayQuerier
 	builderData    map[int64]string
+	confirmations  uint64
+	reorgLookback  uint64
+	// recorded holds the canonical hash and the winner posted for the
+	// recently recorded blocks. It is only accessed from watchL1Block and is
+	// loaded from the winner register on start.
+	recorded map[uint64]recordedBlock
+}
+
+type recordedBlock struct {
+	hash          common.Hash
+	builderPubKey string
+}
+
+type Option func(*L1Listener)
+
+// WithConfirmations sets the number of blocks behind the tip after which a
+// block is recorded. Larger values reduce the chance of recording a block
+// which is later reorged out at the cost of latency.
+func WithConfirmations(confirmations uint64) Option {
+	return func(l *L1Listener) {
+		if confirmations > 0 {
+			l.confirmations = confirmations
+		}
+	}
+}
+
+// WithReorgLookback sets the number of recorded blocks which are checked for
+// reorgs. If a recorded block is found to be reorged out, the winner is
+// queried again and a corrected winner is posted.
+func WithReorgLookback(lookback uint64) Option {
+	return func(l *L1Listener) {
+		l.reorgLookback = lookback
+	}
 }
 
 type RelayData struct {
@@ -68,8 +109,9 @@ func NewL1Listener(
 	evtMgr events.EventManager,
 	recorder L1Recorder,
 	relayQuerier RelayQuerier,
+	opts ...Option,
 ) *L1Listener {
-	return &L1Listener{
+	l := &L1Listener{
 		logger:         logger,
 		l1Client:       l1Client,
 		winnerRegister: winnerRegister,
@@ -78,7 +120,14 @@ func NewL1Listener(
 		metrics:        newMetrics(),
 		relayQuerier:   relayQuerier,
 		builderData:    make(map[int64]string),
+		confirmations:  defaultConfirmations,
+		reorgLookback:  defaultReorgLookback,
+		recorded:       make(map[uint64]recordedBlock),
+	}
+	for _, opt := range opts {
+		opt(l)
 	}
+	return l
 }
 
 func (l *L1Listener) Metrics() []prometheus.Collector {
@@ -169,171 +218,233 @@ func (l *L1Listener) watchL1Block(ctx context.Context) error {
 			return err
 		}
 	}
+	if err := l.loadRecorded(ctx, currentBlockNo); err != nil {
+		l.logger.Error("failed to load recorded blocks", "error", err)
+		return err
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
-			blockNum, err := l.l1Client.BlockNumber(ctx)
+			tip, err := l.l1Client.BlockNumber(ctx)
 			if err != nil {
 				l.logger.Error("failed to get block number", "error", err)
 				continue
 			}
-			// We need to get the previous block number because the current block has finalized header
-			blockNum = blockNum - 1
+			if tip < l.confirmations {
+				continue
+			}
+
+			if err := l.handleReorgs(ctx); err != nil {
+				l.logger.Error("failed to check for reorgs", "error", err)
+			}
+
+			// The block at the tip does not have a finalized header yet, so
+			// blocks are only recorded once they are confirmations deep.
+			blockNum := tip - l.confirmations
 
 			if blockNum <= uint64(currentBlockNo) {
 				continue
 			}
 
 			for b := uint64(currentBlockNo) + 1; b <= blockNum; b++ {
-				header, err := l.l1Client.HeaderByNumber(ctx, new(big.Int).SetUint64(b+1))
+				// The remaining blocks are retried on the next tick so that
+				// the winners are recorded in order.
+				hash, err := l.canonicalHash(ctx, b)
 				if err != nil {
 					l.logger.Error("failed to get header", "block", b, "error", err)
-					continue
-				}
-				// End of changes needed to be done.
-				var builderPubKey string
-				l.logger.Info("querying relay", "block", b, "hash", header.ParentHash.Hex())
-				builderPubKey, err = l.relayQuerier.Query(ctx, int64(b), header.ParentHash.Hex())
-				if err != nil {
-					l.logger.Info("block not found in relay, assuming out of PBS block", "block", b, "error", err)
-					builderPubKey = "" // Set a default value in case of failure
+					blockNum = b - 1
+					break
 				}
-
-				builderPubKey = strings.TrimPrefix(builderPubKey, "0x")
-
-				builderPubKeyBytes, err := hex.DecodeString(builderPubKey)
+				builderPubKey, err := l.queryWinner(ctx, b, hash)
 				if err != nil {
-					l.logger.Error("failed to decode builder pubkey", "block", b, "builder_pubkey", builderPubKey, "error", err)
+					l.logger.Error("failed to query winner", "block", b, "error", err)
+					blockNum = b - 1
+					break
 				}
-
-				l.logger.Info(
-					"new L1 winner",
-					"block", header.Number.Int64()-1,
-					"builder_pubkey", builderPubKey,
-				)
-
-				winnerPostingTxn, err := l.recorder.RecordL1Block(
-					big.NewInt(0).SetUint64(b),
-					builderPubKeyBytes,
-				)
-				if err != nil {
+				if err := l.recordWinner(ctx, b, hash, builderPubKey); err != nil {
 					l.logger.Error("failed to register winner for block", "block", b, "error", err)
-					continue
+					blockNum = b - 1
+					break
 				}
-
-				l.metrics.WinnerPostedCount.Inc()
-				l.metrics.LastSentNonce.Set(float64(winnerPostingTxn.Nonce()))
-
-				l.logger.Info(
-					"registered winner",
-					"block", header.Number.Int64()-1,
-					"txn", winnerPostingTxn.Hash().String(),
-				)
 			}
 
 			currentBlockNo = int64(blockNum)
+			l.pruneRecorded(blockNum)
 		}
 	}
 }
 
-type RelayQuerier interface {
-	Query(ctx context.Context, blockNumber int64, blockHash string) (string, error)
+// canonicalHash returns the hash of the given block on the current canonical
+// chain. It is read from the parent hash of the next header.
+func (l *L1Listener) canonicalHash(ctx context.Context, blockNum uint64) (common.Hash, error) {
+	header, err := l.l1Client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNum+1))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ParentHash, nil
 }
 
-type RelayQueryEngine struct {
-	relayUrls []string
-	logger    *slog.Logger
+// queryWinner queries the relays for the builder of the block with the given
+// hash. An empty builder is returned for blocks built outside of PBS. An error
+// is returned only if the relays could not agree on the winner, in which case
+// the block should be queried again later.
+func (l *L1Listener) queryWinner(ctx context.Context, blockNum uint64, hash common.Hash) (string, error) {
+	l.logger.Info("querying relay", "block", blockNum, "hash", hash.Hex())
+	builderPubKey, err := l.relayQuerier.Query(ctx, int64(blockNum), hash.Hex())
+	switch {
+	case errors.Is(err, ErrNoWinner):
+		l.logger.Error("relays did not agree on the winner, recording no winner", "block", blockNum, "error", err)
+		builderPubKey = ""
+	case errors.Is(err, ErrNoQuorum), errors.Is(err, context.Canceled):
+		return "", err
+	case err != nil:
+		l.logger.Info("block not found in relay, assuming out of PBS block", "block", blockNum, "error", err)
+		builderPubKey = "" // Set a default value in case of failure
+	}
+	return strings.TrimPrefix(builderPubKey, "0x"), nil
 }
 
-func NewRelayQueryEngine(relayUrls []string, logger *slog.Logger) RelayQuerier {
-	return &RelayQueryEngine{
-		relayUrls: relayUrls,
-		logger:    logger,
+// loadRecorded restores the hashes of the blocks within the reorg lookback of
+// the last winner, so that they are still checked for reorgs after a restart.
+// The blocks after the last winner are recorded again and are not loaded.
+func (l *L1Listener) loadRecorded(ctx context.Context, lastWinnerBlock int64) error {
+	if l.reorgLookback == 0 {
+		return nil
+	}
+	blocks, err := l.winnerRegister.RecordedBlocks(ctx, lastWinnerBlock-int64(l.reorgLookback))
+	if err != nil {
+		return err
 	}
+	for _, b := range blocks {
+		if b.BlockNumber > lastWinnerBlock {
+			break
+		}
+		l.recorded[uint64(b.BlockNumber)] = recordedBlock{hash: b.BlockHash, builderPubKey: b.BuilderPubKey}
+	}
+	return nil
 }
 
-func (m *RelayQueryEngine) Query(ctx context.Context, blockNumber int64, blockHash string) (string, error) {
-	var wg sync.WaitGroup
-	resultChan := make(chan string, len(m.relayUrls))
+// recordWinner posts the builder of the block to the block tracker and
+// remembers the block hash to detect reorgs. The hash is stored before the
+// winner is posted, so that a posted winner is always checked for reorgs.
+func (l *L1Listener) recordWinner(ctx context.Context, blockNum uint64, hash common.Hash, builderPubKey string) error {
+	builderPubKeyBytes, err := hex.DecodeString(builderPubKey)
+	if err != nil {
+		l.logger.Error("failed to decode builder pubkey", "block", blockNum, "builder_pubkey", builderPubKey, "error", err)
+	}
 
-	for _, u := range m.relayUrls {
-		wg.Add(1)
-		go func(u string) {
-			defer wg.Done()
-			apiPath := "/relay/v1/data/bidtraces/proposer_payload_delivered"
-			baseURL, err := url.Parse(u)
-			if err != nil {
-				m.logger.Error("failed to parse relay URL", "url", u, "error", err)
-				return
-			}
-			baseURL.Path = baseURL.Path + apiPath
+	l.logger.Info(
+		"new L1 winner",
+		"block", blockNum,
+		"builder_pubkey", builderPubKey,
+	)
 
-			query := url.Values{}
-			query.Add("block_number", strconv.Itoa(int(blockNumber)))
+	if err := l.winnerRegister.AddRecordedBlock(ctx, int64(blockNum), hash, builderPubKey); err != nil {
+		return fmt.Errorf("storing hash of block %d: %w", blockNum, err)
+	}
 
-			baseURL.RawQuery = query.Encode()
-			m.logger.Debug("querying relay", "url", baseURL.String())
+	winnerPostingTxn, err := l.recorder.RecordL1Block(
+		big.NewInt(0).SetUint64(blockNum),
+		builderPubKeyBytes,
+	)
+	if err != nil {
+		return err
+	}
 
-			req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.String(), nil)
-			if err != nil {
-				m.logger.Error("failed to create request", "url", baseURL.String(), "error", err)
-				return
-			}
+	l.recorded[blockNum] = recordedBlock{hash: hash, builderPubKey: builderPubKey}
+	l.metrics.WinnerPostedCount.Inc()
+	l.metrics.LastSentNonce.Set(float64(winnerPostingTxn.Nonce()))
 
-			resp, err := http.DefaultClient.Do(req)
-			if err != nil {
-				m.logger.Error("failed to fetch data from relay", "url", baseURL.String(), "error", err)
-				return
-			}
-			//nolint:errcheck
-			defer resp.Body.Close()
-			m.logger.Info("received response from relay", "url", baseURL.String(), "status", resp.Status)
+	l.logger.Info(
+		"registered winner",
+		"block", blockNum,
+		"txn", winnerPostingTxn.Hash().String(),
+	)
+	return nil
+}
 
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				m.logger.Error("failed to read response body", "url", baseURL.String(), "error", err)
-				return
-			}
+// handleReorgs checks if the recently recorded blocks are still part of the
+// canonical chain. As the hashes form a chain, it walks back from the latest
+// recorded block until a block with a matching hash is found. Every block on
+// the way was reorged out and its winner is queried again. A corrected winner
+// is posted only if the builder changed.
+func (l *L1Listener) handleReorgs(ctx context.Context) error {
+	if l.reorgLookback == 0 || len(l.recorded) == 0 {
+		return nil
+	}
 
-			var data []map[string]interface{}
-			if err := json.Unmarshal(body, &data); err != nil {
-				m.logger.Error("failed to unmarshal response", "url", baseURL.String(), "error", err)
-				return
-			}
+	heights := make([]uint64, 0, len(l.recorded))
+	for h := range l.recorded {
+		heights = append(heights, h)
+	}
+	slices.Sort(heights)
+
+	var reorged []uint64
+	newHashes := make(map[uint64]common.Hash)
+	for i := len(heights) - 1; i >= 0; i-- {
+		h := heights[i]
+		hash, err := l.canonicalHash(ctx, h)
+		if err != nil {
+			return fmt.Errorf("getting canonical hash of block %d: %w", h, err)
+		}
+		if hash == l.recorded[h].hash {
+			break
+		}
+		reorged = append(reorged, h)
+		newHashes[h] = hash
+	}
+	if len(reorged) == 0 {
+		return nil
+	}
 
-			for _, item := range data {
-				blockNum, ok := item["block_number"].(string)
-				if !ok {
-					m.logger.Error("block_number is not a string", "block_number", item["block_number"])
-					continue
-				}
-				blockNumInt, err := strconv.Atoi(blockNum)
-				if err != nil {
-					m.logger.Error("failed to convert block_number to int", "block_number", blockNum, "error", err)
-					continue
-				}
-				if blockNumInt == int(blockNumber) && item["block_hash"] == blockHash {
-					resultChan <- fmt.Sprintf("%v", item["builder_pubkey"])
-					return
-				}
+	l.logger.Warn("L1 reorg detected", "depth", len(reorged), "from_block", reorged[len(reorged)-1])
+	l.metrics.ReorgCount.Inc()
+	l.metrics.ReorgDepth.Observe(float64(len(reorged)))
+
+	slices.Reverse(reorged)
+	for _, h := range reorged {
+		prev := l.recorded[h]
+		hash := newHashes[h]
+
+		builderPubKey, err := l.queryWinner(ctx, h, hash)
+		if err != nil {
+			return fmt.Errorf("querying winner of block %d: %w", h, err)
+		}
+		if builderPubKey == prev.builderPubKey {
+			l.logger.Info("winner unchanged after reorg", "block", h, "hash", hash.Hex())
+			if err := l.winnerRegister.AddRecordedBlock(ctx, int64(h), hash, builderPubKey); err != nil {
+				return fmt.Errorf("storing hash of block %d: %w", h, err)
 			}
-		}(u)
-	}
+			l.recorded[h] = recordedBlock{hash: hash, builderPubKey: builderPubKey}
+			continue
+		}
 
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+		l.logger.Warn(
+			"correcting winner after reorg",
+			"block", h,
+			"old_hash", prev.hash.Hex(),
+			"new_hash", hash.Hex(),
+			"old_builder_pubkey", prev.builderPubKey,
+			"new_builder_pubkey", builderPubKey,
+		)
+		if err := l.recordWinner(ctx, h, hash, builderPubKey); err != nil {
+			return fmt.Errorf("recording corrected winner for block %d: %w", h, err)
+		}
+		l.metrics.WinnerCorrectedCount.Inc()
+	}
+	return nil
+}
 
-	select {
-	case <-ctx.Done():
-		return "", ctx.Err()
-	case result, ok := <-resultChan:
-		if !ok {
-			return "", errors.New("no matching block found")
+// pruneRecorded forgets the blocks which are too deep to be checked for reorgs.
+func (l *L1Listener) pruneRecorded(latest uint64) {
+	if latest < l.reorgLookback {
+		return
+	}
+	for h := range l.recorded {
+		if h <= latest-l.reorgLookback {
+			delete(l.recorded, h)
 		}
-		return result, nil
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	blocktracker "github.com/primev/mev-commit/contracts-abi/clients/BlockTracker"
 	"github.com/primev/mev-commit/oracle/pkg/l1Listener"
+	"github.com/primev/mev-commit/oracle/pkg/store"
 	"github.com/primev/mev-commit/x/contracts/events"
 	"github.com/primev/mev-commit/x/util"
 )
@@ -135,6 +136,177 @@ func TestL1Listener(t *testing.T) {
 	}
 }
 
+func TestL1ListenerReorg(t *testing.T) {
+	t.Parallel()
+
+	reg := &testRegister{
+		winners: make(chan winnerObj),
+	}
+	ethClient := &testEthClient{
+		headers: make(map[uint64]*types.Header),
+		errC:    make(chan error, 1),
+	}
+	btABI, err := abi.JSON(strings.NewReader(blocktracker.BlocktrackerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventManager := events.NewListener(
+		util.NewTestLogger(io.Discard),
+		&btABI,
+	)
+	rec := &testRecorder{
+		updates: make(chan l1Update),
+	}
+
+	oldBuilder := "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"
+	newBuilder := "9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba"
+
+	testRelayQuerier := &testRelayQuerier{
+		responses: map[int64]string{1: "0x" + oldBuilder},
+	}
+
+	l := l1Listener.NewL1Listener(
+		util.NewTestLogger(io.Discard),
+		ethClient,
+		reg,
+		eventManager,
+		rec,
+		testRelayQuerier,
+		l1Listener.WithReorgLookback(8),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cl := l1Listener.SetCheckInterval(100 * time.Millisecond)
+	t.Cleanup(cl)
+
+	done := l.Start(ctx)
+
+	ethClient.AddHeader(2, &types.Header{
+		Number:     big.NewInt(2),
+		ParentHash: common.HexToHash("0x01"),
+	})
+
+	waitUpdate := func(builder string) {
+		t.Helper()
+
+		want, err := hex.DecodeString(builder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-time.After(10 * time.Second):
+			t.Fatal("timeout waiting for winner")
+		case update := <-rec.updates:
+			if update.blockNum.Int64() != 1 {
+				t.Fatalf("wrong block number: %d", update.blockNum.Int64())
+			}
+			if !bytes.Equal(update.winner, want) {
+				t.Fatalf("wrong winner: %x", update.winner)
+			}
+		}
+	}
+
+	waitUpdate(oldBuilder)
+
+	// block 1 is reorged out and the new block was built by another builder
+	testRelayQuerier.SetResponse(1, "0x"+newBuilder)
+	ethClient.AddHeader(2, &types.Header{
+		Number:     big.NewInt(2),
+		ParentHash: common.HexToHash("0x02"),
+	})
+
+	waitUpdate(newBuilder)
+
+	cancel()
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for done")
+	case <-done:
+	}
+}
+
+func TestL1ListenerReorgAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	oldBuilder := "abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890"
+	newBuilder := "9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210fedcba"
+
+	// block 1 was recorded before the restart and was reorged out since
+	reg := &testRegister{
+		winners:   make(chan winnerObj),
+		lastBlock: 1,
+		recorded: map[int64]store.RecordedBlock{
+			1: {BlockNumber: 1, BlockHash: common.HexToHash("0x01"), BuilderPubKey: oldBuilder},
+		},
+	}
+	ethClient := &testEthClient{
+		headers: make(map[uint64]*types.Header),
+		errC:    make(chan error, 1),
+	}
+	ethClient.AddHeader(2, &types.Header{
+		Number:     big.NewInt(2),
+		ParentHash: common.HexToHash("0x02"),
+	})
+	btABI, err := abi.JSON(strings.NewReader(blocktracker.BlocktrackerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventManager := events.NewListener(
+		util.NewTestLogger(io.Discard),
+		&btABI,
+	)
+	rec := &testRecorder{
+		updates: make(chan l1Update),
+	}
+
+	testRelayQuerier := &testRelayQuerier{
+		responses: map[int64]string{1: "0x" + newBuilder},
+	}
+
+	l := l1Listener.NewL1Listener(
+		util.NewTestLogger(io.Discard),
+		ethClient,
+		reg,
+		eventManager,
+		rec,
+		testRelayQuerier,
+		l1Listener.WithReorgLookback(8),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cl := l1Listener.SetCheckInterval(100 * time.Millisecond)
+	t.Cleanup(cl)
+
+	done := l.Start(ctx)
+
+	want, err := hex.DecodeString(newBuilder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for winner")
+	case update := <-rec.updates:
+		if update.blockNum.Int64() != 1 {
+			t.Fatalf("wrong block number: %d", update.blockNum.Int64())
+		}
+		if !bytes.Equal(update.winner, want) {
+			t.Fatalf("wrong winner: %x", update.winner)
+		}
+	}
+
+	cancel()
+	select {
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for done")
+	case <-done:
+	}
+
+	if got := reg.recordedBlock(1); got.BlockHash != common.HexToHash("0x02") || got.BuilderPubKey != newBuilder {
+		t.Fatalf("unexpected recorded block: %+v", got)
+	}
+}
+
 type testRelayQuerier struct {
 	responses map[int64]string
 	mu        sync.Mutex
@@ -161,7 +333,10 @@ type winnerObj struct {
 }
 
 type testRegister struct {
-	winners chan winnerObj
+	winners   chan winnerObj
+	lastBlock int64
+	mu        sync.Mutex
+	recorded  map[int64]store.RecordedBlock
 }
 
 func (t *testRegister) RegisterWinner(_ context.Context, blockNum int64, winner []byte) error {
@@ -170,7 +345,38 @@ func (t *testRegister) RegisterWinner(_ context.Context, blockNum int64, winner
 }
 
 func (t *testRegister) LastWinnerBlock() (int64, error) {
-	return 0, nil
+	return t.lastBlock, nil
+}
+
+func (t *testRegister) AddRecordedBlock(_ context.Context, blockNum int64, blockHash common.Hash, builderPubKey string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.recorded == nil {
+		t.recorded = make(map[int64]store.RecordedBlock)
+	}
+	t.recorded[blockNum] = store.RecordedBlock{BlockNumber: blockNum, BlockHash: blockHash, BuilderPubKey: builderPubKey}
+	return nil
+}
+
+func (t *testRegister) RecordedBlocks(_ context.Context, fromBlock int64) ([]store.RecordedBlock, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var blocks []store.RecordedBlock
+	for _, b := range t.recorded {
+		if b.BlockNumber > fromBlock {
+			blocks = append(blocks, b)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].BlockNumber < blocks[j].BlockNumber
+	})
+	return blocks, nil
+}
+
+func (t *testRegister) recordedBlock(blockNum int64) store.RecordedBlock {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.recorded[blockNum]
 }
 
 type testEthClient struct {
@@ -5,13 +5,17 @@ import "github.com/prometheus/client_golang/prometheus"
 const (
 	defaultNamespace = "mev_commit_oracle"
 	subsystem        = "l1_listener"
+	relaySubsystem   = "relay_querier"
 )
 
 type metrics struct {
-	WinnerPostedCount prometheus.Counter
-	WinnerRoundCount  *prometheus.CounterVec
-	WinnerCount       prometheus.Counter
-	LastSentNonce     prometheus.Gauge
+	WinnerPostedCount    prometheus.Counter
+	WinnerRoundCount     *prometheus.CounterVec
+	WinnerCount          prometheus.Counter
+	LastSentNonce        prometheus.Gauge
+	ReorgCount           prometheus.Counter
+	ReorgDepth           prometheus.Summary
+	WinnerCorrectedCount prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -49,6 +53,31 @@ func newMetrics() *metrics {
 			Help:      "Last sent nonce",
 		},
 	)
+	m.ReorgCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "reorg_count",
+			Help:      "Number of L1 reorgs detected on recorded blocks",
+		},
+	)
+	m.ReorgDepth = prometheus.NewSummary(
+		prometheus.SummaryOpts{
+			Namespace:  defaultNamespace,
+			Subsystem:  subsystem,
+			Name:       "reorg_depth",
+			Help:       "Number of recorded blocks reorged out per reorg",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		},
+	)
+	m.WinnerCorrectedCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "winner_corrected_count",
+			Help:      "Number of winners posted again after a reorg changed the builder",
+		},
+	)
 	return m
 }
 
@@ -58,5 +87,86 @@ func (m *metrics) Collectors() []prometheus.Collector {
 		m.WinnerRoundCount,
 		m.WinnerCount,
 		m.LastSentNonce,
+		m.ReorgCount,
+		m.ReorgDepth,
+		m.WinnerCorrectedCount,
+	}
+}
+
+type relayMetrics struct {
+	RelayQueryDuration     *prometheus.HistogramVec
+	RelayErrorCount        *prometheus.CounterVec
+	RelayInvalidTraceCount *prometheus.CounterVec
+	RelayDisagreementCount *prometheus.CounterVec
+	QuorumFailureCount     prometheus.Counter
+	QuorumExhaustedCount   prometheus.Counter
+}
+
+func newRelayMetrics() *relayMetrics {
+	m := &relayMetrics{}
+	m.RelayQueryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "query_duration_seconds",
+			Help:      "Duration of the bid trace queries per relay",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"relay"},
+	)
+	m.RelayErrorCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "error_count",
+			Help:      "Number of failed bid trace queries per relay",
+		},
+		[]string{"relay"},
+	)
+	m.RelayInvalidTraceCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "invalid_trace_count",
+			Help:      "Number of bid traces per relay which did not match the L1 block",
+		},
+		[]string{"relay"},
+	)
+	m.RelayDisagreementCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "disagreement_count",
+			Help:      "Number of times a relay disagreed with the quorum on the block builder",
+		},
+		[]string{"relay"},
+	)
+	m.QuorumFailureCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "quorum_failure_count",
+			Help:      "Number of queries for which the relays did not reach quorum",
+		},
+	)
+	m.QuorumExhaustedCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: relaySubsystem,
+			Name:      "quorum_exhausted_count",
+			Help:      "Number of blocks recorded without a winner after the quorum attempts ran out",
+		},
+	)
+	return m
+}
+
+func (m *relayMetrics) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.RelayQueryDuration,
+		m.RelayErrorCount,
+		m.RelayInvalidTraceCount,
+		m.RelayDisagreementCount,
+		m.QuorumFailureCount,
+		m.QuorumExhaustedCount,
 	}
 }
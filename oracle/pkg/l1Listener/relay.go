@@ -0,0 +1,468 @@
+package l1Listener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const bidTracePath = "/relay/v1/data/bidtraces/proposer_payload_delivered"
+
+var relayTimeout = 10 * time.Second
+
+// defaultQuorumAttempts is the number of queries of a block without quorum
+// after which the block is recorded without a winner.
+const defaultQuorumAttempts = 10
+
+var (
+	// ErrBlockNotFound is returned when none of the relays delivered the
+	// payload of the block, which means it was built outside of PBS.
+	ErrBlockNotFound = errors.New("no matching block found")
+	// ErrNoQuorum is returned when not enough relays agree on the builder of
+	// the block. The block should be queried again later, until the quorum
+	// attempts run out.
+	ErrNoQuorum = errors.New("relays did not reach quorum")
+	// ErrNoWinner is returned once the quorum attempts of a block ran out. The
+	// block is recorded without a winner instead of trusting any of the relays.
+	ErrNoWinner = errors.New("relays did not agree on the winner")
+)
+
+type RelayQuerier interface {
+	Query(ctx context.Context, blockNumber int64, blockHash string) (string, error)
+}
+
+// BlockReader is used to verify the bid traces returned by the relays against
+// the block on L1.
+type BlockReader interface {
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+type RelayQueryOption func(*RelayQueryEngine)
+
+// WithQuorum requires the given number of relays to agree on the builder of
+// a block, or on the block being built outside of PBS. The relays which did not
+// deliver a block abstain when another relay delivered it, but fewer relays
+// than the quorum can only decide the builder if their bid traces are verified.
+// Without a quorum the builder returned by the first relay which delivered the
+// block is used.
+func WithQuorum(quorum int) RelayQueryOption {
+	return func(m *RelayQueryEngine) {
+		m.quorum = quorum
+	}
+}
+
+// WithQuorumAttempts sets the number of times a block is queried without the
+// relays reaching quorum before the block is recorded without a winner, so
+// that the oracle keeps settling while the relays stay split.
+func WithQuorumAttempts(attempts int) RelayQueryOption {
+	return func(m *RelayQueryEngine) {
+		if attempts > 0 {
+			m.quorumAttempts = attempts
+		}
+	}
+}
+
+// WithBidTraceVerification verifies the fee recipient and the value of the
+// bid traces returned by the relays against the block read from L1. Relays
+// returning a trace which does not match the block are ignored.
+func WithBidTraceVerification(reader BlockReader) RelayQueryOption {
+	return func(m *RelayQueryEngine) {
+		m.blockReader = reader
+	}
+}
+
+type RelayQueryEngine struct {
+	relayUrls      []string
+	quorum         int
+	quorumAttempts int
+	blockReader    BlockReader
+	client         *http.Client
+	metrics        *relayMetrics
+	logger         *slog.Logger
+	// failedAttempts counts the queries without quorum per block number.
+	failedMu       sync.Mutex
+	failedAttempts map[int64]int
+}
+
+func NewRelayQueryEngine(relayUrls []string, logger *slog.Logger, opts ...RelayQueryOption) *RelayQueryEngine {
+	m := &RelayQueryEngine{
+		relayUrls:      relayUrls,
+		quorumAttempts: defaultQuorumAttempts,
+		client:         &http.Client{Timeout: relayTimeout},
+		metrics:        newRelayMetrics(),
+		logger:         logger,
+		failedAttempts: make(map[int64]int),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+func (m *RelayQueryEngine) Metrics() []prometheus.Collector {
+	return m.metrics.Collectors()
+}
+
+type bidTrace struct {
+	BlockNumber          string `json:"block_number"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	Value                string `json:"value"`
+}
+
+type relayResult struct {
+	relay string
+	// builderPubKey is empty if the relay did not deliver the block.
+	builderPubKey string
+	err           error
+}
+
+func (m *RelayQueryEngine) Query(ctx context.Context, blockNumber int64, blockHash string) (string, error) {
+	// The block is read at most once per query and only if a relay returns a
+	// trace which needs to be verified.
+	block := sync.OnceValues(func() (*types.Block, error) {
+		return m.blockReader.BlockByNumber(ctx, big.NewInt(blockNumber))
+	})
+
+	var wg sync.WaitGroup
+	resultChan := make(chan relayResult, len(m.relayUrls))
+
+	for _, u := range m.relayUrls {
+		wg.Add(1)
+		go func(u string) {
+			defer wg.Done()
+			resultChan <- m.queryRelay(ctx, u, blockNumber, blockHash, block)
+		}(u)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	if m.quorum > 0 {
+		return m.quorumResult(ctx, blockNumber, resultChan)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case result, ok := <-resultChan:
+			if !ok {
+				return "", ErrBlockNotFound
+			}
+			if result.err == nil && result.builderPubKey != "" {
+				return result.builderPubKey, nil
+			}
+		}
+	}
+}
+
+// quorumResult waits for all the relays to respond and returns the builder on
+// which at least quorum relays agree. Usually only one relay delivers the
+// payload of a block, so the relays which did not deliver it abstain instead of
+// voting against the builder. If the bid traces are verified against the L1
+// block, the quorum is capped at the number of relays which delivered the
+// block. Otherwise a single relay could decide the builder, so quorum relays
+// need to deliver it. The block is not found only if none of the relays
+// delivered it and at least quorum relays responded. Relays which responded
+// with a different builder are recorded as disagreeing. Once the block was
+// queried quorumAttempts times without quorum, ErrNoWinner is returned, as
+// conflicting relays cannot be told apart by their number of votes.
+func (m *RelayQueryEngine) quorumResult(
+	ctx context.Context,
+	blockNumber int64,
+	resultChan <-chan relayResult,
+) (string, error) {
+	votes := make(map[string][]string)
+	delivered, abstained := 0, 0
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case result, ok := <-resultChan:
+			if !ok {
+				done = true
+				break
+			}
+			switch {
+			case result.err != nil:
+				continue
+			case result.builderPubKey == "":
+				abstained++
+			default:
+				delivered++
+				votes[result.builderPubKey] = append(votes[result.builderPubKey], result.relay)
+			}
+		}
+	}
+
+	if delivered == 0 {
+		if abstained < m.quorum {
+			m.metrics.QuorumFailureCount.Inc()
+			return "", fmt.Errorf(
+				"%w: %d of %d relays responded without the block, %d required",
+				ErrNoQuorum,
+				abstained,
+				len(m.relayUrls),
+				m.quorum,
+			)
+		}
+		m.clearFailedAttempts(blockNumber)
+		return "", ErrBlockNotFound
+	}
+
+	required := m.quorum
+	if m.blockReader != nil {
+		required = min(m.quorum, delivered)
+	}
+	winner := pluralityBuilder(votes)
+	best := len(votes[winner])
+
+	var noQuorum error
+	if best < required {
+		noQuorum = fmt.Errorf(
+			"%w: %d of %d relays which delivered the block agree, %d required",
+			ErrNoQuorum,
+			best,
+			delivered,
+			required,
+		)
+	}
+	for builderPubKey, relays := range votes {
+		if noQuorum == nil && builderPubKey != winner && len(relays) >= required {
+			noQuorum = fmt.Errorf("%w: conflicting builders %s and %s", ErrNoQuorum, winner, builderPubKey)
+		}
+	}
+	if noQuorum != nil {
+		m.metrics.QuorumFailureCount.Inc()
+		if !m.quorumExhausted(blockNumber) {
+			return "", noQuorum
+		}
+		m.metrics.QuorumExhaustedCount.Inc()
+		m.logger.Error(
+			"relays did not reach quorum, recording no winner",
+			"block", blockNumber,
+			"attempts", m.quorumAttempts,
+			"votes", votes,
+			"error", noQuorum,
+		)
+		return "", fmt.Errorf("%w: block queried %d times without quorum", ErrNoWinner, m.quorumAttempts)
+	}
+	m.clearFailedAttempts(blockNumber)
+
+	for builderPubKey, relays := range votes {
+		if builderPubKey == winner {
+			continue
+		}
+		for _, relay := range relays {
+			m.metrics.RelayDisagreementCount.WithLabelValues(relay).Inc()
+			m.logger.Warn(
+				"relay disagrees with quorum",
+				"relay", relay,
+				"block", blockNumber,
+				"builder_pubkey", builderPubKey,
+				"quorum_builder_pubkey", winner,
+			)
+		}
+	}
+
+	return winner, nil
+}
+
+// quorumExhausted records a query of the block without quorum and returns true
+// once the block was queried quorumAttempts times without quorum.
+func (m *RelayQueryEngine) quorumExhausted(blockNumber int64) bool {
+	m.failedMu.Lock()
+	defer m.failedMu.Unlock()
+
+	m.failedAttempts[blockNumber]++
+	if m.failedAttempts[blockNumber] < m.quorumAttempts {
+		return false
+	}
+	delete(m.failedAttempts, blockNumber)
+	return true
+}
+
+// clearFailedAttempts forgets the attempts of the block and of the older
+// blocks, which are not queried anymore once a newer block is resolved.
+func (m *RelayQueryEngine) clearFailedAttempts(blockNumber int64) {
+	m.failedMu.Lock()
+	defer m.failedMu.Unlock()
+
+	for b := range m.failedAttempts {
+		if b <= blockNumber {
+			delete(m.failedAttempts, b)
+		}
+	}
+}
+
+// pluralityBuilder returns the builder returned by the most relays which
+// delivered the block, or an empty builder if none did. Ties are broken by the
+// lowest public key so that the result is deterministic, the caller rejects
+// them as conflicting builders.
+func pluralityBuilder(votes map[string][]string) string {
+	winner, best := "", 0
+	for builderPubKey, relays := range votes {
+		if len(relays) > best || (len(relays) == best && builderPubKey < winner) {
+			winner, best = builderPubKey, len(relays)
+		}
+	}
+	return winner
+}
+
+func (m *RelayQueryEngine) queryRelay(
+	ctx context.Context,
+	relayURL string,
+	blockNumber int64,
+	blockHash string,
+	block func() (*types.Block, error),
+) relayResult {
+	baseURL, err := url.Parse(relayURL)
+	if err != nil {
+		m.logger.Error("failed to parse relay URL", "url", relayURL, "error", err)
+		return relayResult{relay: relayURL, err: err}
+	}
+	relay := baseURL.Host
+
+	start := time.Now()
+	trace, err := m.fetchBidTrace(ctx, baseURL, blockNumber, blockHash)
+	m.metrics.RelayQueryDuration.WithLabelValues(relay).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.metrics.RelayErrorCount.WithLabelValues(relay).Inc()
+		m.logger.Error("failed to query relay", "relay", relay, "block", blockNumber, "error", err)
+		return relayResult{relay: relay, err: err}
+	}
+	if trace == nil {
+		return relayResult{relay: relay}
+	}
+
+	if m.blockReader != nil {
+		blk, err := block()
+		if err != nil {
+			m.logger.Error("failed to get block for verification", "block", blockNumber, "error", err)
+			return relayResult{relay: relay, err: err}
+		}
+		if err := verifyBidTrace(blk, trace); err != nil {
+			m.metrics.RelayInvalidTraceCount.WithLabelValues(relay).Inc()
+			m.logger.Warn(
+				"relay returned invalid bid trace",
+				"relay", relay,
+				"block", blockNumber,
+				"builder_pubkey", trace.BuilderPubkey,
+				"error", err,
+			)
+			return relayResult{relay: relay, err: err}
+		}
+	}
+
+	return relayResult{relay: relay, builderPubKey: strings.ToLower(trace.BuilderPubkey)}
+}
+
+// fetchBidTrace returns the bid trace of the payload delivered by the relay
+// for the block, or nil if the relay did not deliver it.
+func (m *RelayQueryEngine) fetchBidTrace(
+	ctx context.Context,
+	baseURL *url.URL,
+	blockNumber int64,
+	blockHash string,
+) (*bidTrace, error) {
+	u := *baseURL
+	u.Path = u.Path + bidTracePath
+
+	query := url.Values{}
+	query.Add("block_number", strconv.FormatInt(blockNumber, 10))
+	u.RawQuery = query.Encode()
+	m.logger.Debug("querying relay", "url", u.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching data: %w", err)
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+	m.logger.Info("received response from relay", "url", u.String(), "status", resp.Status)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	var traces []bidTrace
+	if err := json.Unmarshal(body, &traces); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
+	}
+
+	for _, trace := range traces {
+		blockNum, err := strconv.ParseInt(trace.BlockNumber, 10, 64)
+		if err != nil {
+			m.logger.Error("failed to convert block_number to int", "block_number", trace.BlockNumber, "error", err)
+			continue
+		}
+		if blockNum == blockNumber && strings.EqualFold(trace.BlockHash, blockHash) {
+			return &trace, nil
+		}
+	}
+	return nil, nil
+}
+
+// verifyBidTrace checks that the proposer was paid the value of the bid trace.
+// The builder either pays the proposer with the last transaction of the block
+// or, if it sets the proposer as the fee recipient of the block, through the
+// priority fees. The value can only be verified in the former case as the fees
+// are not known from the block alone.
+func verifyBidTrace(block *types.Block, trace *bidTrace) error {
+	if !strings.EqualFold(block.Hash().Hex(), trace.BlockHash) {
+		return fmt.Errorf("block hash mismatch: block %s, trace %s", block.Hash().Hex(), trace.BlockHash)
+	}
+	if !common.IsHexAddress(trace.ProposerFeeRecipient) {
+		return fmt.Errorf("invalid proposer fee recipient: %s", trace.ProposerFeeRecipient)
+	}
+	feeRecipient := common.HexToAddress(trace.ProposerFeeRecipient)
+	value, ok := new(big.Int).SetString(trace.Value, 10)
+	if !ok {
+		return fmt.Errorf("invalid value: %s", trace.Value)
+	}
+
+	if block.Coinbase() == feeRecipient {
+		return nil
+	}
+
+	txns := block.Transactions()
+	if len(txns) == 0 {
+		return fmt.Errorf("fee recipient %s is not the coinbase and the block has no payment", feeRecipient.Hex())
+	}
+	payment := txns[len(txns)-1]
+	if payment.To() == nil || *payment.To() != feeRecipient {
+		return fmt.Errorf("fee recipient %s is neither the coinbase nor paid by the last transaction", feeRecipient.Hex())
+	}
+	if payment.Value().Cmp(value) != 0 {
+		return fmt.Errorf("value mismatch: trace %s, paid %s", value, payment.Value())
+	}
+	return nil
+}
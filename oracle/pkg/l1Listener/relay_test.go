@@ -0,0 +1,265 @@
+package l1Listener_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/primev/mev-commit/oracle/pkg/l1Listener"
+	"github.com/primev/mev-commit/x/util"
+)
+
+type testTrace struct {
+	BlockNumber          string `json:"block_number"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	Value                string `json:"value"`
+}
+
+func startRelay(t *testing.T, status int, traces ...testTrace) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/relay/v1/data/bidtraces/proposer_payload_delivered" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("block_number") != "10" {
+			t.Errorf("unexpected block number: %s", r.URL.Query().Get("block_number"))
+		}
+		w.WriteHeader(status)
+		if traces == nil {
+			traces = []testTrace{}
+		}
+		if err := json.NewEncoder(w).Encode(traces); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+type testBlockReader struct {
+	block *types.Block
+}
+
+func (t *testBlockReader) BlockByNumber(_ context.Context, _ *big.Int) (*types.Block, error) {
+	return t.block, nil
+}
+
+func TestRelayQueryEngineQuorum(t *testing.T) {
+	t.Parallel()
+
+	hash := common.HexToHash("0x01").Hex()
+	trace := func(builder string) testTrace {
+		return testTrace{BlockNumber: "10", BlockHash: hash, BuilderPubkey: builder}
+	}
+
+	tests := []struct {
+		name    string
+		relays  func(t *testing.T) []string
+		quorum  int
+		want    string
+		wantErr error
+	}{
+		{
+			name: "first match",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusOK, trace("0xaa")),
+				}
+			},
+			want: "0xaa",
+		},
+		{
+			name: "quorum reached",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK, trace("0xaa")),
+					startRelay(t, http.StatusOK, trace("0xAA")),
+					startRelay(t, http.StatusOK, trace("0xbb")),
+				}
+			},
+			quorum: 2,
+			want:   "0xaa",
+		},
+		{
+			name: "quorum not reached",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK, trace("0xaa")),
+					startRelay(t, http.StatusOK, trace("0xbb")),
+					startRelay(t, http.StatusInternalServerError),
+				}
+			},
+			quorum:  2,
+			wantErr: l1Listener.ErrNoQuorum,
+		},
+		{
+			name: "delivered by one relay",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusOK, trace("0xaa")),
+				}
+			},
+			quorum:  2,
+			wantErr: l1Listener.ErrNoQuorum,
+		},
+		{
+			name: "tie",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK, trace("0xaa")),
+					startRelay(t, http.StatusOK, trace("0xbb")),
+				}
+			},
+			quorum:  1,
+			wantErr: l1Listener.ErrNoQuorum,
+		},
+		{
+			name: "not found by quorum",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusInternalServerError),
+				}
+			},
+			quorum:  2,
+			wantErr: l1Listener.ErrBlockNotFound,
+		},
+		{
+			name: "not found because of errors",
+			relays: func(t *testing.T) []string {
+				return []string{
+					startRelay(t, http.StatusOK),
+					startRelay(t, http.StatusInternalServerError),
+					startRelay(t, http.StatusInternalServerError),
+				}
+			},
+			quorum:  2,
+			wantErr: l1Listener.ErrNoQuorum,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := l1Listener.NewRelayQueryEngine(
+				tc.relays(t),
+				util.NewTestLogger(os.Stdout),
+				l1Listener.WithQuorum(tc.quorum),
+			)
+			got, err := engine.Query(context.Background(), 10, hash)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected builder %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRelayQueryEngineQuorumExhausted(t *testing.T) {
+	t.Parallel()
+
+	hash := common.HexToHash("0x01").Hex()
+	trace := func(builder string) testTrace {
+		return testTrace{BlockNumber: "10", BlockHash: hash, BuilderPubkey: builder}
+	}
+
+	engine := l1Listener.NewRelayQueryEngine(
+		[]string{
+			startRelay(t, http.StatusOK, trace("0xbb")),
+			startRelay(t, http.StatusOK, trace("0xaa")),
+			startRelay(t, http.StatusOK, trace("0xaa")),
+			startRelay(t, http.StatusOK),
+		},
+		util.NewTestLogger(os.Stdout),
+		l1Listener.WithQuorum(3),
+		l1Listener.WithQuorumAttempts(3),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := engine.Query(context.Background(), 10, hash); !errors.Is(err, l1Listener.ErrNoQuorum) {
+			t.Fatalf("attempt %d: expected error %v, got %v", i+1, l1Listener.ErrNoQuorum, err)
+		}
+	}
+
+	// The builder returned by the most relays is not trusted.
+	got, err := engine.Query(context.Background(), 10, hash)
+	if !errors.Is(err, l1Listener.ErrNoWinner) {
+		t.Fatalf("expected error %v, got %v", l1Listener.ErrNoWinner, err)
+	}
+	if got != "" {
+		t.Fatalf("expected no builder, got %q", got)
+	}
+
+	// The attempts are counted again from zero once they ran out.
+	if _, err := engine.Query(context.Background(), 10, hash); !errors.Is(err, l1Listener.ErrNoQuorum) {
+		t.Fatalf("expected error %v, got %v", l1Listener.ErrNoQuorum, err)
+	}
+}
+
+func TestRelayQueryEngineVerification(t *testing.T) {
+	t.Parallel()
+
+	coinbase := common.HexToAddress("0xc0")
+	feeRecipient := common.HexToAddress("0xfe")
+
+	payment := types.NewTransaction(0, feeRecipient, big.NewInt(1000), 21000, big.NewInt(1), nil)
+	block := types.NewBlock(
+		&types.Header{Number: big.NewInt(10), Coinbase: coinbase},
+		&types.Body{Transactions: types.Transactions{payment}},
+		nil,
+		trie.NewStackTrie(nil),
+	)
+	hash := block.Hash().Hex()
+
+	valid := testTrace{
+		BlockNumber:          "10",
+		BlockHash:            hash,
+		BuilderPubkey:        "0xaa",
+		ProposerFeeRecipient: feeRecipient.Hex(),
+		Value:                "1000",
+	}
+	wrongValue := valid
+	wrongValue.BuilderPubkey = "0xbb"
+	wrongValue.Value = "2000"
+	wrongRecipient := valid
+	wrongRecipient.BuilderPubkey = "0xcc"
+	wrongRecipient.ProposerFeeRecipient = common.HexToAddress("0x01").Hex()
+
+	engine := l1Listener.NewRelayQueryEngine(
+		[]string{
+			startRelay(t, http.StatusOK, wrongValue),
+			startRelay(t, http.StatusOK, wrongRecipient),
+			startRelay(t, http.StatusOK, valid),
+		},
+		util.NewTestLogger(os.Stdout),
+		// The only relay with a valid trace decides the builder below the
+		// quorum as its trace is verified.
+		l1Listener.WithQuorum(2),
+		l1Listener.WithBidTraceVerification(&testBlockReader{block: block}),
+	)
+
+	got, err := engine.Query(context.Background(), 10, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "0xaa" {
+		t.Fatalf("expected builder 0xaa, got %s", got)
+	}
+}
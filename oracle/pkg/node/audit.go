@@ -0,0 +1,244 @@
+package node
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+	rollupclient "github.com/primev/mev-commit/contracts-abi/clients/Oracle"
+	preconf "github.com/primev/mev-commit/contracts-abi/clients/PreconfManager"
+	"github.com/primev/mev-commit/oracle/pkg/store"
+	"github.com/primev/mev-commit/oracle/pkg/updater"
+	"github.com/primev/mev-commit/x/contracts/ethwrapper"
+	"github.com/primev/mev-commit/x/contracts/txmonitor"
+)
+
+// auditLogRange is the maximum number of blocks for which the settlement
+// chain logs are requested at once.
+const auditLogRange = 10000
+
+const (
+	AuditFormatCSV  = "csv"
+	AuditFormatJSON = "json"
+)
+
+type AuditOptions struct {
+	// FromBlock and ToBlock are the settlement chain block range in which
+	// the OpenedCommitmentStored events are audited.
+	FromBlock uint64
+	ToBlock   uint64
+	Format    string
+	Output    io.Writer
+}
+
+// Audit replays the settlement pipeline of the oracle for the commitments
+// opened in the given block range and writes a report comparing the decisions
+// with the settlements recorded in the database and on-chain. No transactions
+// are sent and nothing is written to the database.
+func Audit(ctx context.Context, opts *Options, auditOpts *AuditOptions) error {
+	if auditOpts.ToBlock < auditOpts.FromBlock {
+		return fmt.Errorf("invalid block range %d-%d", auditOpts.FromBlock, auditOpts.ToBlock)
+	}
+
+	db, err := initDB(opts)
+	if err != nil {
+		opts.Logger.Error("failed initializing DB", "error", err)
+		return err
+	}
+	//nolint:errcheck
+	defer db.Close()
+
+	// The tables are not created, the audit only reads the database of a
+	// running oracle.
+	st := store.OpenStore(db)
+
+	settlementClient, err := ethclient.Dial(opts.SettlementRPCUrl)
+	if err != nil {
+		opts.Logger.Error("failed to connect to the settlement layer", "error", err)
+		return err
+	}
+	defer settlementClient.Close()
+
+	l1Client, err := ethwrapper.NewClient(
+		opts.Logger,
+		opts.L1RPCUrls,
+		ethwrapper.EthClientWithMaxRetries(30),
+	)
+	if err != nil {
+		opts.Logger.Error("failed to instantiate L1 client", "error", err)
+		return err
+	}
+	rawClient := l1Client.RawClient()
+	if rawClient == nil {
+		return errors.New("failed to get ethclient")
+	}
+
+	contracts, err := getContractABIs(opts)
+	if err != nil {
+		opts.Logger.Error("failed to get contract ABIs", "error", err)
+		return err
+	}
+
+	preconfFilterer, err := preconf.NewPreconfmanagerFilterer(opts.PreconfContractAddr, settlementClient)
+	if err != nil {
+		return fmt.Errorf("instantiating preconf manager filterer: %w", err)
+	}
+	oracleFilterer, err := rollupclient.NewOracleFilterer(opts.OracleContractAddr, settlementClient)
+	if err != nil {
+		return fmt.Errorf("instantiating oracle filterer: %w", err)
+	}
+
+	var cmts []*preconf.PreconfmanagerOpenedCommitmentStored
+	err = walkLogRange(auditOpts.FromBlock, auditOpts.ToBlock, func(start, end uint64) error {
+		it, err := preconfFilterer.FilterOpenedCommitmentStored(
+			&bind.FilterOpts{Start: start, End: &end, Context: ctx},
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		//nolint:errcheck
+		defer it.Close()
+		for it.Next() {
+			cmts = append(cmts, it.Event)
+		}
+		return it.Error()
+	})
+	if err != nil {
+		return fmt.Errorf("filtering opened commitments: %w", err)
+	}
+
+	// The commitments are processed after they are opened, so the processed
+	// events are read up to the tip of the settlement chain.
+	tip, err := settlementClient.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("getting settlement chain tip: %w", err)
+	}
+	processed := make(map[[32]byte]bool)
+	err = walkLogRange(auditOpts.FromBlock, max(tip, auditOpts.ToBlock), func(start, end uint64) error {
+		it, err := oracleFilterer.FilterCommitmentProcessed(
+			&bind.FilterOpts{Start: start, End: &end, Context: ctx},
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		//nolint:errcheck
+		defer it.Close()
+		for it.Next() {
+			processed[it.Event.CommitmentIndex] = it.Event.IsSlash
+		}
+		return it.Error()
+	})
+	if err != nil {
+		return fmt.Errorf("filtering processed commitments: %w", err)
+	}
+
+	opts.Logger.Info(
+		"auditing commitments",
+		"fromBlock", auditOpts.FromBlock,
+		"toBlock", auditOpts.ToBlock,
+		"opened", len(cmts),
+		"processed", len(processed),
+	)
+
+	// The updater is created without an oracle transactor and event manager
+	// as only the decisions are computed.
+	updtr, err := updater.NewUpdater(
+		opts.Logger.With("component", "updater"),
+		l1Client,
+		st,
+		nil,
+		nil,
+		txmonitor.NewEVMHelperWithLogger(rawClient, opts.Logger, contracts),
+		opts.BidOptionsSlashEnabled,
+	)
+	if err != nil {
+		return fmt.Errorf("instantiating updater: %w", err)
+	}
+
+	entries, err := updtr.Audit(ctx, cmts, st, processed)
+	if err != nil {
+		return err
+	}
+
+	mismatches := 0
+	for _, e := range entries {
+		if len(e.Mismatches) > 0 {
+			mismatches++
+		}
+	}
+	opts.Logger.Info("audit completed", "commitments", len(entries), "mismatches", mismatches)
+
+	return writeAuditReport(auditOpts.Output, auditOpts.Format, entries)
+}
+
+func walkLogRange(from, to uint64, fn func(start, end uint64) error) error {
+	for start := from; start <= to; start += auditLogRange {
+		end := min(start+auditLogRange-1, to)
+		if err := fn(start, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAuditReport(w io.Writer, format string, entries []*updater.AuditEntry) error {
+	switch format {
+	case AuditFormatJSON:
+		enc := json.NewEncoder(w)
+		for _, e := range entries {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case AuditFormatCSV:
+		cw := csv.NewWriter(w)
+		err := cw.Write([]string{
+			"commitment_index",
+			"block_number",
+			"committer",
+			"txn_hash",
+			"decision",
+			"decay_percentage",
+			"reason",
+			"recorded_type",
+			"recorded_decay_percentage",
+			"onchain_type",
+			"mismatches",
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			err := cw.Write([]string{
+				e.CommitmentIndex,
+				strconv.FormatUint(e.BlockNumber, 10),
+				e.Committer,
+				e.TxnHash,
+				string(e.Decision),
+				strconv.FormatInt(e.DecayPercentage, 10),
+				e.Reason,
+				string(e.RecordedType),
+				strconv.FormatInt(e.RecordedDecayPercentage, 10),
+				string(e.OnChainType),
+				strings.Join(e.Mismatches, ","),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unknown audit report format %q", format)
+	}
+}
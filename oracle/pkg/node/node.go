@@ -46,6 +46,9 @@ type Options struct {
 	HTTPPort                     int
 	SettlementRPCUrl             string
 	RelayUrls                    []string
+	RelayQuorum                  int
+	RelayQuorumAttempts          int
+	RelayVerifyBidTraces         bool
 	L1RPCUrls                    []string
 	OracleContractAddr           common.Address
 	PreconfContractAddr          common.Address
@@ -63,7 +66,13 @@ type Options struct {
 	DefaultGasLimit              uint64
 	DefaultGasTipCap             *big.Int
 	DefaultGasFeeCap             *big.Int
+	FeeBumpInterval              time.Duration
+	MaxGasTipCap                 *big.Int
+	MaxGasFeeCap                 *big.Int
 	BidOptionsSlashEnabled       bool
+	L1Confirmations              uint64
+	L1ReorgLookback              uint64
+	SettlementConfirmations      uint64
 }
 
 type Node struct {
@@ -109,6 +118,21 @@ func NewNode(opts *Options) (*Node, error) {
 		return nil, err
 	}
 
+	var monitorOpts []txmonitor.Option
+	if opts.FeeBumpInterval > 0 {
+		monitorOpts = append(monitorOpts, txmonitor.WithFeeBumping(
+			txmonitor.FeeBumpPolicy{
+				Interval:     opts.FeeBumpInterval,
+				Percentage:   txmonitor.DefaultFeeBumpPercentage,
+				MaxGasTipCap: opts.MaxGasTipCap,
+				MaxGasFeeCap: opts.MaxGasFeeCap,
+			},
+			chainID,
+			opts.KeySigner,
+			settlementClient,
+		))
+	}
+
 	monitor := txmonitor.New(
 		owner,
 		settlementClient,
@@ -116,6 +140,7 @@ func NewNode(opts *Options) (*Node, error) {
 		st,
 		nd.logger.With("component", "tx_monitor"),
 		2048,
+		monitorOpts...,
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -148,6 +173,7 @@ func NewNode(opts *Options) (*Node, error) {
 			nd.logger.With("component", "ws_publisher"),
 			settlementClient,
 			evtMgr,
+			publisher.WithConfirmations(opts.SettlementConfirmations),
 		)
 	} else {
 		eventsPublisher = publisher.NewHTTPPublisher(
@@ -155,6 +181,7 @@ func NewNode(opts *Options) (*Node, error) {
 			nd.logger.With("component", "http_publisher"),
 			settlementClient,
 			evtMgr,
+			publisher.WithConfirmations(opts.SettlementConfirmations),
 		)
 	}
 
@@ -242,7 +269,20 @@ func NewNode(opts *Options) (*Node, error) {
 		return nil, err
 	}
 
-	relayQuerier := l1Listener.NewRelayQueryEngine(opts.RelayUrls, nd.logger.With("component", "l1_listener_relay_querier"))
+	relayOpts := []l1Listener.RelayQueryOption{
+		l1Listener.WithQuorum(opts.RelayQuorum),
+		l1Listener.WithQuorumAttempts(opts.RelayQuorumAttempts),
+	}
+	// Below the quorum only relays with verified bid traces may decide the
+	// builder of a block, so the verification is always on with a quorum.
+	if opts.RelayVerifyBidTraces || opts.RelayQuorum > 0 {
+		relayOpts = append(relayOpts, l1Listener.WithBidTraceVerification(l1Client))
+	}
+	relayQuerier := l1Listener.NewRelayQueryEngine(
+		opts.RelayUrls,
+		nd.logger.With("component", "l1_listener_relay_querier"),
+		relayOpts...,
+	)
 
 	l1Lis := l1Listener.NewL1Listener(
 		nd.logger.With("component", "l1_listener"),
@@ -251,6 +291,8 @@ func NewNode(opts *Options) (*Node, error) {
 		evtMgr,
 		blockTrackerTransactor,
 		relayQuerier,
+		l1Listener.WithConfirmations(opts.L1Confirmations),
+		l1Listener.WithReorgLookback(opts.L1ReorgLookback),
 	)
 
 	l1LisClosed := l1Lis.Start(ctx)
@@ -312,6 +354,7 @@ func NewNode(opts *Options) (*Node, error) {
 	healthChecker.Register(health.CloseChannelHealthCheck("events_publisher", pubDone))
 
 	srv.RegisterMetricsCollectors(l1Lis.Metrics()...)
+	srv.RegisterMetricsCollectors(relayQuerier.Metrics()...)
 	srv.RegisterMetricsCollectors(updtr.Metrics()...)
 	srv.RegisterMetricsCollectors(monitor.Metrics()...)
 	srv.RegisterMetricsCollectors(evtMgr.Metrics()...)
@@ -43,6 +43,15 @@ CREATE TABLE IF NOT EXISTS winners (
 	builder_address TEXT
 );`
 
+// recordedBlocksTable holds the hashes of the L1 blocks whose winners were
+// posted, so that reorgs of the recent blocks are detected after a restart.
+var recordedBlocksTable = `
+CREATE TABLE IF NOT EXISTS recorded_blocks (
+	block_number BIGINT PRIMARY KEY,
+	block_hash TEXT,
+	builder_pubkey TEXT
+);`
+
 var transactionsTable = `
 CREATE TABLE IF NOT EXISTS sent_transactions (
 	hash TEXT PRIMARY KEY,
@@ -59,6 +68,13 @@ CREATE TABLE IF NOT EXISTS integers (
 
 var ErrNotFound = fmt.Errorf("not found")
 
+// RecordedBlock is an L1 block whose winner was posted by the oracle.
+type RecordedBlock struct {
+	BlockNumber   int64
+	BlockHash     common.Hash
+	BuilderPubKey string
+}
+
 type Store struct {
 	db *sql.DB
 }
@@ -68,6 +84,7 @@ func NewStore(db *sql.DB) (*Store, error) {
 		settlementType,
 		settlementsTable,
 		winnersTable,
+		recordedBlocksTable,
 		transactionsTable,
 		integerTable,
 	} {
@@ -77,9 +94,15 @@ func NewStore(db *sql.DB) (*Store, error) {
 		}
 	}
 
+	return OpenStore(db), nil
+}
+
+// OpenStore returns the store of a database whose tables are already set up
+// by NewStore. No statement is run on the database.
+func OpenStore(db *sql.DB) *Store {
 	return &Store{
 		db: db,
-	}, nil
+	}
 }
 
 func (s *Store) RegisterWinner(
@@ -87,7 +110,11 @@ func (s *Store) RegisterWinner(
 	blockNum int64,
 	winner []byte,
 ) error {
-	insertStr := "INSERT INTO winners (block_number, builder_address) VALUES ($1, $2)"
+	// The winner of a block is posted again if the block was reorged out, so
+	// the latest winner replaces the previous one.
+	insertStr := `
+	INSERT INTO winners (block_number, builder_address) VALUES ($1, $2)
+	ON CONFLICT (block_number) DO UPDATE SET builder_address = EXCLUDED.builder_address`
 
 	// Convert winner to base64 string for storage
 	winnerBase64 := base64.StdEncoding.EncodeToString(winner)
@@ -138,6 +165,53 @@ func (s *Store) LastWinnerBlock() (int64, error) {
 	return lastBlock.Int64, nil
 }
 
+// AddRecordedBlock stores the hash of the block and the builder posted as its
+// winner. A block posted again after a reorg replaces the previous entry.
+func (s *Store) AddRecordedBlock(
+	ctx context.Context,
+	blockNum int64,
+	blockHash common.Hash,
+	builderPubKey string,
+) error {
+	insertStr := `
+	INSERT INTO recorded_blocks (block_number, block_hash, builder_pubkey) VALUES ($1, $2, $3)
+	ON CONFLICT (block_number) DO UPDATE SET
+		block_hash = EXCLUDED.block_hash,
+		builder_pubkey = EXCLUDED.builder_pubkey`
+
+	_, err := s.db.ExecContext(ctx, insertStr, blockNum, blockHash.Hex(), builderPubKey)
+	return err
+}
+
+// RecordedBlocks returns the recorded blocks after the given block number in
+// ascending order.
+func (s *Store) RecordedBlocks(ctx context.Context, fromBlock int64) ([]RecordedBlock, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT block_number, block_hash, builder_pubkey FROM recorded_blocks
+		WHERE block_number > $1 ORDER BY block_number`,
+		fromBlock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []RecordedBlock
+	for rows.Next() {
+		var (
+			block     RecordedBlock
+			blockHash string
+		)
+		if err := rows.Scan(&block.BlockNumber, &blockHash, &block.BuilderPubKey); err != nil {
+			return nil, err
+		}
+		block.BlockHash = common.HexToHash(blockHash)
+		blocks = append(blocks, block)
+	}
+	return blocks, rows.Err()
+}
+
 func (s *Store) AddSettlement(
 	ctx context.Context,
 	commitmentIdx []byte,
@@ -224,6 +298,29 @@ func (s *Store) IsSettled(
 	return settled, nil
 }
 
+func (s *Store) GetSettlement(
+	ctx context.Context,
+	commitmentIdx []byte,
+) (*updater.SettlementRecord, error) {
+	var (
+		record          updater.SettlementRecord
+		decayPercentage sql.NullInt64
+		settled         sql.NullBool
+	)
+	commitmentIdxBase64 := base64.StdEncoding.EncodeToString(commitmentIdx)
+	err := s.db.QueryRowContext(
+		ctx,
+		"SELECT type, decay_percentage, settled FROM settlements WHERE commitment_index = $1",
+		commitmentIdxBase64,
+	).Scan(&record.Type, &decayPercentage, &settled)
+	if err != nil {
+		return nil, err
+	}
+	record.DecayPercentage = decayPercentage.Int64
+	record.Settled = settled.Bool
+	return &record, nil
+}
+
 func (s *Store) Save(ctx context.Context, txHash common.Hash, nonce uint64) error {
 	txHashBase64 := base64.StdEncoding.EncodeToString(txHash.Bytes())
 	_, err := s.db.ExecContext(
@@ -3,6 +3,7 @@ package store_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -230,6 +231,39 @@ func TestStore(t *testing.T) {
 		}
 	})
 
+	t.Run("RecordedBlocks", func(t *testing.T) {
+		st, err := store.NewStore(db)
+		if err != nil {
+			t.Fatalf("Failed to create store: %s", err)
+		}
+
+		blocks := []store.RecordedBlock{
+			{BlockNumber: 1, BlockHash: common.HexToHash("0x01"), BuilderPubKey: "aa"},
+			{BlockNumber: 2, BlockHash: common.HexToHash("0x02"), BuilderPubKey: "bb"},
+			{BlockNumber: 3, BlockHash: common.HexToHash("0x03"), BuilderPubKey: ""},
+		}
+		for _, b := range blocks {
+			err := st.AddRecordedBlock(context.Background(), b.BlockNumber, b.BlockHash, b.BuilderPubKey)
+			if err != nil {
+				t.Fatalf("Failed to add recorded block: %s", err)
+			}
+		}
+		// block 2 is reorged out and posted again
+		blocks[1] = store.RecordedBlock{BlockNumber: 2, BlockHash: common.HexToHash("0x22"), BuilderPubKey: "cc"}
+		err = st.AddRecordedBlock(context.Background(), 2, blocks[1].BlockHash, blocks[1].BuilderPubKey)
+		if err != nil {
+			t.Fatalf("Failed to add recorded block: %s", err)
+		}
+
+		got, err := st.RecordedBlocks(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Failed to get recorded blocks: %s", err)
+		}
+		if diff := cmp.Diff(blocks[1:], got); diff != "" {
+			t.Fatalf("Unexpected recorded blocks: (-want +have):\n%s", diff)
+		}
+	})
+
 	t.Run("AddSettlement", func(t *testing.T) {
 		st, err := store.NewStore(db)
 		if err != nil {
@@ -288,6 +322,31 @@ func TestStore(t *testing.T) {
 		}
 	})
 
+	t.Run("GetSettlement", func(t *testing.T) {
+		st, err := store.NewStore(db)
+		if err != nil {
+			t.Fatalf("Failed to create store: %s", err)
+		}
+
+		for _, settlement := range settlements {
+			record, err := st.GetSettlement(context.Background(), settlement.CommitmentIdx)
+			if err != nil {
+				t.Fatalf("Failed to get settlement: %s", err)
+			}
+			if record.Type != settlement.Type {
+				t.Fatalf("Expected settlement type %s, got %s", settlement.Type, record.Type)
+			}
+			if record.DecayPercentage != settlement.DecayPercentage {
+				t.Fatalf("Expected decay percentage %d, got %d", settlement.DecayPercentage, record.DecayPercentage)
+			}
+		}
+
+		_, err = st.GetSettlement(context.Background(), []byte("unknown"))
+		if !errors.Is(err, sql.ErrNoRows) {
+			t.Fatalf("Expected no rows error, got %v", err)
+		}
+	})
+
 	t.Run("LastBlock and SetBlockNo", func(t *testing.T) {
 		st, err := store.NewStore(db)
 		if err != nil {
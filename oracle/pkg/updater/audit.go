@@ -0,0 +1,118 @@
+package updater
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconf "github.com/primev/mev-commit/contracts-abi/clients/PreconfManager"
+)
+
+// SettlementRecord is a settlement previously recorded by the oracle.
+type SettlementRecord struct {
+	Type            SettlementType
+	DecayPercentage int64
+	Settled         bool
+}
+
+type SettlementRecords interface {
+	// GetSettlement returns sql.ErrNoRows if the commitment was not settled.
+	GetSettlement(ctx context.Context, commitmentIdx []byte) (*SettlementRecord, error)
+}
+
+// AuditEntry compares the decision the oracle would make for a commitment with
+// the settlement recorded in the settlements table and on-chain.
+type AuditEntry struct {
+	CommitmentIndex         string         `json:"commitment_index"`
+	BlockNumber             uint64         `json:"block_number"`
+	Committer               string         `json:"committer"`
+	TxnHash                 string         `json:"txn_hash"`
+	Decision                SettlementType `json:"decision"`
+	DecayPercentage         int64          `json:"decay_percentage"`
+	Reason                  string         `json:"reason,omitempty"`
+	RecordedType            SettlementType `json:"recorded_type"`
+	RecordedDecayPercentage int64          `json:"recorded_decay_percentage"`
+	OnChainType             SettlementType `json:"onchain_type"`
+	Mismatches              []string       `json:"mismatches,omitempty"`
+}
+
+// Audit runs the settlement pipeline for the opened commitments without
+// sending any transactions. The decisions are compared with the recorded
+// settlements and with the commitments processed on-chain, given as a map
+// from the commitment index to whether it was slashed.
+func (u *Updater) Audit(
+	ctx context.Context,
+	cmts []*preconf.PreconfmanagerOpenedCommitmentStored,
+	records SettlementRecords,
+	processed map[[32]byte]bool,
+) ([]*AuditEntry, error) {
+	entries := make([]*AuditEntry, 0, len(cmts))
+	seen := make(map[[32]byte]struct{})
+	for _, cmt := range cmts {
+		// both bidders and providers could open commitments
+		if _, ok := seen[cmt.CommitmentIndex]; ok {
+			continue
+		}
+		seen[cmt.CommitmentIndex] = struct{}{}
+
+		decision, err := u.decide(ctx, cmt)
+		if err != nil {
+			return nil, fmt.Errorf("deciding commitment %x: %w", cmt.CommitmentIndex, err)
+		}
+
+		entry := &AuditEntry{
+			CommitmentIndex: common.Bytes2Hex(cmt.CommitmentIndex[:]),
+			BlockNumber:     cmt.BlockNumber,
+			Committer:       cmt.Committer.Hex(),
+			TxnHash:         cmt.TxnHash,
+			Decision:        decision.SettlementType,
+			Reason:          decision.Reason,
+		}
+		if decision.ResidualPercentage != nil {
+			entry.DecayPercentage = decision.ResidualPercentage.Int64()
+		}
+
+		record, err := records.GetSettlement(ctx, cmt.CommitmentIndex[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return nil, fmt.Errorf("getting settlement %x: %w", cmt.CommitmentIndex, err)
+		default:
+			entry.RecordedType = record.Type
+			entry.RecordedDecayPercentage = record.DecayPercentage
+		}
+
+		if isSlash, ok := processed[cmt.CommitmentIndex]; ok {
+			entry.OnChainType = SettlementTypeReward
+			if isSlash {
+				entry.OnChainType = SettlementTypeSlash
+			}
+		}
+
+		if entry.Decision != entry.RecordedType {
+			entry.Mismatches = append(entry.Mismatches, "recorded_type")
+		}
+		if entry.Decision == entry.RecordedType && entry.DecayPercentage != entry.RecordedDecayPercentage {
+			entry.Mismatches = append(entry.Mismatches, "recorded_decay_percentage")
+		}
+		if entry.Decision != entry.OnChainType {
+			entry.Mismatches = append(entry.Mismatches, "onchain_type")
+		}
+		if len(entry.Mismatches) > 0 {
+			u.logger.Warn(
+				"audit mismatch",
+				"commitmentIdx", entry.CommitmentIndex,
+				"blockNumber", entry.BlockNumber,
+				"decision", entry.Decision,
+				"recordedType", entry.RecordedType,
+				"onChainType", entry.OnChainType,
+				"mismatches", strings.Join(entry.Mismatches, ","),
+			)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
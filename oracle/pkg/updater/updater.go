@@ -258,19 +258,50 @@ func (u *Updater) handleOpenedCommitment(
 		return nil
 	}
 
+	decision, err := u.decide(ctx, update)
+	if err != nil {
+		return err
+	}
+	if decision.SettlementType == "" {
+		return nil
+	}
+
+	return u.settle(
+		ctx,
+		update,
+		decision.SettlementType,
+		decision.ResidualPercentage,
+	)
+}
+
+// Decision is the outcome of the settlement pipeline for an opened commitment.
+type Decision struct {
+	// SettlementType is empty if the commitment is not settled.
+	SettlementType     SettlementType
+	ResidualPercentage *big.Int
+	// Reason explains why the commitment is slashed or not settled.
+	Reason string
+}
+
+// decide computes the settlement of the opened commitment without sending any
+// transaction, so that it can also be used to audit past settlements.
+func (u *Updater) decide(
+	ctx context.Context,
+	update *preconf.PreconfmanagerOpenedCommitmentStored,
+) (*Decision, error) {
 	winner, err := u.winnerRegister.GetWinner(ctx, int64(update.BlockNumber))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			log.Warn("winner not found", "blockNumber", update.BlockNumber)
 			u.metrics.NoWinnerCount.Inc()
-			return nil
+			return &Decision{Reason: "winner not found"}, nil
 		}
 		u.logger.Error(
 			"failed to get winner",
 			"blockNumber", update.BlockNumber,
 			"error", err,
 		)
-		return err
+		return nil, err
 	}
 
 	if common.BytesToAddress(winner.Winner).Cmp(update.Committer) != 0 {
@@ -282,7 +313,7 @@ func (u *Updater) handleOpenedCommitment(
 			"committer", update.Committer.Hex(),
 			"blockNumber", update.BlockNumber,
 		)
-		return nil
+		return &Decision{Reason: "winner is not the committer"}, nil
 	}
 
 	txns, err := u.getL1Txns(ctx, update.BlockNumber)
@@ -292,7 +323,7 @@ func (u *Updater) handleOpenedCommitment(
 			"blockNumber", update.BlockNumber,
 			"error", err,
 		)
-		return err
+		return nil, err
 	}
 	// Compute the decay percentage
 	residualPercentage := u.computeResidualAfterDecay(
@@ -322,7 +353,7 @@ func (u *Updater) handleOpenedCommitment(
 					"commitmentIdx", common.Bytes2Hex(update.CommitmentIndex[:]),
 					"error", err,
 				)
-				return err
+				return nil, err
 			}
 		}
 	}
@@ -355,19 +386,18 @@ func (u *Updater) handleOpenedCommitment(
 							"blockNumber", update.BlockNumber,
 							"shutter option", sOpt,
 						)
-						return nil
+						return &Decision{Reason: "shutterised bid option present"}, nil
 					}
 				}
 			}
 
 			// The committer did not include the transactions in the block
 			// correctly, so this is a slash to be processed
-			return u.settle(
-				ctx,
-				update,
-				SettlementTypeSlash,
-				residualPercentage,
-			)
+			return &Decision{
+				SettlementType:     SettlementTypeSlash,
+				ResidualPercentage: residualPercentage,
+				Reason:             "bundle does not satisfy committed requirements",
+			}, nil
 		}
 
 		if u.bidOptionsSlashEnabled {
@@ -400,20 +430,17 @@ func (u *Updater) handleOpenedCommitment(
 		)
 		// The committer did not include the transactions in the block
 		// correctly, so this is a slash to be processed
-		return u.settle(
-			ctx,
-			update,
-			SettlementTypeSlash,
-			residualPercentage,
-		)
+		return &Decision{
+			SettlementType:     SettlementTypeSlash,
+			ResidualPercentage: residualPercentage,
+			Reason:             "positional constraints not satisfied",
+		}, nil
 	}
 
-	return u.settle(
-		ctx,
-		update,
-		SettlementTypeReward,
-		residualPercentage,
-	)
+	return &Decision{
+		SettlementType:     SettlementTypeReward,
+		ResidualPercentage: residualPercentage,
+	}, nil
 }
 
 func (u *Updater) settle(
@@ -1079,6 +1079,143 @@ func TestUpdaterIgnoreCommitments(t *testing.T) {
 	}
 }
 
+func TestUpdaterAudit(t *testing.T) {
+	t.Parallel()
+
+	startTimestamp := time.UnixMilli(1615195200000)
+	midTimestamp := startTimestamp.Add(time.Duration(2.5 * float64(time.Second)))
+	endTimestamp := startTimestamp.Add(5 * time.Second)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	builderAddr := common.HexToAddress("0xabcd")
+	otherBuilderAddr := common.HexToAddress("0xabdd")
+
+	signer := types.NewLondonSigner(big.NewInt(5))
+	var txns []*types.Transaction
+	for i := range 4 {
+		txns = append(txns, types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+			Nonce:     uint64(i + 1),
+			Gas:       1000000,
+			Value:     big.NewInt(1),
+			GasTipCap: big.NewInt(500),
+			GasFeeCap: big.NewInt(500),
+		}))
+	}
+
+	commitments := make([]*preconf.PreconfmanagerOpenedCommitmentStored, 0)
+	for i, txn := range txns {
+		committer := builderAddr
+		if i == 1 {
+			committer = otherBuilderAddr
+		}
+		commitments = append(commitments, &preconf.PreconfmanagerOpenedCommitmentStored{
+			CommitmentIndex:     getIdxBytes(int64(i)),
+			Committer:           committer,
+			BidAmt:              big.NewInt(10),
+			SlashAmt:            big.NewInt(0),
+			TxnHash:             strings.TrimPrefix(txn.Hash().Hex(), "0x"),
+			BlockNumber:         5,
+			CommitmentDigest:    common.HexToHash(fmt.Sprintf("0x%02d", i)),
+			DecayStartTimeStamp: uint64(startTimestamp.UnixMilli()),
+			DecayEndTimeStamp:   uint64(endTimestamp.UnixMilli()),
+			DispatchTimestamp:   uint64(midTimestamp.UnixMilli()),
+		})
+	}
+	// duplicate commitment
+	commitments = append(commitments, commitments[0])
+
+	register := &testWinnerRegister{
+		winners: []testWinner{
+			{
+				blockNum: 5,
+				winner: updater.Winner{
+					Winner: builderAddr.Bytes(),
+				},
+			},
+		},
+	}
+
+	body := &types.Body{Transactions: txns[:3], Uncles: nil}
+	l1Client := &testEVMClient{
+		blocks: map[int64]*types.Block{
+			5: types.NewBlock(&types.Header{}, body, []*types.Receipt{}, trie.NewStackTrie(nil)),
+		},
+	}
+
+	updtr, err := updater.NewUpdater(
+		util.NewTestLogger(io.Discard),
+		l1Client,
+		register,
+		nil,
+		nil,
+		&testBatcher{},
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := testSettlementRecords{
+		getIdxBytes(0): {Type: updater.SettlementTypeReward, DecayPercentage: 50 * updater.PRECISION, Settled: true},
+		getIdxBytes(1): {Type: updater.SettlementTypeReward, DecayPercentage: 50 * updater.PRECISION, Settled: true},
+		getIdxBytes(2): {Type: updater.SettlementTypeReward, DecayPercentage: 40 * updater.PRECISION, Settled: true},
+	}
+	processed := map[[32]byte]bool{
+		getIdxBytes(0): false,
+		getIdxBytes(1): false,
+		getIdxBytes(2): false,
+		getIdxBytes(3): true,
+	}
+
+	entries, err := updtr.Audit(context.Background(), commitments, records, processed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		decision   updater.SettlementType
+		reason     string
+		mismatches []string
+	}{
+		{decision: updater.SettlementTypeReward},
+		{
+			reason:     "winner is not the committer",
+			mismatches: []string{"recorded_type", "onchain_type"},
+		},
+		{
+			decision:   updater.SettlementTypeReward,
+			mismatches: []string{"recorded_decay_percentage"},
+		},
+		{
+			// the transaction is not included in the block
+			decision:   updater.SettlementTypeSlash,
+			mismatches: []string{"recorded_type"},
+		},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), len(entries))
+	}
+	for i, w := range want {
+		e := entries[i]
+		if e.Decision != w.decision {
+			t.Fatalf("entry %d: expected decision %q, got %q", i, w.decision, e.Decision)
+		}
+		if w.reason != "" && e.Reason != w.reason {
+			t.Fatalf("entry %d: expected reason %q, got %q", i, w.reason, e.Reason)
+		}
+		if strings.Join(e.Mismatches, ",") != strings.Join(w.mismatches, ",") {
+			t.Fatalf("entry %d: expected mismatches %v, got %v", i, w.mismatches, e.Mismatches)
+		}
+	}
+	if entries[0].DecayPercentage != 50*updater.PRECISION {
+		t.Fatalf("wrong decay percentage %d", entries[0].DecayPercentage)
+	}
+}
+
 func TestComputeResidualAfterDecay(t *testing.T) {
 	t.Parallel()
 
@@ -1737,3 +1874,16 @@ func TestBidOptions(t *testing.T) {
 		})
 	}
 }
+
+type testSettlementRecords map[[32]byte]*updater.SettlementRecord
+
+func (t testSettlementRecords) GetSettlement(
+	_ context.Context,
+	commitmentIdx []byte,
+) (*updater.SettlementRecord, error) {
+	r, ok := t[[32]byte(commitmentIdx)]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return r, nil
+}
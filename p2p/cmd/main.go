@@ -18,7 +18,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-logr/logr"
 	mevcommit "github.com/primev/mev-commit/p2p"
+	"github.com/primev/mev-commit/p2p/pkg/bidpolicy"
+	"github.com/primev/mev-commit/p2p/pkg/exposure"
 	"github.com/primev/mev-commit/p2p/pkg/node"
+	"github.com/primev/mev-commit/p2p/pkg/replay"
+	pebblestorage "github.com/primev/mev-commit/p2p/pkg/storage/pebble"
 	"github.com/primev/mev-commit/x/epoch"
 	ks "github.com/primev/mev-commit/x/keysigner"
 	"github.com/primev/mev-commit/x/util"
@@ -88,6 +92,13 @@ var (
 		Category: categoryGlobal,
 	})
 
+	optionDryRun = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:     "dry-run",
+		Usage:    "Report the pending storage migrations of the data directory and exit without starting the node",
+		EnvVars:  []string{"MEV_COMMIT_DRY_RUN"},
+		Category: categoryGlobal,
+	})
+
 	optionPrivKeyFile = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "priv-key-file",
 		Usage:    "Path to private key file",
@@ -111,6 +122,26 @@ var (
 		Category: categoryGlobal,
 	})
 
+	optionRemoteSignerURL = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "remote-signer-url",
+		Usage:    "URL of the Web3Signer compatible signing service, if set the private key is never loaded in the node. The libp2p identity then uses a separate key kept in the node storage, which is bound to the account during the handshake",
+		EnvVars:  []string{"MEV_COMMIT_REMOTE_SIGNER_URL"},
+		Category: categoryGlobal,
+	})
+
+	optionRemoteSignerAddress = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "remote-signer-address",
+		Usage:    "Address of the key to use on the remote signing service, required if it holds more than one key",
+		EnvVars:  []string{"MEV_COMMIT_REMOTE_SIGNER_ADDRESS"},
+		Category: categoryGlobal,
+		Action: func(ctx *cli.Context, s string) error {
+			if s != "" && !common.IsHexAddress(s) {
+				return fmt.Errorf("invalid remote signer address: %s", s)
+			}
+			return nil
+		},
+	})
+
 	optionPeerType = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "peer-type",
 		Usage:    "Peer type to use, options are 'bidder', 'provider' or 'bootnode'",
@@ -327,6 +358,14 @@ var (
 		Category: categoryEthRPC,
 	})
 
+	optionSettlementConfirmations = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "settlement-confirmations",
+		Usage:    "Number of blocks the settlement layer events must be deep before they are processed",
+		EnvVars:  []string{"MEV_COMMIT_SETTLEMENT_CONFIRMATIONS"},
+		Value:    0,
+		Category: categoryEthRPC,
+	})
+
 	optionNATAddr = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "nat-addr",
 		Usage:    "External address of the node to advertise to other peers",
@@ -395,6 +434,30 @@ var (
 		Category: categoryGlobal,
 	})
 
+	optionFeeBumpInterval = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:     "fee-bump-interval",
+		Usage:    "Replace transactions pending for longer than the interval with bumped fees, 0 disables the replacements",
+		EnvVars:  []string{"MEV_COMMIT_FEE_BUMP_INTERVAL"},
+		Value:    0,
+		Category: categoryGlobal,
+	})
+
+	optionMaxGasTipCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "max-gas-tip-cap",
+		Usage:    "Maximum gas tip cap of the transactions replaced with bumped fees",
+		EnvVars:  []string{"MEV_COMMIT_MAX_GAS_TIP_CAP"},
+		Value:    "500000000", // 0.5 gWEI
+		Category: categoryGlobal,
+	})
+
+	optionMaxGasFeeCap = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "max-gas-fee-cap",
+		Usage:    "Maximum gas fee cap of the transactions replaced with bumped fees",
+		EnvVars:  []string{"MEV_COMMIT_MAX_GAS_FEE_CAP"},
+		Value:    "600000000", // 0.6 gWEI
+		Category: categoryGlobal,
+	})
+
 	optionBeaconAPIURL = altsrc.NewStringFlag(&cli.StringFlag{
 		Name:     "beacon-api-url",
 		Usage:    "URL of the beacon chain API",
@@ -436,6 +499,34 @@ var (
 		Category: categoryProvider,
 	})
 
+	optionCommitmentOpenAttempts = altsrc.NewIntFlag(&cli.IntFlag{
+		Name:     "commitment-open-attempts",
+		Usage:    "Maximum number of attempts to open a commitment, a failed open is retried with the next L1 block",
+		EnvVars:  []string{"MEV_COMMIT_COMMITMENT_OPEN_ATTEMPTS"},
+		Value:    3,
+		Category: categoryContracts,
+		Action: func(_ *cli.Context, v int) error {
+			if v < 1 {
+				return fmt.Errorf("commitment-open-attempts must be at least 1")
+			}
+			return nil
+		},
+	})
+
+	optionCommitmentOpenWindow = altsrc.NewInt64Flag(&cli.Int64Flag{
+		Name:     "commitment-open-window",
+		Usage:    "Number of L1 blocks after the block of a commitment during which it is opened, it should not exceed the blocks after which the oracle processes a block",
+		EnvVars:  []string{"MEV_COMMIT_COMMITMENT_OPEN_WINDOW"},
+		Value:    10,
+		Category: categoryContracts,
+		Action: func(_ *cli.Context, v int64) error {
+			if v < 1 {
+				return fmt.Errorf("commitment-open-window must be at least 1")
+			}
+			return nil
+		},
+	})
+
 	optionLaggardMode = altsrc.NewIntFlag(&cli.IntFlag{
 		Name:     "laggard-mode",
 		Usage:    "No of blocks to lag behind for L1 chain when fetching validator duties",
@@ -452,6 +543,14 @@ var (
 		Category: categoryGlobal,
 	})
 
+	optionNotificationsRetention = altsrc.NewDurationFlag(&cli.DurationFlag{
+		Name:     "notifications-retention",
+		Usage:    "Duration for which the notifications are retained for replay, 0 retains them forever",
+		EnvVars:  []string{"MEV_COMMIT_NOTIFICATIONS_RETENTION"},
+		Value:    24 * time.Hour,
+		Category: categoryGlobal,
+	})
+
 	optionProposerNotifyOffset = altsrc.NewDurationFlag(&cli.DurationFlag{
 		Name:     "proposer-notify-offset",
 		Usage:    "Time offset that a notification is sent, prior to the start of a slot where the proposer is opted-in to mev-commit",
@@ -482,16 +581,135 @@ var (
 		EnvVars:  []string{"MEV_COMMIT_SHUTTER_SEQUENCER_ENDPOINT"},
 		Category: categoryProvider,
 	})
+
+	optionProviderDecisionMode = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:    "provider-decision-mode",
+		Usage:   "How bids are decided on the provider node, options are 'external' (decision stream only), 'auto' (policy rules only) or 'filter' (policy rules in front of the decision stream)",
+		EnvVars: []string{"MEV_COMMIT_PROVIDER_DECISION_MODE"},
+		Value:   string(bidpolicy.ModeExternal),
+		Action: stringInCheck("provider-decision-mode", []string{
+			string(bidpolicy.ModeExternal),
+			string(bidpolicy.ModeAuto),
+			string(bidpolicy.ModeFilter),
+		}),
+		Category: categoryProvider,
+	})
+
+	optionPolicyMinBidPerGas = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "policy-min-bid-per-gas",
+		Usage:    "Minimum bid amount in wei per unit of gas, bids without raw transactions are rejected when set",
+		EnvVars:  []string{"MEV_COMMIT_POLICY_MIN_BID_PER_GAS"},
+		Category: categoryProvider,
+	})
+
+	optionPolicyAllowedBidders = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "policy-allowed-bidders",
+		Usage:   "List of bidder addresses allowed to bid, if empty all bidders are allowed",
+		EnvVars: []string{"MEV_COMMIT_POLICY_ALLOWED_BIDDERS"},
+		Action: func(ctx *cli.Context, vals []string) error {
+			for i, v := range vals {
+				if !common.IsHexAddress(v) {
+					return fmt.Errorf("invalid bidder address at index %d: %s", i, v)
+				}
+			}
+			return nil
+		},
+		Category: categoryProvider,
+	})
+
+	optionPolicyDeniedBidders = altsrc.NewStringSliceFlag(&cli.StringSliceFlag{
+		Name:    "policy-denied-bidders",
+		Usage:   "List of bidder addresses whose bids are always rejected",
+		EnvVars: []string{"MEV_COMMIT_POLICY_DENIED_BIDDERS"},
+		Action: func(ctx *cli.Context, vals []string) error {
+			for i, v := range vals {
+				if !common.IsHexAddress(v) {
+					return fmt.Errorf("invalid bidder address at index %d: %s", i, v)
+				}
+			}
+			return nil
+		},
+		Category: categoryProvider,
+	})
+
+	optionPolicyMaxSlashAmount = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "policy-max-slash-amount",
+		Usage:    "Maximum slash amount in wei accepted for a single bid",
+		EnvVars:  []string{"MEV_COMMIT_POLICY_MAX_SLASH_AMOUNT"},
+		Category: categoryProvider,
+	})
+
+	optionPolicyMaxBlocksAhead = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "policy-max-blocks-ahead",
+		Usage:    "Maximum number of L1 blocks ahead of the current block a bid can target, 0 disables the check",
+		EnvVars:  []string{"MEV_COMMIT_POLICY_MAX_BLOCKS_AHEAD"},
+		Category: categoryProvider,
+	})
+
+	optionPolicyRejectPositionConstraints = altsrc.NewBoolFlag(&cli.BoolFlag{
+		Name:     "policy-reject-position-constraints",
+		Usage:    "Whether bids carrying position constraints should be rejected",
+		EnvVars:  []string{"MEV_COMMIT_POLICY_REJECT_POSITION_CONSTRAINTS"},
+		Category: categoryProvider,
+	})
+
+	optionExposureMaxSlashPerBlock = altsrc.NewStringFlag(&cli.StringFlag{
+		Name:     "exposure-max-slash-per-block",
+		Usage:    "Maximum aggregate slash amount in wei of the commitments for a single block, 0 disables the limit",
+		EnvVars:  []string{"MEV_COMMIT_EXPOSURE_MAX_SLASH_PER_BLOCK"},
+		Category: categoryProvider,
+	})
+
+	optionExposureMaxCommitmentsPerBidder = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "exposure-max-commitments-per-bidder",
+		Usage:    "Maximum number of commitments a single bidder can get for a block, 0 disables the limit",
+		EnvVars:  []string{"MEV_COMMIT_EXPOSURE_MAX_COMMITMENTS_PER_BIDDER"},
+		Category: categoryProvider,
+	})
+
+	optionExposureMaxStakePercent = altsrc.NewUint64Flag(&cli.Uint64Flag{
+		Name:     "exposure-max-stake-percent",
+		Usage:    "Maximum aggregate slash amount of the commitments for a single block as a percentage of the provider stake, 0 disables the limit",
+		EnvVars:  []string{"MEV_COMMIT_EXPOSURE_MAX_STAKE_PERCENT"},
+		Category: categoryProvider,
+	})
+)
+
+var (
+	optionReplayDataDir = &cli.StringFlag{
+		Name:    "data-dir",
+		Usage:   "Path to the data directory of the replayed node",
+		EnvVars: []string{"MEV_COMMIT_DATA_DIR"},
+		Value:   filepath.Join(defaultConfigDir, defaultDataDir),
+	}
+
+	optionReplayFromBlock = &cli.Int64Flag{
+		Name:  "from-block",
+		Usage: "first L1 block of the replayed commitments, all the blocks are replayed if not set",
+	}
+
+	optionReplayToBlock = &cli.Int64Flag{
+		Name:  "to-block",
+		Usage: "last L1 block of the replayed commitments, all the blocks are replayed if not set",
+	}
+
+	optionReplayOutput = &cli.StringFlag{
+		Name:  "output",
+		Usage: "path of the timeline file, the timeline is written to stdout if not set",
+	}
 )
 
 func main() {
 	flags := []cli.Flag{
 		optionConfig,
 		optionDataDir,
+		optionDryRun,
 		optionPeerType,
 		optionPrivKeyFile,
 		optionKeystorePassword,
 		optionKeystorePath,
+		optionRemoteSignerURL,
+		optionRemoteSignerAddress,
 		optionP2PPort,
 		optionP2PAddr,
 		optionHTTPPort,
@@ -513,6 +731,7 @@ func main() {
 		optionTargetDepositAmount,
 		optionSettlementRPCEndpoint,
 		optionSettlementWSRPCEndpoint,
+		optionSettlementConfirmations,
 		optionNATAddr,
 		optionNATPort,
 		optionServerTLSCert,
@@ -521,17 +740,33 @@ func main() {
 		optionGasLimit,
 		optionGasTipCap,
 		optionGasFeeCap,
+		optionFeeBumpInterval,
+		optionMaxGasTipCap,
+		optionMaxGasFeeCap,
 		optionBeaconAPIURL,
 		optionL1RPCURL,
 		optionOTelCollectorEndpointURL,
 		optionBidderBidTimeout,
 		optionProviderDecisionTimeout,
 		optionNotificationsBuffer,
+		optionNotificationsRetention,
 		optionLaggardMode,
+		optionCommitmentOpenAttempts,
+		optionCommitmentOpenWindow,
 		optionProposerNotifyOffset,
 		optionSlotDuration,
 		optionSlotsPerEpoch,
 		optionShutterSequencerEndpoint,
+		optionProviderDecisionMode,
+		optionPolicyMinBidPerGas,
+		optionPolicyAllowedBidders,
+		optionPolicyDeniedBidders,
+		optionPolicyMaxSlashAmount,
+		optionPolicyMaxBlocksAhead,
+		optionPolicyRejectPositionConstraints,
+		optionExposureMaxSlashPerBlock,
+		optionExposureMaxCommitmentsPerBidder,
+		optionExposureMaxStakePercent,
 	}
 
 	app := &cli.App{
@@ -541,6 +776,19 @@ func main() {
 		Flags:   flags,
 		Before:  altsrc.InitInputSourceWithContext(flags, altsrc.NewYamlSourceFromFlagFunc(optionConfig.Name)),
 		Action:  initializeApplication,
+		Commands: []*cli.Command{
+			{
+				Name:  "replay",
+				Usage: "Write the timeline of the commitments in the data directory of a stopped node as JSON lines",
+				Flags: []cli.Flag{
+					optionReplayDataDir,
+					optionReplayFromBlock,
+					optionReplayToBlock,
+					optionReplayOutput,
+				},
+				Action: runReplay,
+			},
+		},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -564,7 +812,48 @@ func main() {
 	os.Exit(0)
 }
 
+// runReplay opens the data directory read-only and writes the timeline and
+// the block summaries of the stored commitments.
+func runReplay(c *cli.Context) error {
+	dbPath, err := util.ResolveFilePath(c.String(optionReplayDataDir.Name))
+	if err != nil {
+		return fmt.Errorf("failed to resolve data directory: %w", err)
+	}
+
+	from, to := c.Int64(optionReplayFromBlock.Name), c.Int64(optionReplayToBlock.Name)
+	if to != 0 && from > to {
+		return fmt.Errorf("from block %d is greater than to block %d", from, to)
+	}
+
+	st, err := pebblestorage.NewReadOnly(dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open data directory: %w", err)
+	}
+	//nolint:errcheck
+	defer st.Close()
+
+	output := c.App.Writer
+	if path := c.String(optionReplayOutput.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create timeline file: %w", err)
+		}
+		//nolint:errcheck
+		defer f.Close()
+		output = f
+	}
+
+	err = replay.Replay(st, &replay.Options{FromBlock: from, ToBlock: to}, output)
+	if err != nil {
+		return fmt.Errorf("failed to replay data directory: %w", err)
+	}
+	return nil
+}
+
 func initializeApplication(c *cli.Context) error {
+	if c.Bool(optionDryRun.Name) {
+		return reportPendingMigrations(c)
+	}
 	if err := verifyKeystorePasswordPresence(c); err != nil {
 		return err
 	}
@@ -574,6 +863,44 @@ func initializeApplication(c *cli.Context) error {
 	return nil
 }
 
+// reportPendingMigrations writes the storage schema version of the data
+// directory and the migrations which would run on the next start.
+func reportPendingMigrations(c *cli.Context) error {
+	if c.String(optionDataDir.Name) == "" {
+		_, _ = fmt.Fprintln(c.App.Writer, "no data directory configured, the node uses in-memory storage")
+		return nil
+	}
+	dbPath, err := util.ResolveFilePath(c.String(optionDataDir.Name))
+	if err != nil {
+		return fmt.Errorf("failed to resolve data directory: %w", err)
+	}
+
+	logger, err := util.NewLogger(
+		c.String(optionLogLevel.Name),
+		c.String(optionLogFmt.Name),
+		c.String(optionLogTags.Name),
+		c.App.ErrWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	version, pending, err := node.PendingMigrations(dbPath, logger)
+	if err != nil {
+		return fmt.Errorf("failed to read storage migrations: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(c.App.Writer, "storage schema version: %d\n", version)
+	if len(pending) == 0 {
+		_, _ = fmt.Fprintln(c.App.Writer, "no pending migrations")
+		return nil
+	}
+	for _, m := range pending {
+		_, _ = fmt.Fprintf(c.App.Writer, "pending migration %d: %s\n", m.Version, m.Name)
+	}
+	return nil
+}
+
 // verifyKeystorePasswordPresence checks for the presence of a keystore password.
 // it returns error, if keystore path is set and keystore password is not
 func verifyKeystorePasswordPresence(c *cli.Context) error {
@@ -644,6 +971,32 @@ func launchNodeWithConfig(c *cli.Context) (err error) {
 		}
 	}
 
+	maxGasTipCap, ok := new(big.Int).SetString(c.String(optionMaxGasTipCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas tip cap %q", c.String(optionMaxGasTipCap.Name))
+	}
+	maxGasFeeCap, ok := new(big.Int).SetString(c.String(optionMaxGasFeeCap.Name), 10)
+	if !ok {
+		return fmt.Errorf("failed to parse max gas fee cap %q", c.String(optionMaxGasFeeCap.Name))
+	}
+
+	bidPolicy, err := newBidPolicy(c)
+	if err != nil {
+		return err
+	}
+
+	exposureLimits := &exposure.Limits{
+		MaxCommitmentsPerBidder: c.Uint64(optionExposureMaxCommitmentsPerBidder.Name),
+		MaxStakePercent:         c.Uint64(optionExposureMaxStakePercent.Name),
+	}
+	if v := c.String(optionExposureMaxSlashPerBlock.Name); v != "" {
+		maxSlash, ok := new(big.Int).SetString(v, 10)
+		if !ok || maxSlash.Sign() < 0 {
+			return fmt.Errorf("failed to parse max slash per block %q", v)
+		}
+		exposureLimits.MaxSlashPerBlock = maxSlash
+	}
+
 	dbPath := ""
 	if c.String(optionDataDir.Name) != "" {
 		dbPath, err = util.ResolveFilePath(c.String(optionDataDir.Name))
@@ -701,6 +1054,7 @@ func launchNodeWithConfig(c *cli.Context) (err error) {
 		OracleContract:           c.String(optionOracleAddr.Name),
 		RPCEndpoint:              c.String(optionSettlementRPCEndpoint.Name),
 		WSRPCEndpoint:            c.String(optionSettlementWSRPCEndpoint.Name),
+		SettlementConfirmations:  c.Uint64(optionSettlementConfirmations.Name),
 		NatAddr:                  natAddr,
 		TLSCertificateFile:       crtFile,
 		TLSPrivateKeyFile:        keyFile,
@@ -708,16 +1062,25 @@ func launchNodeWithConfig(c *cli.Context) (err error) {
 		DefaultGasLimit:          uint64(c.Int(optionGasLimit.Name)),
 		DefaultGasTipCap:         gasTipCap,
 		DefaultGasFeeCap:         gasFeeCap,
+		FeeBumpInterval:          c.Duration(optionFeeBumpInterval.Name),
+		MaxGasTipCap:             maxGasTipCap,
+		MaxGasFeeCap:             maxGasFeeCap,
 		BeaconAPIURL:             c.String(optionBeaconAPIURL.Name),
 		L1RPCURL:                 c.String(optionL1RPCURL.Name),
 		LaggardMode:              big.NewInt(int64(c.Int(optionLaggardMode.Name))),
 		BidderBidTimeout:         c.Duration(optionBidderBidTimeout.Name),
 		ProviderDecisionTimeout:  c.Duration(optionProviderDecisionTimeout.Name),
 		NotificationsBufferCap:   c.Int(optionNotificationsBuffer.Name),
+		NotificationsRetention:   c.Duration(optionNotificationsRetention.Name),
 		ProposerNotifyOffset:     c.Duration(optionProposerNotifyOffset.Name),
 		SlotDuration:             c.Duration(optionSlotDuration.Name),
 		SlotsPerEpoch:            c.Uint64(optionSlotsPerEpoch.Name),
 		ShutterSequencerEndpoint: c.String(optionShutterSequencerEndpoint.Name),
+		ProviderDecisionMode:     bidpolicy.Mode(c.String(optionProviderDecisionMode.Name)),
+		ProviderBidPolicy:        bidPolicy,
+		ProviderExposureLimits:   exposureLimits,
+		CommitmentOpenAttempts:   c.Int(optionCommitmentOpenAttempts.Name),
+		CommitmentOpenWindow:     c.Int64(optionCommitmentOpenWindow.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("failed starting node: %w", err)
@@ -746,8 +1109,43 @@ func launchNodeWithConfig(c *cli.Context) (err error) {
 }
 
 func newKeySigner(c *cli.Context) (ks.KeySigner, error) {
+	if c.IsSet(optionRemoteSignerURL.Name) {
+		return ks.NewRemoteSigner(
+			c.String(optionRemoteSignerURL.Name),
+			common.HexToAddress(c.String(optionRemoteSignerAddress.Name)),
+			0,
+		)
+	}
 	if c.IsSet(optionKeystorePath.Name) {
 		return ks.NewKeystoreSigner(c.String(optionKeystorePath.Name), c.String(optionKeystorePassword.Name))
 	}
 	return ks.NewPrivateKeySigner(c.String(optionPrivKeyFile.Name))
 }
+
+func newBidPolicy(c *cli.Context) (*bidpolicy.Policy, error) {
+	policy := &bidpolicy.Policy{
+		MaxBlocksAhead:            c.Uint64(optionPolicyMaxBlocksAhead.Name),
+		RejectPositionConstraints: c.Bool(optionPolicyRejectPositionConstraints.Name),
+	}
+	if v := c.String(optionPolicyMinBidPerGas.Name); v != "" {
+		minBidPerGas, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse min bid per gas %q", v)
+		}
+		policy.MinBidPerGas = minBidPerGas
+	}
+	if v := c.String(optionPolicyMaxSlashAmount.Name); v != "" {
+		maxSlashAmount, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse max slash amount %q", v)
+		}
+		policy.MaxSlashAmount = maxSlashAmount
+	}
+	for _, addr := range c.StringSlice(optionPolicyAllowedBidders.Name) {
+		policy.AllowedBidders = append(policy.AllowedBidders, common.HexToAddress(addr))
+	}
+	for _, addr := range c.StringSlice(optionPolicyDeniedBidders.Name) {
+		policy.DeniedBidders = append(policy.DeniedBidders, common.HexToAddress(addr))
+	}
+	return policy, nil
+}
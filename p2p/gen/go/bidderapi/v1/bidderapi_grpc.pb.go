@@ -20,20 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Bidder_SendBid_FullMethodName               = "/bidderapi.v1.Bidder/SendBid"
-	Bidder_Deposit_FullMethodName               = "/bidderapi.v1.Bidder/Deposit"
-	Bidder_DepositEvenly_FullMethodName         = "/bidderapi.v1.Bidder/DepositEvenly"
-	Bidder_EnableDepositManager_FullMethodName  = "/bidderapi.v1.Bidder/EnableDepositManager"
-	Bidder_DisableDepositManager_FullMethodName = "/bidderapi.v1.Bidder/DisableDepositManager"
-	Bidder_SetTargetDeposits_FullMethodName     = "/bidderapi.v1.Bidder/SetTargetDeposits"
-	Bidder_DepositManagerStatus_FullMethodName  = "/bidderapi.v1.Bidder/DepositManagerStatus"
-	Bidder_RequestWithdrawals_FullMethodName    = "/bidderapi.v1.Bidder/RequestWithdrawals"
-	Bidder_GetValidProviders_FullMethodName     = "/bidderapi.v1.Bidder/GetValidProviders"
-	Bidder_GetDeposit_FullMethodName            = "/bidderapi.v1.Bidder/GetDeposit"
-	Bidder_GetAllDeposits_FullMethodName        = "/bidderapi.v1.Bidder/GetAllDeposits"
-	Bidder_Withdraw_FullMethodName              = "/bidderapi.v1.Bidder/Withdraw"
-	Bidder_GetBidInfo_FullMethodName            = "/bidderapi.v1.Bidder/GetBidInfo"
-	Bidder_ClaimSlashedFunds_FullMethodName     = "/bidderapi.v1.Bidder/ClaimSlashedFunds"
+	Bidder_SendBid_FullMethodName                = "/bidderapi.v1.Bidder/SendBid"
+	Bidder_Deposit_FullMethodName                = "/bidderapi.v1.Bidder/Deposit"
+	Bidder_DepositEvenly_FullMethodName          = "/bidderapi.v1.Bidder/DepositEvenly"
+	Bidder_EnableDepositManager_FullMethodName   = "/bidderapi.v1.Bidder/EnableDepositManager"
+	Bidder_DisableDepositManager_FullMethodName  = "/bidderapi.v1.Bidder/DisableDepositManager"
+	Bidder_SetTargetDeposits_FullMethodName      = "/bidderapi.v1.Bidder/SetTargetDeposits"
+	Bidder_DepositManagerStatus_FullMethodName   = "/bidderapi.v1.Bidder/DepositManagerStatus"
+	Bidder_RequestWithdrawals_FullMethodName     = "/bidderapi.v1.Bidder/RequestWithdrawals"
+	Bidder_GetValidProviders_FullMethodName      = "/bidderapi.v1.Bidder/GetValidProviders"
+	Bidder_GetProviderReputations_FullMethodName = "/bidderapi.v1.Bidder/GetProviderReputations"
+	Bidder_GetDeposit_FullMethodName             = "/bidderapi.v1.Bidder/GetDeposit"
+	Bidder_GetAllDeposits_FullMethodName         = "/bidderapi.v1.Bidder/GetAllDeposits"
+	Bidder_Withdraw_FullMethodName               = "/bidderapi.v1.Bidder/Withdraw"
+	Bidder_GetBidInfo_FullMethodName             = "/bidderapi.v1.Bidder/GetBidInfo"
+	Bidder_ClaimSlashedFunds_FullMethodName      = "/bidderapi.v1.Bidder/ClaimSlashedFunds"
 )
 
 // BidderClient is the client API for Bidder service.
@@ -89,6 +90,11 @@ type BidderClient interface {
 	// - Have no pending withdrawal request with provider registry
 	// - Have at least one BLS key registered with provider registry
 	GetValidProviders(ctx context.Context, in *GetValidProvidersRequest, opts ...grpc.CallOption) (*GetValidProvidersResponse, error)
+	// GetProviderReputations
+	//
+	// GetProviderReputations is called by the bidder to get the statistics kept by the bidder
+	// node for the providers it sent bids to, along with the reputation score derived from them.
+	GetProviderReputations(ctx context.Context, in *GetProviderReputationsRequest, opts ...grpc.CallOption) (*GetProviderReputationsResponse, error)
 	// GetDeposit
 	//
 	// GetDeposit is called by the bidder to get its deposit specific to a provider in the bidder registry.
@@ -221,6 +227,16 @@ func (c *bidderClient) GetValidProviders(ctx context.Context, in *GetValidProvid
 	return out, nil
 }
 
+func (c *bidderClient) GetProviderReputations(ctx context.Context, in *GetProviderReputationsRequest, opts ...grpc.CallOption) (*GetProviderReputationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderReputationsResponse)
+	err := c.cc.Invoke(ctx, Bidder_GetProviderReputations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bidderClient) GetDeposit(ctx context.Context, in *GetDepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DepositResponse)
@@ -324,6 +340,11 @@ type BidderServer interface {
 	// - Have no pending withdrawal request with provider registry
 	// - Have at least one BLS key registered with provider registry
 	GetValidProviders(context.Context, *GetValidProvidersRequest) (*GetValidProvidersResponse, error)
+	// GetProviderReputations
+	//
+	// GetProviderReputations is called by the bidder to get the statistics kept by the bidder
+	// node for the providers it sent bids to, along with the reputation score derived from them.
+	GetProviderReputations(context.Context, *GetProviderReputationsRequest) (*GetProviderReputationsResponse, error)
 	// GetDeposit
 	//
 	// GetDeposit is called by the bidder to get its deposit specific to a provider in the bidder registry.
@@ -384,6 +405,9 @@ func (UnimplementedBidderServer) RequestWithdrawals(context.Context, *RequestWit
 func (UnimplementedBidderServer) GetValidProviders(context.Context, *GetValidProvidersRequest) (*GetValidProvidersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetValidProviders not implemented")
 }
+func (UnimplementedBidderServer) GetProviderReputations(context.Context, *GetProviderReputationsRequest) (*GetProviderReputationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProviderReputations not implemented")
+}
 func (UnimplementedBidderServer) GetDeposit(context.Context, *GetDepositRequest) (*DepositResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDeposit not implemented")
 }
@@ -575,6 +599,24 @@ func _Bidder_GetValidProviders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bidder_GetProviderReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderReputationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidderServer).GetProviderReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bidder_GetProviderReputations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidderServer).GetProviderReputations(ctx, req.(*GetProviderReputationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bidder_GetDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDepositRequest)
 	if err := dec(in); err != nil {
@@ -704,6 +746,10 @@ var Bidder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidProviders",
 			Handler:    _Bidder_GetValidProviders_Handler,
 		},
+		{
+			MethodName: "GetProviderReputations",
+			Handler:    _Bidder_GetProviderReputations_Handler,
+		},
 		{
 			MethodName: "GetDeposit",
 			Handler:    _Bidder_GetDeposit_Handler,
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: commitmentapi/v1/commitmentapi.proto
+
+package commitmentapiv1
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Commitments_QueryCommitments_FullMethodName  = "/commitmentapi.v1.Commitments/QueryCommitments"
+	Commitments_ExportCommitments_FullMethodName = "/commitmentapi.v1.Commitments/ExportCommitments"
+)
+
+// CommitmentsClient is the client API for Commitments service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommitmentsClient interface {
+	// QueryCommitments
+	//
+	// QueryCommitments is called by the bidder or the provider to query the historical commitments
+	// stored by the node. The commitments are returned in the ascending order of the block number
+	// and the descending order of the bid amount. The next_cursor of the response can be used to
+	// retrieve the next page.
+	QueryCommitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error)
+	// ExportCommitments
+	//
+	// ExportCommitments is called by the bidder or the provider to export all the commitments
+	// matching the filters as CSV or JSON lines. Every message of the stream contains a single
+	// line of the export.
+	ExportCommitments(ctx context.Context, in *ExportCommitmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+}
+
+type commitmentsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommitmentsClient(cc grpc.ClientConnInterface) CommitmentsClient {
+	return &commitmentsClient{cc}
+}
+
+func (c *commitmentsClient) QueryCommitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCommitmentsResponse)
+	err := c.cc.Invoke(ctx, Commitments_QueryCommitments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commitmentsClient) ExportCommitments(ctx context.Context, in *ExportCommitmentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Commitments_ServiceDesc.Streams[0], Commitments_ExportCommitments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCommitmentsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Commitments_ExportCommitmentsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+// CommitmentsServer is the server API for Commitments service.
+// All implementations must embed UnimplementedCommitmentsServer
+// for forward compatibility.
+type CommitmentsServer interface {
+	// QueryCommitments
+	//
+	// QueryCommitments is called by the bidder or the provider to query the historical commitments
+	// stored by the node. The commitments are returned in the ascending order of the block number
+	// and the descending order of the bid amount. The next_cursor of the response can be used to
+	// retrieve the next page.
+	QueryCommitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error)
+	// ExportCommitments
+	//
+	// ExportCommitments is called by the bidder or the provider to export all the commitments
+	// matching the filters as CSV or JSON lines. Every message of the stream contains a single
+	// line of the export.
+	ExportCommitments(*ExportCommitmentsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	mustEmbedUnimplementedCommitmentsServer()
+}
+
+// UnimplementedCommitmentsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommitmentsServer struct{}
+
+func (UnimplementedCommitmentsServer) QueryCommitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryCommitments not implemented")
+}
+func (UnimplementedCommitmentsServer) ExportCommitments(*ExportCommitmentsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportCommitments not implemented")
+}
+func (UnimplementedCommitmentsServer) mustEmbedUnimplementedCommitmentsServer() {}
+func (UnimplementedCommitmentsServer) testEmbeddedByValue()                     {}
+
+// UnsafeCommitmentsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommitmentsServer will
+// result in compilation errors.
+type UnsafeCommitmentsServer interface {
+	mustEmbedUnimplementedCommitmentsServer()
+}
+
+func RegisterCommitmentsServer(s grpc.ServiceRegistrar, srv CommitmentsServer) {
+	// If the following call panics, it indicates UnimplementedCommitmentsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Commitments_ServiceDesc, srv)
+}
+
+func _Commitments_QueryCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitmentsServer).QueryCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Commitments_QueryCommitments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitmentsServer).QueryCommitments(ctx, req.(*QueryCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commitments_ExportCommitments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCommitmentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommitmentsServer).ExportCommitments(m, &grpc.GenericServerStream[ExportCommitmentsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Commitments_ExportCommitmentsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+// Commitments_ServiceDesc is the grpc.ServiceDesc for Commitments service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Commitments_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commitmentapi.v1.Commitments",
+	HandlerType: (*CommitmentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryCommitments",
+			Handler:    _Commitments_QueryCommitments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCommitments",
+			Handler:       _Commitments_ExportCommitments_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "commitmentapi/v1/commitmentapi.proto",
+}
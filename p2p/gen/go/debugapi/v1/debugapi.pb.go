@@ -313,6 +313,100 @@ func (x *CancelTransactionResponse) GetTxHash() string {
 	return ""
 }
 
+type OpenCommitmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitmentDigest string `protobuf:"bytes,1,opt,name=commitment_digest,json=commitmentDigest,proto3" json:"commitment_digest,omitempty"`
+}
+
+func (x *OpenCommitmentReq) Reset() {
+	*x = OpenCommitmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debugapi_v1_debugapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenCommitmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCommitmentReq) ProtoMessage() {}
+
+func (x *OpenCommitmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_debugapi_v1_debugapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCommitmentReq.ProtoReflect.Descriptor instead.
+func (*OpenCommitmentReq) Descriptor() ([]byte, []int) {
+	return file_debugapi_v1_debugapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OpenCommitmentReq) GetCommitmentDigest() string {
+	if x != nil {
+		return x.CommitmentDigest
+	}
+	return ""
+}
+
+type OpenCommitmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *OpenCommitmentResponse) Reset() {
+	*x = OpenCommitmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debugapi_v1_debugapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenCommitmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCommitmentResponse) ProtoMessage() {}
+
+func (x *OpenCommitmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debugapi_v1_debugapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCommitmentResponse.ProtoReflect.Descriptor instead.
+func (*OpenCommitmentResponse) Descriptor() ([]byte, []int) {
+	return file_debugapi_v1_debugapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenCommitmentResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
 var File_debugapi_v1_debugapi_proto protoreflect.FileDescriptor
 
 var file_debugapi_v1_debugapi_proto_rawDesc = []byte{
@@ -442,7 +536,54 @@ var file_debugapi_v1_debugapi_proto_rawDesc = []byte{
 	0x38, 0x66, 0x32, 0x64, 0x37, 0x66, 0x66, 0x37, 0x65, 0x38, 0x31, 0x34, 0x66, 0x39, 0x63, 0x33,
 	0x36, 0x31, 0x37, 0x39, 0x38, 0x33, 0x37, 0x30, 0x33, 0x34, 0x33, 0x35, 0x65, 0x61, 0x37, 0x34,
 	0x34, 0x36, 0x64, 0x65, 0x34, 0x32, 0x30, 0x61, 0x65, 0x61, 0x63, 0x34, 0x38, 0x38, 0x62, 0x66,
-	0x31, 0x64, 0x65, 0x33, 0x35, 0x37, 0x33, 0x37, 0x65, 0x38, 0x22, 0x7d, 0x32, 0x8c, 0x03, 0x0a,
+	0x31, 0x64, 0x65, 0x33, 0x35, 0x37, 0x33, 0x37, 0x65, 0x38, 0x22, 0x7d, 0x22, 0xb9, 0x03, 0x0a,
+	0x11, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0xf2, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xc4,
+	0x01, 0x92, 0x41, 0x50, 0x32, 0x3c, 0x48, 0x65, 0x78, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x70, 0x65,
+	0x6e, 0x2e, 0x8a, 0x01, 0x0f, 0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x36, 0x34, 0x7d, 0xba, 0x48, 0x6e, 0xba, 0x01, 0x6b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x33, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x36, 0x34, 0x2d, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x20, 0x68, 0x65, 0x78, 0x20, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x1a, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x28, 0x27, 0x5e, 0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36,
+	0x34, 0x7d, 0x24, 0x27, 0x29, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x3a, 0xae, 0x01, 0x92, 0x41, 0xaa, 0x01, 0x0a, 0x4e,
+	0x2a, 0x17, 0x4f, 0x70, 0x65, 0x6e, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x20, 0x61, 0x20,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0xd2, 0x01, 0x10, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x32, 0x58,
+	0x7b, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x39, 0x64, 0x63, 0x31, 0x65, 0x35, 0x61, 0x38, 0x66, 0x38,
+	0x65, 0x34, 0x64, 0x34, 0x64, 0x30, 0x66, 0x31, 0x65, 0x34, 0x66, 0x33, 0x61, 0x31, 0x64, 0x33,
+	0x63, 0x35, 0x61, 0x38, 0x62, 0x37, 0x65, 0x36, 0x66, 0x35, 0x64, 0x34, 0x63, 0x33, 0x62, 0x32,
+	0x61, 0x31, 0x39, 0x30, 0x38, 0x66, 0x37, 0x65, 0x36, 0x64, 0x35, 0x63, 0x34, 0x62, 0x33, 0x61,
+	0x32, 0x39, 0x31, 0x38, 0x30, 0x37, 0x22, 0x7d, 0x22, 0xb1, 0x02, 0x0a, 0x16, 0x4f, 0x70, 0x65,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x59, 0x92, 0x41, 0x56, 0x32, 0x42, 0x48, 0x65, 0x78, 0x20, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x61, 0x73, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x8a, 0x01, 0x0f,
+	0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x3a, 0xa2, 0x01, 0x92, 0x41, 0x9e, 0x01, 0x0a, 0x4c,
+	0x2a, 0x18, 0x4f, 0x70, 0x65, 0x6e, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0x48, 0x61, 0x73, 0x68,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0xd2, 0x01, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x32, 0x4e, 0x7b, 0x22,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x37, 0x31, 0x63, 0x31, 0x33, 0x34,
+	0x38, 0x66, 0x32, 0x64, 0x37, 0x66, 0x66, 0x37, 0x65, 0x38, 0x31, 0x34, 0x66, 0x39, 0x63, 0x33,
+	0x36, 0x31, 0x37, 0x39, 0x38, 0x33, 0x37, 0x30, 0x33, 0x34, 0x33, 0x35, 0x65, 0x61, 0x37, 0x34,
+	0x34, 0x36, 0x64, 0x65, 0x34, 0x32, 0x30, 0x61, 0x65, 0x61, 0x63, 0x34, 0x38, 0x38, 0x62, 0x66,
+	0x31, 0x64, 0x65, 0x33, 0x35, 0x37, 0x33, 0x37, 0x65, 0x38, 0x22, 0x7d, 0x32, 0x9b, 0x04, 0x0a,
 	0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a,
 	0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x19, 0x2e, 0x64,
 	0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
@@ -467,26 +608,35 @@ var file_debugapi_v1_debugapi_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x28, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x63,
 	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x42, 0xa1, 0x02, 0x92, 0x41,
-	0x71, 0x12, 0x6f, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x20, 0x41, 0x50, 0x49, 0x2a, 0x55,
-	0x0a, 0x1b, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x20, 0x31, 0x2e, 0x31, 0x12, 0x36, 0x68,
-	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x76, 0x2f, 0x6d, 0x65, 0x76, 0x2d, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49,
-	0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x0b, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2d, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x42, 0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6e, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x0e,
+	0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x2d, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x7d, 0x42, 0xa1, 0x02, 0x92, 0x41, 0x71,
+	0x12, 0x6f, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x20, 0x41, 0x50, 0x49, 0x2a, 0x55, 0x0a,
+	0x1b, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x20, 0x31, 0x2e, 0x31, 0x12, 0x36, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x76, 0x2f, 0x6d, 0x65, 0x76, 0x2d, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x2f, 0x70, 0x32, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61,
-	0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x61, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70,
-	0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x74, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43,
+	0x45, 0x4e, 0x53, 0x45, 0x32, 0x0b, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2d, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x42, 0x0d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x69, 0x6d, 0x65, 0x76, 0x2f, 0x6d, 0x65, 0x76, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x2f, 0x70, 0x32, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70,
+	0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61,
+	0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -501,7 +651,7 @@ func file_debugapi_v1_debugapi_proto_rawDescGZIP() []byte {
 	return file_debugapi_v1_debugapi_proto_rawDescData
 }
 
-var file_debugapi_v1_debugapi_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_debugapi_v1_debugapi_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_debugapi_v1_debugapi_proto_goTypes = []interface{}{
 	(*EmptyMessage)(nil),                // 0: debugapi.v1.EmptyMessage
 	(*TopologyResponse)(nil),            // 1: debugapi.v1.TopologyResponse
@@ -509,19 +659,23 @@ var file_debugapi_v1_debugapi_proto_goTypes = []interface{}{
 	(*TransactionInfo)(nil),             // 3: debugapi.v1.TransactionInfo
 	(*CancelTransactionReq)(nil),        // 4: debugapi.v1.CancelTransactionReq
 	(*CancelTransactionResponse)(nil),   // 5: debugapi.v1.CancelTransactionResponse
-	(*structpb.Struct)(nil),             // 6: google.protobuf.Struct
+	(*OpenCommitmentReq)(nil),           // 6: debugapi.v1.OpenCommitmentReq
+	(*OpenCommitmentResponse)(nil),      // 7: debugapi.v1.OpenCommitmentResponse
+	(*structpb.Struct)(nil),             // 8: google.protobuf.Struct
 }
 var file_debugapi_v1_debugapi_proto_depIdxs = []int32{
-	6, // 0: debugapi.v1.TopologyResponse.topology:type_name -> google.protobuf.Struct
+	8, // 0: debugapi.v1.TopologyResponse.topology:type_name -> google.protobuf.Struct
 	3, // 1: debugapi.v1.PendingTransactionsResponse.pending_transactions:type_name -> debugapi.v1.TransactionInfo
 	0, // 2: debugapi.v1.DebugService.GetTopology:input_type -> debugapi.v1.EmptyMessage
 	0, // 3: debugapi.v1.DebugService.GetPendingTransactions:input_type -> debugapi.v1.EmptyMessage
 	4, // 4: debugapi.v1.DebugService.CancelTransaction:input_type -> debugapi.v1.CancelTransactionReq
-	1, // 5: debugapi.v1.DebugService.GetTopology:output_type -> debugapi.v1.TopologyResponse
-	2, // 6: debugapi.v1.DebugService.GetPendingTransactions:output_type -> debugapi.v1.PendingTransactionsResponse
-	5, // 7: debugapi.v1.DebugService.CancelTransaction:output_type -> debugapi.v1.CancelTransactionResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
+	6, // 5: debugapi.v1.DebugService.OpenCommitment:input_type -> debugapi.v1.OpenCommitmentReq
+	1, // 6: debugapi.v1.DebugService.GetTopology:output_type -> debugapi.v1.TopologyResponse
+	2, // 7: debugapi.v1.DebugService.GetPendingTransactions:output_type -> debugapi.v1.PendingTransactionsResponse
+	5, // 8: debugapi.v1.DebugService.CancelTransaction:output_type -> debugapi.v1.CancelTransactionResponse
+	7, // 9: debugapi.v1.DebugService.OpenCommitment:output_type -> debugapi.v1.OpenCommitmentResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -605,6 +759,30 @@ func file_debugapi_v1_debugapi_proto_init() {
 				return nil
 			}
 		}
+		file_debugapi_v1_debugapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenCommitmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debugapi_v1_debugapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenCommitmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -612,7 +790,7 @@ func file_debugapi_v1_debugapi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_debugapi_v1_debugapi_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	DebugService_GetTopology_FullMethodName            = "/debugapi.v1.DebugService/GetTopology"
 	DebugService_GetPendingTransactions_FullMethodName = "/debugapi.v1.DebugService/GetPendingTransactions"
 	DebugService_CancelTransaction_FullMethodName      = "/debugapi.v1.DebugService/CancelTransaction"
+	DebugService_OpenCommitment_FullMethodName         = "/debugapi.v1.DebugService/OpenCommitment"
 )
 
 // DebugServiceClient is the client API for DebugService service.
@@ -41,6 +42,11 @@ type DebugServiceClient interface {
 	//
 	// CancelTransaction is called by the provider to cancel a transaction sent from this wallet.
 	CancelTransaction(ctx context.Context, in *CancelTransactionReq, opts ...grpc.CallOption) (*CancelTransactionResponse, error)
+	// OpenCommitment
+	//
+	// OpenCommitment is called by the operator to re-open a commitment whose open
+	// failed. A new openCommitment transaction is sent regardless of the retry limits.
+	OpenCommitment(ctx context.Context, in *OpenCommitmentReq, opts ...grpc.CallOption) (*OpenCommitmentResponse, error)
 }
 
 type debugServiceClient struct {
@@ -81,6 +87,16 @@ func (c *debugServiceClient) CancelTransaction(ctx context.Context, in *CancelTr
 	return out, nil
 }
 
+func (c *debugServiceClient) OpenCommitment(ctx context.Context, in *OpenCommitmentReq, opts ...grpc.CallOption) (*OpenCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenCommitmentResponse)
+	err := c.cc.Invoke(ctx, DebugService_OpenCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServiceServer is the server API for DebugService service.
 // All implementations must embed UnimplementedDebugServiceServer
 // for forward compatibility.
@@ -98,6 +114,11 @@ type DebugServiceServer interface {
 	//
 	// CancelTransaction is called by the provider to cancel a transaction sent from this wallet.
 	CancelTransaction(context.Context, *CancelTransactionReq) (*CancelTransactionResponse, error)
+	// OpenCommitment
+	//
+	// OpenCommitment is called by the operator to re-open a commitment whose open
+	// failed. A new openCommitment transaction is sent regardless of the retry limits.
+	OpenCommitment(context.Context, *OpenCommitmentReq) (*OpenCommitmentResponse, error)
 	mustEmbedUnimplementedDebugServiceServer()
 }
 
@@ -117,6 +138,9 @@ func (UnimplementedDebugServiceServer) GetPendingTransactions(context.Context, *
 func (UnimplementedDebugServiceServer) CancelTransaction(context.Context, *CancelTransactionReq) (*CancelTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTransaction not implemented")
 }
+func (UnimplementedDebugServiceServer) OpenCommitment(context.Context, *OpenCommitmentReq) (*OpenCommitmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenCommitment not implemented")
+}
 func (UnimplementedDebugServiceServer) mustEmbedUnimplementedDebugServiceServer() {}
 func (UnimplementedDebugServiceServer) testEmbeddedByValue()                      {}
 
@@ -192,6 +216,24 @@ func _DebugService_CancelTransaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DebugService_OpenCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenCommitmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).OpenCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_OpenCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).OpenCommitment(ctx, req.(*OpenCommitmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DebugService_ServiceDesc is the grpc.ServiceDesc for DebugService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -211,6 +253,10 @@ var DebugService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTransaction",
 			Handler:    _DebugService_CancelTransaction_Handler,
 		},
+		{
+			MethodName: "OpenCommitment",
+			Handler:    _DebugService_OpenCommitment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "debugapi/v1/debugapi.proto",
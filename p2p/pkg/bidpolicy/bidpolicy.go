@@ -0,0 +1,336 @@
+package bidpolicy
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	providerapiv1 "github.com/primev/mev-commit/p2p/gen/go/providerapi/v1"
+	providerapi "github.com/primev/mev-commit/p2p/pkg/rpc/provider"
+	"google.golang.org/protobuf/proto"
+)
+
+// Mode determines how the policy engine cooperates with the external
+// decision stream exposed by the provider API.
+type Mode string
+
+const (
+	// ModeExternal disables the policy engine, every bid is sent to the
+	// external decision stream.
+	ModeExternal Mode = "external"
+	// ModeAuto lets the policy engine decide on its own, bids which pass
+	// all the rules are accepted without consulting the external stream.
+	ModeAuto Mode = "auto"
+	// ModeFilter rejects bids which do not pass the rules and forwards the
+	// rest to the external decision stream.
+	ModeFilter Mode = "filter"
+)
+
+// blockNumberCacheTTL is the duration for which the last observed L1 block
+// number is reused before querying the L1 RPC again.
+const blockNumberCacheTTL = time.Second
+
+var (
+	ErrBidderNotAllowed      = errors.New("bidder not in allowlist")
+	ErrBidderDenied          = errors.New("bidder in denylist")
+	ErrSlashAmountTooHigh    = errors.New("slash amount exceeds maximum")
+	ErrBidPerGasTooLow       = errors.New("bid per gas below minimum")
+	ErrBidPerGasUnknown      = errors.New("bid per gas cannot be determined without raw transactions")
+	ErrBlockOutOfWindow      = errors.New("block number outside target window")
+	ErrPositionConstraint    = errors.New("position constraints not accepted")
+	ErrInvalidBidAmount      = errors.New("invalid bid amount")
+	ErrInvalidSlashAmount    = errors.New("invalid slash amount")
+	ErrInvalidRawTransaction = errors.New("invalid raw transaction")
+)
+
+// Policy is the set of declarative rules evaluated for every bid. Zero
+// values disable the corresponding rule.
+type Policy struct {
+	// MinBidPerGas is the minimum bid amount in wei per unit of gas used
+	// by the transactions in the bid.
+	MinBidPerGas *big.Int
+	// AllowedBidders, if non-empty, restricts bids to these bidders.
+	AllowedBidders []common.Address
+	// DeniedBidders are always rejected.
+	DeniedBidders []common.Address
+	// MaxSlashAmount is the maximum slash amount in wei for a single bid.
+	MaxSlashAmount *big.Int
+	// MaxBlocksAhead is the maximum distance between the current L1 block
+	// and the block number targeted by the bid.
+	MaxBlocksAhead uint64
+	// RejectPositionConstraints rejects bids carrying position constraints.
+	RejectPositionConstraints bool
+}
+
+// Enabled returns true if at least one rule is configured.
+func (p *Policy) Enabled() bool {
+	return p.MinBidPerGas != nil ||
+		len(p.AllowedBidders) > 0 ||
+		len(p.DeniedBidders) > 0 ||
+		p.MaxSlashAmount != nil ||
+		p.MaxBlocksAhead > 0 ||
+		p.RejectPositionConstraints
+}
+
+type BidProcessor interface {
+	ProcessBid(context.Context, *preconfpb.Bid, common.Address) (chan providerapi.ProcessedBidResponse, error)
+}
+
+type BlockNumberGetter interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Engine evaluates the configured policy against incoming bids. It
+// implements the BidProcessor interface used by the preconfirmation
+// protocol so it can be used in place of, or in front of, the provider API.
+type Engine struct {
+	policy    *Policy
+	allowed   map[common.Address]struct{}
+	denied    map[common.Address]struct{}
+	next      BidProcessor
+	l1        BlockNumberGetter
+	logger    *slog.Logger
+	metrics   *metrics
+	blkMu     sync.Mutex
+	lastBlk   uint64
+	lastBlkAt time.Time
+}
+
+// New creates a new policy engine. If next is nil, the engine decides on its
+// own and accepts every bid which passes the rules. Otherwise, accepted bids
+// are forwarded to next for the final decision.
+func New(
+	policy *Policy,
+	next BidProcessor,
+	l1 BlockNumberGetter,
+	logger *slog.Logger,
+) (*Engine, error) {
+	if policy.MaxBlocksAhead > 0 && l1 == nil {
+		return nil, errors.New("L1 client required for target block window rule")
+	}
+
+	e := &Engine{
+		policy:  policy,
+		allowed: make(map[common.Address]struct{}, len(policy.AllowedBidders)),
+		denied:  make(map[common.Address]struct{}, len(policy.DeniedBidders)),
+		next:    next,
+		l1:      l1,
+		logger:  logger,
+		metrics: newMetrics(),
+	}
+	for _, addr := range policy.AllowedBidders {
+		e.allowed[addr] = struct{}{}
+	}
+	for _, addr := range policy.DeniedBidders {
+		e.denied[addr] = struct{}{}
+	}
+	return e, nil
+}
+
+// ProcessBid evaluates the policy for the bid and either answers directly
+// or forwards the bid to the next processor.
+func (e *Engine) ProcessBid(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+	bidderAddr common.Address,
+) (chan providerapi.ProcessedBidResponse, error) {
+	start := time.Now()
+	err := e.Evaluate(ctx, bid, bidderAddr)
+	e.metrics.EvaluationDuration.Observe(time.Since(start).Seconds())
+
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return nil, err
+	case err != nil:
+		e.logger.Info(
+			"bid rejected by policy",
+			"bidDigest", hex.EncodeToString(bid.Digest),
+			"bidder", bidderAddr.Hex(),
+			"reason", err,
+		)
+		e.metrics.BidsRejectedCount.WithLabelValues(ruleLabel(err)).Inc()
+		return respond(providerapiv1.BidResponse_STATUS_REJECTED), nil
+	}
+
+	e.metrics.BidsAcceptedCount.Inc()
+	if e.next != nil {
+		return e.next.ProcessBid(ctx, bid, bidderAddr)
+	}
+
+	e.logger.Info(
+		"bid accepted by policy",
+		"bidDigest", hex.EncodeToString(bid.Digest),
+		"bidder", bidderAddr.Hex(),
+	)
+	return respond(providerapiv1.BidResponse_STATUS_ACCEPTED), nil
+}
+
+// Evaluate checks the bid against all configured rules and returns the
+// first violation found, or nil if the bid passes.
+func (e *Engine) Evaluate(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+	bidderAddr common.Address,
+) error {
+	if _, ok := e.denied[bidderAddr]; ok {
+		return ErrBidderDenied
+	}
+	if len(e.allowed) > 0 {
+		if _, ok := e.allowed[bidderAddr]; !ok {
+			return ErrBidderNotAllowed
+		}
+	}
+
+	if e.policy.MaxSlashAmount != nil {
+		slashAmount, ok := new(big.Int).SetString(bid.SlashAmount, 10)
+		if !ok {
+			return ErrInvalidSlashAmount
+		}
+		if slashAmount.Cmp(e.policy.MaxSlashAmount) > 0 {
+			return fmt.Errorf("%w: %s > %s", ErrSlashAmountTooHigh, slashAmount, e.policy.MaxSlashAmount)
+		}
+	}
+
+	if e.policy.MinBidPerGas != nil {
+		if err := e.checkBidPerGas(bid); err != nil {
+			return err
+		}
+	}
+
+	if e.policy.RejectPositionConstraints {
+		hasConstraint, err := hasPositionConstraint(bid.BidOptions)
+		if err != nil {
+			return err
+		}
+		if hasConstraint {
+			return ErrPositionConstraint
+		}
+	}
+
+	if e.policy.MaxBlocksAhead > 0 {
+		current, err := e.currentBlock(ctx)
+		if err != nil {
+			return err
+		}
+		target := uint64(max(bid.BlockNumber, 0))
+		if target <= current || target > current+e.policy.MaxBlocksAhead {
+			return fmt.Errorf(
+				"%w: target %d, current %d, max ahead %d",
+				ErrBlockOutOfWindow,
+				target,
+				current,
+				e.policy.MaxBlocksAhead,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (e *Engine) checkBidPerGas(bid *preconfpb.Bid) error {
+	if len(bid.RawTransactions) == 0 {
+		return ErrBidPerGasUnknown
+	}
+
+	bidAmount, ok := new(big.Int).SetString(bid.BidAmount, 10)
+	if !ok {
+		return ErrInvalidBidAmount
+	}
+
+	var gas uint64
+	for _, rawTx := range bid.RawTransactions {
+		buf, err := hex.DecodeString(strings.TrimPrefix(rawTx, "0x"))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRawTransaction, err)
+		}
+		txn := new(types.Transaction)
+		if err := txn.UnmarshalBinary(buf); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRawTransaction, err)
+		}
+		gas += txn.Gas()
+	}
+	if gas == 0 {
+		return ErrBidPerGasUnknown
+	}
+
+	perGas := new(big.Int).Div(bidAmount, new(big.Int).SetUint64(gas))
+	if perGas.Cmp(e.policy.MinBidPerGas) < 0 {
+		return fmt.Errorf("%w: %s < %s", ErrBidPerGasTooLow, perGas, e.policy.MinBidPerGas)
+	}
+	return nil
+}
+
+func (e *Engine) currentBlock(ctx context.Context) (uint64, error) {
+	e.blkMu.Lock()
+	defer e.blkMu.Unlock()
+
+	if time.Since(e.lastBlkAt) < blockNumberCacheTTL {
+		return e.lastBlk, nil
+	}
+
+	blk, err := e.l1.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting L1 block number: %w", err)
+	}
+	e.lastBlk = blk
+	e.lastBlkAt = time.Now()
+	return blk, nil
+}
+
+func hasPositionConstraint(bidOptions []byte) (bool, error) {
+	if len(bidOptions) == 0 {
+		return false, nil
+	}
+	opts := new(bidderapiv1.BidOptions)
+	if err := proto.Unmarshal(bidOptions, opts); err != nil {
+		return false, fmt.Errorf("unmarshalling bid options: %w", err)
+	}
+	for _, opt := range opts.Options {
+		if opt.GetPositionConstraint() != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func respond(st providerapiv1.BidResponse_Status) chan providerapi.ProcessedBidResponse {
+	respC := make(chan providerapi.ProcessedBidResponse, 1)
+	respC <- providerapi.ProcessedBidResponse{
+		Status:            st,
+		DispatchTimestamp: time.Now().UnixMilli(),
+	}
+	close(respC)
+	return respC
+}
+
+func ruleLabel(err error) string {
+	switch {
+	case errors.Is(err, ErrBidderNotAllowed):
+		return "bidder_not_allowed"
+	case errors.Is(err, ErrBidderDenied):
+		return "bidder_denied"
+	case errors.Is(err, ErrSlashAmountTooHigh), errors.Is(err, ErrInvalidSlashAmount):
+		return "max_slash_amount"
+	case errors.Is(err, ErrBidPerGasTooLow),
+		errors.Is(err, ErrBidPerGasUnknown),
+		errors.Is(err, ErrInvalidBidAmount),
+		errors.Is(err, ErrInvalidRawTransaction):
+		return "min_bid_per_gas"
+	case errors.Is(err, ErrBlockOutOfWindow):
+		return "block_window"
+	case errors.Is(err, ErrPositionConstraint):
+		return "position_constraint"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,259 @@
+package bidpolicy_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	providerapiv1 "github.com/primev/mev-commit/p2p/gen/go/providerapi/v1"
+	"github.com/primev/mev-commit/p2p/pkg/bidpolicy"
+	providerapi "github.com/primev/mev-commit/p2p/pkg/rpc/provider"
+	"github.com/primev/mev-commit/x/util"
+	"google.golang.org/protobuf/proto"
+)
+
+type testBlockNumberGetter uint64
+
+func (t testBlockNumberGetter) BlockNumber(_ context.Context) (uint64, error) {
+	return uint64(t), nil
+}
+
+type testBidProcessor struct {
+	called int
+}
+
+func (t *testBidProcessor) ProcessBid(
+	_ context.Context,
+	_ *preconfpb.Bid,
+	_ common.Address,
+) (chan providerapi.ProcessedBidResponse, error) {
+	t.called++
+	respC := make(chan providerapi.ProcessedBidResponse, 1)
+	respC <- providerapi.ProcessedBidResponse{Status: providerapiv1.BidResponse_STATUS_ACCEPTED}
+	close(respC)
+	return respC, nil
+}
+
+func rawTx(t *testing.T, gas uint64) string {
+	t.Helper()
+
+	buf, err := types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		Gas:      gas,
+		GasPrice: big.NewInt(1),
+		To:       &common.Address{},
+		Value:    big.NewInt(0),
+	}).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+func positionConstraintOpts(t *testing.T) []byte {
+	t.Helper()
+
+	buf, err := proto.Marshal(&bidderapiv1.BidOptions{
+		Options: []*bidderapiv1.BidOption{
+			{
+				Opt: &bidderapiv1.BidOption_PositionConstraint{
+					PositionConstraint: &bidderapiv1.PositionConstraint{
+						Anchor: bidderapiv1.PositionConstraint_ANCHOR_TOP,
+						Basis:  bidderapiv1.PositionConstraint_BASIS_ABSOLUTE,
+						Value:  1,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	bidder := common.HexToAddress("0x1")
+	denied := common.HexToAddress("0x2")
+	stranger := common.HexToAddress("0x3")
+
+	engine, err := bidpolicy.New(
+		&bidpolicy.Policy{
+			MinBidPerGas:              big.NewInt(10),
+			AllowedBidders:            []common.Address{bidder, denied},
+			DeniedBidders:             []common.Address{denied},
+			MaxSlashAmount:            big.NewInt(1000000),
+			MaxBlocksAhead:            2,
+			RejectPositionConstraints: true,
+		},
+		nil,
+		testBlockNumberGetter(100),
+		util.NewTestLogger(io.Discard),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validBid := func() *preconfpb.Bid {
+		return &preconfpb.Bid{
+			BidAmount:       "210000",
+			SlashAmount:     "1000",
+			BlockNumber:     101,
+			RawTransactions: []string{rawTx(t, 21000)},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		bidder common.Address
+		modify func(*preconfpb.Bid)
+		err    error
+	}{
+		{
+			name:   "valid",
+			bidder: bidder,
+			modify: func(*preconfpb.Bid) {},
+		},
+		{
+			name:   "denied bidder",
+			bidder: denied,
+			modify: func(*preconfpb.Bid) {},
+			err:    bidpolicy.ErrBidderDenied,
+		},
+		{
+			name:   "bidder not allowed",
+			bidder: stranger,
+			modify: func(*preconfpb.Bid) {},
+			err:    bidpolicy.ErrBidderNotAllowed,
+		},
+		{
+			name:   "slash amount too high",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) { b.SlashAmount = "1000001" },
+			err:    bidpolicy.ErrSlashAmountTooHigh,
+		},
+		{
+			name:   "bid per gas too low",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) { b.BidAmount = "209999" },
+			err:    bidpolicy.ErrBidPerGasTooLow,
+		},
+		{
+			name:   "tx hashes only",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) {
+				b.RawTransactions = nil
+				b.TxHash = "abcd"
+			},
+			err: bidpolicy.ErrBidPerGasUnknown,
+		},
+		{
+			name:   "past block",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) { b.BlockNumber = 100 },
+			err:    bidpolicy.ErrBlockOutOfWindow,
+		},
+		{
+			name:   "too far ahead",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) { b.BlockNumber = 103 },
+			err:    bidpolicy.ErrBlockOutOfWindow,
+		},
+		{
+			name:   "position constraint",
+			bidder: bidder,
+			modify: func(b *preconfpb.Bid) { b.BidOptions = positionConstraintOpts(t) },
+			err:    bidpolicy.ErrPositionConstraint,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bid := validBid()
+			tc.modify(bid)
+
+			err := engine.Evaluate(context.Background(), bid, tc.bidder)
+			if tc.err == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestProcessBid(t *testing.T) {
+	t.Parallel()
+
+	policy := &bidpolicy.Policy{
+		MaxSlashAmount: big.NewInt(100),
+	}
+
+	t.Run("auto", func(t *testing.T) {
+		engine, err := bidpolicy.New(policy, nil, nil, util.NewTestLogger(io.Discard))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		respC, err := engine.ProcessBid(context.Background(), &preconfpb.Bid{SlashAmount: "100"}, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp := <-respC; resp.Status != providerapiv1.BidResponse_STATUS_ACCEPTED {
+			t.Fatalf("expected accepted, got %v", resp.Status)
+		}
+
+		respC, err = engine.ProcessBid(context.Background(), &preconfpb.Bid{SlashAmount: "101"}, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp := <-respC; resp.Status != providerapiv1.BidResponse_STATUS_REJECTED {
+			t.Fatalf("expected rejected, got %v", resp.Status)
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		next := &testBidProcessor{}
+		engine, err := bidpolicy.New(policy, next, nil, util.NewTestLogger(io.Discard))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		respC, err := engine.ProcessBid(context.Background(), &preconfpb.Bid{SlashAmount: "101"}, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp := <-respC; resp.Status != providerapiv1.BidResponse_STATUS_REJECTED {
+			t.Fatalf("expected rejected, got %v", resp.Status)
+		}
+		if next.called != 0 {
+			t.Fatalf("rejected bid should not be forwarded")
+		}
+
+		respC, err = engine.ProcessBid(context.Background(), &preconfpb.Bid{SlashAmount: "10"}, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp := <-respC; resp.Status != providerapiv1.BidResponse_STATUS_ACCEPTED {
+			t.Fatalf("expected accepted, got %v", resp.Status)
+		}
+		if next.called != 1 {
+			t.Fatalf("accepted bid should be forwarded")
+		}
+	})
+
+	t.Run("missing L1 client", func(t *testing.T) {
+		_, err := bidpolicy.New(&bidpolicy.Policy{MaxBlocksAhead: 1}, nil, nil, util.NewTestLogger(io.Discard))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
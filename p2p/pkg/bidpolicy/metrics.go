@@ -0,0 +1,46 @@
+package bidpolicy
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	defaultNamespace = "mev_commit"
+	subsystem        = "bid_policy"
+)
+
+type metrics struct {
+	BidsAcceptedCount  prometheus.Counter
+	BidsRejectedCount  *prometheus.CounterVec
+	EvaluationDuration prometheus.Summary
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		BidsAcceptedCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "bids_accepted_count",
+			Help:      "Number of bids which passed the policy rules",
+		}),
+		BidsRejectedCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "bids_rejected_count",
+			Help:      "Number of bids rejected by the policy rules",
+		}, []string{"rule"}),
+		EvaluationDuration: prometheus.NewSummary(prometheus.SummaryOpts{
+			Namespace:  defaultNamespace,
+			Subsystem:  subsystem,
+			Name:       "evaluation_duration_seconds",
+			Help:       "Duration taken to evaluate the policy rules for a bid in seconds",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		}),
+	}
+}
+
+func (e *Engine) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		e.metrics.BidsAcceptedCount,
+		e.metrics.BidsRejectedCount,
+		e.metrics.EvaluationDuration,
+	}
+}
@@ -35,6 +35,7 @@ type DepositManager struct {
 	deposits            chan *bidderregistry.BidderregistryBidderDeposited
 	withdrawRequests    chan *bidderregistry.BidderregistryWithdrawalRequested
 	withdrawals         chan *bidderregistry.BidderregistryBidderWithdrawal
+	revertedDeposits    chan *bidderregistry.BidderregistryBidderDeposited
 	thisProviderAddress common.Address
 	logger              *slog.Logger
 }
@@ -54,6 +55,7 @@ func NewDepositManager(
 		deposits:            make(chan *bidderregistry.BidderregistryBidderDeposited),
 		withdrawRequests:    make(chan *bidderregistry.BidderregistryWithdrawalRequested),
 		withdrawals:         make(chan *bidderregistry.BidderregistryBidderWithdrawal),
+		revertedDeposits:    make(chan *bidderregistry.BidderregistryBidderDeposited),
 		evtMgr:              evtMgr,
 		thisProviderAddress: thisProviderAddress,
 		logger:              logger,
@@ -70,7 +72,7 @@ func (dm *DepositManager) Start(ctx context.Context) <-chan struct{} {
 		notifications.TopicOtherProviderWonBlock,
 	)
 
-	ev1 := events.NewEventHandler(
+	ev1 := events.NewRevertibleEventHandler(
 		"BidderDeposited",
 		func(bidderDeposit *bidderregistry.BidderregistryBidderDeposited) {
 			select {
@@ -79,8 +81,19 @@ func (dm *DepositManager) Start(ctx context.Context) <-chan struct{} {
 			case dm.deposits <- bidderDeposit:
 			}
 		},
+		// The deposit removed by a reorg is subtracted from the balance, so
+		// that the deductions of the bids in flight are kept.
+		func(bidderDeposit *bidderregistry.BidderregistryBidderDeposited) {
+			select {
+			case <-egCtx.Done():
+				dm.logger.Info("reverted bidder deposit context done")
+			case dm.revertedDeposits <- bidderDeposit:
+			}
+		},
 	)
 
+	// The withdrawals removed by a reorg need no revert, the balance deleted
+	// by them is read again from the contract on the next bid.
 	ev2 := events.NewEventHandler(
 		"WithdrawalRequested",
 		func(withdrawalRequested *bidderregistry.BidderregistryWithdrawalRequested) {
@@ -216,6 +229,35 @@ func (dm *DepositManager) Start(ctx context.Context) <-chan struct{} {
 					"bidder", withdrawal.Bidder,
 					"provider", withdrawal.Provider,
 				)
+
+			case deposit := <-dm.revertedDeposits:
+				if deposit.Provider != dm.thisProviderAddress {
+					dm.logger.Debug("ignoring reverted deposit event for different provider", "provider", deposit.Provider)
+					continue
+				}
+				currentBalance, err := dm.store.GetBalance(deposit.Bidder)
+				if err != nil {
+					dm.logger.Error("getting balance", "error", err)
+					return err
+				}
+				if currentBalance == nil {
+					// The balance is read from the contract on the next bid.
+					continue
+				}
+				newBalance := new(big.Int).Sub(currentBalance, deposit.DepositedAmount)
+				if newBalance.Sign() < 0 {
+					newBalance = big.NewInt(0)
+				}
+				if err := dm.store.SetBalance(deposit.Bidder, newBalance); err != nil {
+					dm.logger.Error("setting balance", "error", err)
+					return err
+				}
+				dm.logger.Warn("reverted bidder deposit removed by reorg",
+					"bidder", deposit.Bidder,
+					"provider", deposit.Provider,
+					"deposited amount", deposit.DepositedAmount,
+					"new balance", newBalance,
+				)
 			}
 		}
 	})
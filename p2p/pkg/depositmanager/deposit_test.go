@@ -213,6 +213,93 @@ func TestDepositManager(t *testing.T) {
 	<-done
 }
 
+func TestRevertedDepositKeepsDeductions(t *testing.T) {
+	t.Parallel()
+
+	brABI, err := abi.JSON(strings.NewReader(bidderregistry.BidderregistryABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := util.NewTestLogger(io.Discard)
+	evtMgr := events.NewListener(logger, &brABI)
+
+	st := depositstore.New(inmemstorage.New())
+	bidderRegistry := &MockBidderRegistryContract{
+		GetDepositConsideringWithdrawalRequestFunc: func(
+			opts *bind.CallOpts,
+			bidder common.Address,
+			provider common.Address,
+		) (*big.Int, error) {
+			return big.NewInt(0), nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bidder := common.HexToAddress("0x123")
+	providerAddress := common.HexToAddress("0x456")
+
+	dm := depositmanager.NewDepositManager(st, evtMgr, notifications.New(10), bidderRegistry, providerAddress, logger)
+	done := dm.Start(ctx)
+
+	br := &bidderregistry.BidderregistryBidderDeposited{
+		Bidder:             bidder,
+		Provider:           providerAddress,
+		DepositedAmount:    big.NewInt(100),
+		NewAvailableAmount: big.NewInt(100),
+	}
+	if err := publishBidderDeposited(evtMgr, &brABI, br); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if val, err := st.GetBalance(bidder); err == nil && val != nil && val.Cmp(big.NewInt(100)) == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if _, err := dm.CheckAndDeductDeposit(context.Background(), bidder, "10"); err != nil {
+		t.Fatal(err)
+	}
+
+	br2 := &bidderregistry.BidderregistryBidderDeposited{
+		Bidder:             bidder,
+		Provider:           providerAddress,
+		DepositedAmount:    big.NewInt(50),
+		NewAvailableAmount: big.NewInt(150),
+	}
+	if err := publishBidderDeposited(evtMgr, &brABI, br2); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if val, err := st.GetBalance(bidder); err == nil && val != nil && val.Cmp(big.NewInt(140)) == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// only the reorged deposit is subtracted, the deducted bid is kept
+	br2.Raw.Removed = true
+	if err := publishBidderDeposited(evtMgr, &brABI, br2); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if val, err := st.GetBalance(bidder); err == nil && val != nil && val.Cmp(big.NewInt(90)) == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
 func TestStartWithBidderAlreadyDeposited(t *testing.T) {
 	t.Parallel()
 
@@ -425,6 +512,7 @@ func publishBidderDeposited(
 		},
 		Data:        buf,
 		BlockNumber: br.Raw.BlockNumber,
+		Removed:     br.Raw.Removed,
 	}
 	evtMgr.PublishLogEvent(context.Background(), testLog)
 
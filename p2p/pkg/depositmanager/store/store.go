@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -83,6 +84,27 @@ func (s *Store) RefundBalanceIfExists(
 	return s.st.Put(balanceKey(bidder), newAmount.Bytes())
 }
 
+// Balances returns the deposit balances of all the bidders.
+func (s *Store) Balances() (map[common.Address]*big.Int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	balances := make(map[common.Address]*big.Int)
+	err := s.st.WalkPrefix(balanceNS, func(key string, val []byte) bool {
+		bidder := strings.TrimPrefix(key, balanceNS)
+		if !common.IsHexAddress(bidder) {
+			return false
+		}
+		balances[common.HexToAddress(bidder)] = new(big.Int).SetBytes(val)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
 func (s *Store) BalanceEntries(bidder common.Address) (int, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
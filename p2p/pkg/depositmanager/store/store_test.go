@@ -136,3 +136,32 @@ func TestStore_DeleteBalance(t *testing.T) {
 		t.Fatalf("expected nil, got %s", val.String())
 	}
 }
+
+func TestStore_Balances(t *testing.T) {
+	st := inmem.New()
+	s := store.New(st)
+
+	bidder1 := common.HexToAddress("0x123")
+	bidder2 := common.HexToAddress("0x456")
+
+	if err := s.SetBalance(bidder1, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetBalance(bidder2, big.NewInt(20)); err != nil {
+		t.Fatal(err)
+	}
+
+	balances, err := s.Balances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != 2 {
+		t.Fatalf("expected 2 balances, got %d", len(balances))
+	}
+	if balances[bidder1].Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("expected 10, got %s", balances[bidder1])
+	}
+	if balances[bidder2].Cmp(big.NewInt(20)) != 0 {
+		t.Fatalf("expected 20, got %s", balances[bidder2])
+	}
+}
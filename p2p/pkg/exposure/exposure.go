@@ -0,0 +1,323 @@
+package exposure
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	"github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retainBlocks is the number of blocks below the highest block targeted by a
+// bid for which the exposure is kept.
+const retainBlocks = 128
+
+// stakeCacheTTL is how long the provider stake is reused before it is read
+// again, so that the stake is not fetched for every bid.
+const stakeCacheTTL = 12 * time.Second
+
+var (
+	ErrSlashLimitExceeded  = errors.New("slash exposure for block exceeds maximum")
+	ErrBidderLimitExceeded = errors.New("commitments of bidder for block exceed maximum")
+	ErrStakeLimitExceeded  = errors.New("slash exposure for block exceeds allowed share of stake")
+	ErrInvalidSlashAmount  = errors.New("invalid slash amount")
+	ErrStakeUnavailable    = errors.New("provider stake unavailable")
+)
+
+// Limits caps the commitments a provider can take for a single block. Zero
+// values disable the corresponding limit.
+type Limits struct {
+	// MaxSlashPerBlock is the maximum aggregate slash amount in wei of the
+	// commitments for a block. Nil or zero disables the limit.
+	MaxSlashPerBlock *big.Int
+	// MaxCommitmentsPerBidder is the maximum number of commitments a single
+	// bidder can get for a block.
+	MaxCommitmentsPerBidder uint64
+	// MaxStakePercent is the maximum aggregate slash amount of the
+	// commitments for a block as a percentage of the provider stake.
+	MaxStakePercent uint64
+}
+
+// Enabled returns true if at least one limit is configured.
+func (l *Limits) Enabled() bool {
+	return l.maxSlashEnabled() ||
+		l.MaxCommitmentsPerBidder > 0 ||
+		l.MaxStakePercent > 0
+}
+
+func (l *Limits) maxSlashEnabled() bool {
+	return l.MaxSlashPerBlock != nil && l.MaxSlashPerBlock.Sign() > 0
+}
+
+type StakeGetter interface {
+	GetStake(ctx context.Context, provider common.Address) (*big.Int, error)
+}
+
+// CommitmentWalker walks the commitments kept by the node.
+type CommitmentWalker interface {
+	WalkCommitments(fn func(*store.Commitment) bool) error
+}
+
+type blockExposure struct {
+	slash       *big.Int
+	commitments map[common.Address]uint64
+}
+
+// Manager keeps track of the slash exposure of the provider per block. The
+// exposure of a bid is reserved before the bid is decided on and released
+// if the bid does not end up as a commitment, the same way the bid amount is
+// deducted from and refunded to the bidder deposit.
+type Manager struct {
+	limits   *Limits
+	provider common.Address
+	stakes   StakeGetter
+	logger   *slog.Logger
+	metrics  *metrics
+
+	mu           sync.Mutex
+	blocks       map[int64]*blockExposure
+	highestBlock int64
+
+	stakeMu     sync.Mutex
+	stake       *big.Int
+	stakeExpiry time.Time
+}
+
+func NewManager(
+	limits *Limits,
+	provider common.Address,
+	stakes StakeGetter,
+	logger *slog.Logger,
+) *Manager {
+	return &Manager{
+		limits:   limits,
+		provider: provider,
+		stakes:   stakes,
+		logger:   logger,
+		metrics:  newMetrics(),
+		blocks:   make(map[int64]*blockExposure),
+	}
+}
+
+// ReserveExposure adds the slash amount of the bid to the exposure of the
+// targeted block if no limit is breached. The returned function releases the
+// reservation, it is safe to call it more than once.
+func (m *Manager) ReserveExposure(
+	ctx context.Context,
+	bid *preconfpb.Bid,
+	bidderAddr common.Address,
+) (func(), error) {
+	slashAmount, ok := new(big.Int).SetString(bid.SlashAmount, 10)
+	if !ok || slashAmount.Sign() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %q", ErrInvalidSlashAmount, bid.SlashAmount)
+	}
+
+	var stake *big.Int
+	if m.limits.MaxStakePercent > 0 {
+		s, err := m.getStake(ctx)
+		if err != nil {
+			m.logger.Error("getting provider stake", "error", err)
+			return nil, status.Errorf(codes.Internal, "%v: %v", ErrStakeUnavailable, err)
+		}
+		stake = s
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune(bid.BlockNumber)
+
+	exp := m.block(bid.BlockNumber)
+	newSlash := new(big.Int).Add(exp.slash, slashAmount)
+
+	if err := m.check(exp, newSlash, stake, bidderAddr); err != nil {
+		m.logger.Info(
+			"bid rejected by exposure limits",
+			"bidDigest", hex.EncodeToString(bid.Digest),
+			"bidder", bidderAddr.Hex(),
+			"blockNumber", bid.BlockNumber,
+			"reason", err,
+		)
+		m.metrics.BidsRejectedCount.WithLabelValues(limitLabel(err)).Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "bid rejected: %v", err)
+	}
+
+	exp.slash = newSlash
+	exp.commitments[bidderAddr]++
+	m.metrics.BidsReservedCount.Inc()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.release(bid.BlockNumber, bidderAddr, slashAmount)
+		})
+	}, nil
+}
+
+// Restore rebuilds the reservations of the commitments the provider made
+// before a restart, so that the limits hold for the blocks still being bid
+// on. Failed commitments do not count towards the limits.
+func (m *Manager) Restore(commitments CommitmentWalker) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	restored := 0
+	err := commitments.WalkCommitments(func(c *store.Commitment) bool {
+		if c.PreConfirmation == nil || c.Bid == nil || c.BidderAddress == nil ||
+			c.Status == store.CommitmentStatusFailed {
+			return false
+		}
+		slashAmount, ok := new(big.Int).SetString(c.Bid.SlashAmount, 10)
+		if !ok || slashAmount.Sign() < 0 {
+			return false
+		}
+
+		m.prune(c.Bid.BlockNumber)
+		if c.Bid.BlockNumber < m.highestBlock-retainBlocks {
+			return false
+		}
+
+		exp := m.block(c.Bid.BlockNumber)
+		exp.slash.Add(exp.slash, slashAmount)
+		exp.commitments[*c.BidderAddress]++
+		restored++
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk commitments: %w", err)
+	}
+
+	m.logger.Info("restored exposure reservations", "commitments", restored, "blocks", len(m.blocks))
+	return nil
+}
+
+// block returns the exposure of the block, adding it if not tracked yet. It
+// must be called with the lock held.
+func (m *Manager) block(blockNumber int64) *blockExposure {
+	exp, found := m.blocks[blockNumber]
+	if !found {
+		exp = &blockExposure{
+			slash:       new(big.Int),
+			commitments: make(map[common.Address]uint64),
+		}
+		m.blocks[blockNumber] = exp
+	}
+	return exp
+}
+
+// getStake returns the stake of the provider, read again once the cached
+// value is older than stakeCacheTTL.
+func (m *Manager) getStake(ctx context.Context) (*big.Int, error) {
+	m.stakeMu.Lock()
+	defer m.stakeMu.Unlock()
+
+	if m.stake != nil && time.Now().Before(m.stakeExpiry) {
+		return m.stake, nil
+	}
+
+	stake, err := m.stakes.GetStake(ctx, m.provider)
+	if err != nil {
+		return nil, err
+	}
+	m.stake = stake
+	m.stakeExpiry = time.Now().Add(stakeCacheTTL)
+	return stake, nil
+}
+
+func (m *Manager) check(exp *blockExposure, newSlash, stake *big.Int, bidderAddr common.Address) error {
+	if m.limits.maxSlashEnabled() && newSlash.Cmp(m.limits.MaxSlashPerBlock) > 0 {
+		return fmt.Errorf("%w: %s > %s", ErrSlashLimitExceeded, newSlash, m.limits.MaxSlashPerBlock)
+	}
+	if m.limits.MaxCommitmentsPerBidder > 0 && exp.commitments[bidderAddr] >= m.limits.MaxCommitmentsPerBidder {
+		return fmt.Errorf(
+			"%w: %d >= %d",
+			ErrBidderLimitExceeded,
+			exp.commitments[bidderAddr],
+			m.limits.MaxCommitmentsPerBidder,
+		)
+	}
+	if m.limits.MaxStakePercent > 0 {
+		// newSlash * 100 > stake * percent, to avoid rounding.
+		lhs := new(big.Int).Mul(newSlash, big.NewInt(100))
+		rhs := new(big.Int).Mul(stake, new(big.Int).SetUint64(m.limits.MaxStakePercent))
+		if lhs.Cmp(rhs) > 0 {
+			return fmt.Errorf(
+				"%w: %s > %d%% of %s",
+				ErrStakeLimitExceeded,
+				newSlash,
+				m.limits.MaxStakePercent,
+				stake,
+			)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) release(blockNumber int64, bidderAddr common.Address, slashAmount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exp, found := m.blocks[blockNumber]
+	if !found {
+		// Already pruned.
+		return
+	}
+	exp.slash.Sub(exp.slash, slashAmount)
+	exp.commitments[bidderAddr]--
+	if exp.commitments[bidderAddr] == 0 {
+		delete(exp.commitments, bidderAddr)
+	}
+	if len(exp.commitments) == 0 {
+		delete(m.blocks, blockNumber)
+	}
+	m.metrics.ReservationsReleasedCount.Inc()
+}
+
+// prune drops the exposure of the blocks which are too old to be targeted by
+// new bids. It must be called with the lock held.
+func (m *Manager) prune(blockNumber int64) {
+	if blockNumber <= m.highestBlock {
+		return
+	}
+	m.highestBlock = blockNumber
+	for blk := range m.blocks {
+		if blk < m.highestBlock-retainBlocks {
+			delete(m.blocks, blk)
+		}
+	}
+}
+
+// Exposure returns the reserved slash amount and the number of commitments of
+// the bidder for the block.
+func (m *Manager) Exposure(blockNumber int64, bidderAddr common.Address) (*big.Int, uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exp, found := m.blocks[blockNumber]
+	if !found {
+		return new(big.Int), 0
+	}
+	return new(big.Int).Set(exp.slash), exp.commitments[bidderAddr]
+}
+
+func limitLabel(err error) string {
+	switch {
+	case errors.Is(err, ErrSlashLimitExceeded):
+		return "max_slash_per_block"
+	case errors.Is(err, ErrBidderLimitExceeded):
+		return "max_commitments_per_bidder"
+	case errors.Is(err, ErrStakeLimitExceeded):
+		return "max_stake_percent"
+	default:
+		return "other"
+	}
+}
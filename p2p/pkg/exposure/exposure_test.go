@@ -0,0 +1,223 @@
+package exposure_test
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	"github.com/primev/mev-commit/p2p/pkg/exposure"
+	"github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/x/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testStakeGetter struct {
+	stake *big.Int
+	calls int
+}
+
+func (t *testStakeGetter) GetStake(_ context.Context, _ common.Address) (*big.Int, error) {
+	t.calls++
+	return t.stake, nil
+}
+
+type testCommitments []*store.Commitment
+
+func (t testCommitments) WalkCommitments(fn func(*store.Commitment) bool) error {
+	for _, c := range t {
+		if fn(c) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func bid(blockNumber int64, slashAmount string) *preconfpb.Bid {
+	return &preconfpb.Bid{
+		BlockNumber: blockNumber,
+		SlashAmount: slashAmount,
+	}
+}
+
+func assertRejected(t *testing.T, err error, reason error) {
+	t.Helper()
+
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected resource exhausted error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), reason.Error()) {
+		t.Fatalf("expected %q in error, got %v", reason, err)
+	}
+}
+
+func TestReserveExposure(t *testing.T) {
+	t.Parallel()
+
+	bidder1 := common.HexToAddress("0x1")
+	bidder2 := common.HexToAddress("0x2")
+
+	stakes := &testStakeGetter{stake: big.NewInt(1600)}
+	mgr := exposure.NewManager(
+		&exposure.Limits{
+			MaxSlashPerBlock:        big.NewInt(1000),
+			MaxCommitmentsPerBidder: 2,
+			MaxStakePercent:         50,
+		},
+		common.HexToAddress("0x3"),
+		stakes,
+		util.NewTestLogger(io.Discard),
+	)
+	ctx := context.Background()
+
+	release1, err := mgr.ReserveExposure(ctx, bid(10, "300"), bidder1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgr.ReserveExposure(ctx, bid(10, "300"), bidder1); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = mgr.ReserveExposure(ctx, bid(10, "100"), bidder1)
+	assertRejected(t, err, exposure.ErrBidderLimitExceeded)
+
+	// 600 + 300 is above 50% of the stake.
+	_, err = mgr.ReserveExposure(ctx, bid(10, "300"), bidder2)
+	assertRejected(t, err, exposure.ErrStakeLimitExceeded)
+
+	if _, err := mgr.ReserveExposure(ctx, bid(10, "200"), bidder2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Other blocks have their own exposure.
+	_, err = mgr.ReserveExposure(ctx, bid(11, "1001"), bidder2)
+	assertRejected(t, err, exposure.ErrSlashLimitExceeded)
+
+	slash, count := mgr.Exposure(10, bidder1)
+	if slash.Cmp(big.NewInt(800)) != 0 || count != 2 {
+		t.Fatalf("unexpected exposure %s, %d", slash, count)
+	}
+
+	// A released reservation frees the exposure exactly once.
+	release1()
+	release1()
+	slash, count = mgr.Exposure(10, bidder1)
+	if slash.Cmp(big.NewInt(500)) != 0 || count != 1 {
+		t.Fatalf("unexpected exposure after release %s, %d", slash, count)
+	}
+	if _, err := mgr.ReserveExposure(ctx, bid(10, "300"), bidder1); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = mgr.ReserveExposure(ctx, bid(10, "invalid"), bidder1)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument error, got %v", err)
+	}
+
+	// The stake is cached.
+	if stakes.calls != 1 {
+		t.Fatalf("expected the stake to be read once, got %d", stakes.calls)
+	}
+}
+
+func TestZeroMaxSlashPerBlock(t *testing.T) {
+	t.Parallel()
+
+	limits := &exposure.Limits{MaxSlashPerBlock: big.NewInt(0)}
+	if limits.Enabled() {
+		t.Fatal("expected zero max slash per block to disable the limit")
+	}
+
+	limits.MaxCommitmentsPerBidder = 10
+	mgr := exposure.NewManager(
+		limits,
+		common.HexToAddress("0x3"),
+		nil,
+		util.NewTestLogger(io.Discard),
+	)
+	if _, err := mgr.ReserveExposure(context.Background(), bid(10, "1000"), common.HexToAddress("0x1")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	bidder1 := common.HexToAddress("0x1")
+	bidder2 := common.HexToAddress("0x2")
+	commitment := func(
+		blockNumber int64,
+		slashAmount string,
+		bidder common.Address,
+		status store.CommitmentStatus,
+	) *store.Commitment {
+		return &store.Commitment{
+			PreConfirmation: &preconfpb.PreConfirmation{Bid: bid(blockNumber, slashAmount)},
+			BidderAddress:   &bidder,
+			Status:          status,
+		}
+	}
+
+	mgr := exposure.NewManager(
+		&exposure.Limits{
+			MaxSlashPerBlock:        big.NewInt(1000),
+			MaxCommitmentsPerBidder: 2,
+		},
+		common.HexToAddress("0x3"),
+		nil,
+		util.NewTestLogger(io.Discard),
+	)
+	err := mgr.Restore(testCommitments{
+		commitment(1000, "300", bidder1, store.CommitmentStatusStored),
+		commitment(1000, "300", bidder1, store.CommitmentStatusPending),
+		commitment(1000, "300", bidder2, store.CommitmentStatusFailed),
+		commitment(10, "300", bidder2, store.CommitmentStatusStored),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slash, count := mgr.Exposure(1000, bidder1)
+	if slash.Cmp(big.NewInt(600)) != 0 || count != 2 {
+		t.Fatalf("unexpected restored exposure %s, %d", slash, count)
+	}
+	if _, count := mgr.Exposure(1000, bidder2); count != 0 {
+		t.Fatalf("expected failed commitment not to be restored, got %d commitments", count)
+	}
+	if _, count := mgr.Exposure(10, bidder2); count != 0 {
+		t.Fatalf("expected commitment of old block not to be restored, got %d commitments", count)
+	}
+
+	ctx := context.Background()
+	_, err = mgr.ReserveExposure(ctx, bid(1000, "100"), bidder1)
+	assertRejected(t, err, exposure.ErrBidderLimitExceeded)
+	_, err = mgr.ReserveExposure(ctx, bid(1000, "500"), bidder2)
+	assertRejected(t, err, exposure.ErrSlashLimitExceeded)
+}
+
+func TestReserveExposurePruning(t *testing.T) {
+	t.Parallel()
+
+	bidder := common.HexToAddress("0x1")
+	mgr := exposure.NewManager(
+		&exposure.Limits{MaxCommitmentsPerBidder: 1},
+		common.HexToAddress("0x3"),
+		nil,
+		util.NewTestLogger(io.Discard),
+	)
+	ctx := context.Background()
+
+	if _, err := mgr.ReserveExposure(ctx, bid(10, "1"), bidder); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgr.ReserveExposure(ctx, bid(1000, "1"), bidder); err != nil {
+		t.Fatal(err)
+	}
+	if _, count := mgr.Exposure(10, bidder); count != 0 {
+		t.Fatalf("expected exposure of old block to be pruned, got %d commitments", count)
+	}
+}
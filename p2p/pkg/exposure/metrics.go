@@ -0,0 +1,45 @@
+package exposure
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	defaultNamespace = "mev_commit"
+	subsystem        = "exposure"
+)
+
+type metrics struct {
+	BidsReservedCount         prometheus.Counter
+	BidsRejectedCount         *prometheus.CounterVec
+	ReservationsReleasedCount prometheus.Counter
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		BidsReservedCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "bids_reserved_count",
+			Help:      "Number of bids whose slash exposure was reserved",
+		}),
+		BidsRejectedCount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "bids_rejected_count",
+			Help:      "Number of bids rejected by the exposure limits",
+		}, []string{"limit"}),
+		ReservationsReleasedCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: defaultNamespace,
+			Subsystem: subsystem,
+			Name:      "reservations_released_count",
+			Help:      "Number of reservations released because the bid did not become a commitment",
+		}),
+	}
+}
+
+func (m *Manager) Metrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.metrics.BidsReservedCount,
+		m.metrics.BidsRejectedCount,
+		m.metrics.ReservationsReleasedCount,
+	}
+}
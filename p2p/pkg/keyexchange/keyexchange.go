@@ -157,9 +157,7 @@ func (ke *KeyExchange) createSignedMessage(encryptedKeys [][]byte, timestampMess
 		return nil, fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	hashedMessage := hashData(messageBytes)
-
-	signature, err := ke.keySigner.SignHash(hashedMessage.Bytes())
+	signature, err := keysigner.SignKeccak256(ke.keySigner, messageBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign message: %w", err)
 	}
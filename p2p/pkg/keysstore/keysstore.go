@@ -1,6 +1,7 @@
 package keysstore
 
 import (
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -21,6 +22,7 @@ const (
 	eciesPrivateKeyNS = "ecies/"
 	bn254PrivateKeyNS = "bn254-sk/"
 	bn254PublicKeyNS  = "bn254-pk/"
+	identityKeyNS     = "libp2p-identity/"
 )
 
 var (
@@ -140,3 +142,25 @@ func (s *Store) BN254PublicKey() (*bn254.G1Affine, error) {
 
 	return p2pcrypto.BN254PublicKeyFromBytes(raw)
 }
+
+func (s *Store) SetIdentityPrivateKey(key *ecdsa.PrivateKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.st.Put(identityKeyNS, crypto.FromECDSA(key))
+}
+
+func (s *Store) IdentityPrivateKey() (*ecdsa.PrivateKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	raw, err := s.st.Get(identityKeyNS)
+	switch {
+	case errors.Is(err, storage.ErrKeyNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return crypto.ToECDSA(raw)
+}
@@ -99,3 +99,25 @@ func TestNikePublicKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, pk.Bytes(), retrievedKey.Bytes())
 }
+
+func TestIdentityPrivateKey(t *testing.T) {
+	st := inmem.New()
+	store := keysstore.New(st)
+
+	// Get non-existent identity private key
+	retrievedKey, err := store.IdentityPrivateKey()
+	assert.NoError(t, err)
+	assert.Nil(t, retrievedKey)
+
+	// Generate identity private key
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	// Set and get identity private key
+	err = store.SetIdentityPrivateKey(privateKey)
+	assert.NoError(t, err)
+
+	retrievedKey, err = store.IdentityPrivateKey()
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey.D, retrievedKey.D)
+}
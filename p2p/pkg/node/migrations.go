@@ -0,0 +1,67 @@
+package node
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/cockroachdb/pebble"
+	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+	"github.com/primev/mev-commit/p2p/pkg/storage/migration"
+	pebblestorage "github.com/primev/mev-commit/p2p/pkg/storage/pebble"
+)
+
+// storageMigrations lists the changes of the storage layout. New migrations
+// are appended with the next version, released migrations must not change.
+var storageMigrations = []migration.Migration{
+	{
+		Version: 1,
+		Name:    "build commitment query indexes",
+		Migrate: func(st storage.Storage) error {
+			return preconfstore.New(st).BuildQueryIndexes()
+		},
+	},
+}
+
+func migrateStorage(st storage.Storage, logger *slog.Logger) error {
+	m, err := migration.New(st, storageMigrations, logger)
+	if err != nil {
+		return err
+	}
+	return m.Run()
+}
+
+// PendingMigrations opens the data directory read-only and returns its
+// storage schema version along with the migrations the node would run on
+// startup.
+func PendingMigrations(dataDir string, logger *slog.Logger) (uint64, []migration.Migration, error) {
+	// A node starting without a database creates it and runs all the
+	// migrations.
+	if _, err := os.Stat(dataDir); errors.Is(err, os.ErrNotExist) {
+		return 0, storageMigrations, nil
+	}
+	st, err := pebblestorage.NewReadOnly(dataDir)
+	if errors.Is(err, pebble.ErrDBDoesNotExist) {
+		return 0, storageMigrations, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	//nolint:errcheck
+	defer st.Close()
+
+	m, err := migration.New(st, storageMigrations, logger)
+	if err != nil {
+		return 0, nil, err
+	}
+	version, err := m.Version()
+	if err != nil {
+		return 0, nil, err
+	}
+	pending, err := m.Pending()
+	if err != nil {
+		return 0, nil, err
+	}
+	return version, pending, nil
+}
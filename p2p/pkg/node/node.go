@@ -30,16 +30,19 @@ import (
 	validatorrouter "github.com/primev/mev-commit/contracts-abi/clients/ValidatorOptInRouter"
 	contracts "github.com/primev/mev-commit/contracts-abi/config"
 	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
+	commitmentapiv1 "github.com/primev/mev-commit/p2p/gen/go/commitmentapi/v1"
 	debugapiv1 "github.com/primev/mev-commit/p2p/gen/go/debugapi/v1"
 	notificationsapiv1 "github.com/primev/mev-commit/p2p/gen/go/notificationsapi/v1"
 	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
 	providerapiv1 "github.com/primev/mev-commit/p2p/gen/go/providerapi/v1"
 	validatorapiv1 "github.com/primev/mev-commit/p2p/gen/go/validatorapi/v1"
 	"github.com/primev/mev-commit/p2p/pkg/apiserver"
+	"github.com/primev/mev-commit/p2p/pkg/bidpolicy"
 	"github.com/primev/mev-commit/p2p/pkg/crypto"
 	"github.com/primev/mev-commit/p2p/pkg/depositmanager"
 	depositmanagerstore "github.com/primev/mev-commit/p2p/pkg/depositmanager/store"
 	"github.com/primev/mev-commit/p2p/pkg/discovery"
+	"github.com/primev/mev-commit/p2p/pkg/exposure"
 	"github.com/primev/mev-commit/p2p/pkg/keyexchange"
 	"github.com/primev/mev-commit/p2p/pkg/keysstore"
 	"github.com/primev/mev-commit/p2p/pkg/notifications"
@@ -49,7 +52,9 @@ import (
 	preconfencryptor "github.com/primev/mev-commit/p2p/pkg/preconfirmation/encryptor"
 	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
 	preconftracker "github.com/primev/mev-commit/p2p/pkg/preconfirmation/tracker"
+	"github.com/primev/mev-commit/p2p/pkg/reputation"
 	bidderapi "github.com/primev/mev-commit/p2p/pkg/rpc/bidder"
+	commitmentapi "github.com/primev/mev-commit/p2p/pkg/rpc/commitments"
 	debugapi "github.com/primev/mev-commit/p2p/pkg/rpc/debug"
 	notificationsapi "github.com/primev/mev-commit/p2p/pkg/rpc/notifications"
 	providerapi "github.com/primev/mev-commit/p2p/pkg/rpc/provider"
@@ -117,6 +122,7 @@ type Options struct {
 	TargetDepositAmount      *big.Int
 	RPCEndpoint              string
 	WSRPCEndpoint            string
+	SettlementConfirmations  uint64
 	NatAddr                  string
 	TLSCertificateFile       string
 	TLSPrivateKeyFile        string
@@ -124,16 +130,25 @@ type Options struct {
 	DefaultGasLimit          uint64
 	DefaultGasTipCap         *big.Int
 	DefaultGasFeeCap         *big.Int
+	FeeBumpInterval          time.Duration
+	MaxGasTipCap             *big.Int
+	MaxGasFeeCap             *big.Int
 	BeaconAPIURL             string
 	L1RPCURL                 string
 	LaggardMode              *big.Int
 	BidderBidTimeout         time.Duration
 	ProviderDecisionTimeout  time.Duration
 	NotificationsBufferCap   int
+	NotificationsRetention   time.Duration
 	ProposerNotifyOffset     time.Duration
 	SlotDuration             time.Duration
 	SlotsPerEpoch            uint64
 	ShutterSequencerEndpoint string
+	ProviderDecisionMode     bidpolicy.Mode
+	ProviderBidPolicy        *bidpolicy.Policy
+	ProviderExposureLimits   *exposure.Limits
+	CommitmentOpenAttempts   int
+	CommitmentOpenWindow     int64
 }
 
 type Node struct {
@@ -196,15 +211,6 @@ func NewNode(opts *Options) (*Node, error) {
 		"L1RPCURL", opts.L1RPCURL,
 	)
 
-	notificationsSvc := notifications.New(opts.NotificationsBufferCap)
-	nd.closers = append(
-		nd.closers,
-		ioCloserFunc(func() error {
-			notificationsSvc.Shutdown()
-			return nil
-		}),
-	)
-
 	var store storage.Storage
 	if opts.DataDir != "" {
 		store, err = pebblestorage.New(opts.DataDir)
@@ -217,6 +223,29 @@ func NewNode(opts *Options) (*Node, error) {
 	}
 	nd.closers = append(nd.closers, store)
 
+	if err := migrateStorage(store, opts.Logger.With("component", "migration")); err != nil {
+		opts.Logger.Error("failed to migrate storage", "error", err)
+		return nil, errors.Join(err, nd.Close())
+	}
+
+	notificationsSvc, err := notifications.NewWithStore(
+		opts.NotificationsBufferCap,
+		store,
+		opts.NotificationsRetention,
+		opts.Logger.With("component", "notifications"),
+	)
+	if err != nil {
+		opts.Logger.Error("failed to create notifications", "error", err)
+		return nil, errors.Join(err, nd.Close())
+	}
+	nd.closers = append(
+		nd.closers,
+		ioCloserFunc(func() error {
+			notificationsSvc.Shutdown()
+			return nil
+		}),
+	)
+
 	progressstore := NewDurableProgressStore(store, contractRPC)
 
 	contracts, err := getContractABIs(opts)
@@ -249,6 +278,7 @@ func NewNode(opts *Options) (*Node, error) {
 			opts.Logger.With("component", "ws_publisher"),
 			contractRPC,
 			evtMgr,
+			publisher.WithConfirmations(opts.SettlementConfirmations),
 		)
 	} else {
 		evtPublisher = publisher.NewHTTPPublisher(
@@ -256,6 +286,7 @@ func NewNode(opts *Options) (*Node, error) {
 			opts.Logger.With("component", "http_publisher"),
 			contractRPC,
 			evtMgr,
+			publisher.WithConfirmations(opts.SettlementConfirmations),
 		)
 	}
 
@@ -271,6 +302,21 @@ func NewNode(opts *Options) (*Node, error) {
 
 	txnStore := txnstore.New(store)
 
+	var monitorOpts []txmonitor.Option
+	if opts.FeeBumpInterval > 0 {
+		monitorOpts = append(monitorOpts, txmonitor.WithFeeBumping(
+			txmonitor.FeeBumpPolicy{
+				Interval:     opts.FeeBumpInterval,
+				Percentage:   txmonitor.DefaultFeeBumpPercentage,
+				MaxGasTipCap: opts.MaxGasTipCap,
+				MaxGasFeeCap: opts.MaxGasFeeCap,
+			},
+			chainID,
+			opts.KeySigner,
+			contractRPC,
+		))
+	}
+
 	monitor := txmonitor.New(
 		opts.KeySigner.GetAddress(),
 		contractRPC,
@@ -278,6 +324,7 @@ func NewNode(opts *Options) (*Node, error) {
 		txnStore,
 		opts.Logger.With("component", "txmonitor"),
 		2048,
+		monitorOpts...,
 	)
 	startables = append(
 		startables,
@@ -475,6 +522,32 @@ func NewNode(opts *Options) (*Node, error) {
 		}
 
 		preconfStore := preconfstore.New(store)
+		commitmentsRPCService := commitmentapi.NewService(
+			preconfStore,
+			opts.Logger.With("component", "commitmentapi"),
+		)
+		commitmentapiv1.RegisterCommitmentsServer(grpcServer, commitmentsRPCService)
+
+		var providerReputation *reputation.Reputation
+		trackerOpts := []preconftracker.Option{
+			preconftracker.WithOpenRetries(opts.CommitmentOpenAttempts, opts.CommitmentOpenWindow),
+		}
+		if peerType == p2p.PeerTypeBidder {
+			providerReputation = reputation.New(
+				store,
+				notificationsSvc,
+				opts.Logger.With("component", "reputation"),
+			)
+			startables = append(
+				startables,
+				StartableObjWithDesc{
+					Desc:      "reputation",
+					Startable: providerReputation,
+				},
+			)
+			trackerOpts = append(trackerOpts, preconftracker.WithOpenRecorder(providerReputation))
+		}
+
 		tracker := preconftracker.NewTracker(
 			chainID,
 			peerType,
@@ -488,6 +561,7 @@ func NewNode(opts *Options) (*Node, error) {
 			sk,
 			optsGetter,
 			opts.Logger.With("component", "tracker"),
+			trackerOpts...,
 		)
 		startables = append(
 			startables,
@@ -498,6 +572,7 @@ func NewNode(opts *Options) (*Node, error) {
 		)
 		srv.RegisterMetricsCollectors(tracker.Metrics()...)
 		preconfStore.SetNotifier(notificationsSvc)
+		debugService.SetCommitmentOpener(tracker)
 
 		l1ContractRPC, err := ethclient.Dial(opts.L1RPCURL)
 		if err != nil {
@@ -570,6 +645,34 @@ func NewNode(opts *Options) (*Node, error) {
 			providerapiv1.RegisterProviderServer(grpcServer, providerAPI)
 			bidProcessor = providerAPI
 			srv.RegisterMetricsCollectors(providerAPI.Metrics()...)
+
+			switch opts.ProviderDecisionMode {
+			case bidpolicy.ModeAuto, bidpolicy.ModeFilter:
+				var next bidpolicy.BidProcessor
+				if opts.ProviderDecisionMode == bidpolicy.ModeFilter {
+					next = providerAPI
+				}
+				policy := opts.ProviderBidPolicy
+				if policy == nil {
+					policy = new(bidpolicy.Policy)
+				}
+				if !policy.Enabled() {
+					opts.Logger.Warn("no bid policy rules configured", "mode", opts.ProviderDecisionMode)
+				}
+				policyEngine, err := bidpolicy.New(
+					policy,
+					next,
+					l1ContractRPC,
+					opts.Logger.With("component", "bidpolicy"),
+				)
+				if err != nil {
+					opts.Logger.Error("failed to create bid policy engine", "error", err)
+					return nil, errors.Join(err, nd.Close())
+				}
+				bidProcessor = policyEngine
+				srv.RegisterMetricsCollectors(policyEngine.Metrics()...)
+				opts.Logger.Info("bid policy engine enabled", "mode", opts.ProviderDecisionMode)
+			}
 			depositMgr = depositmanager.NewDepositManager(
 				depositmanagerstore.New(store),
 				evtMgr,
@@ -590,11 +693,29 @@ func NewNode(opts *Options) (*Node, error) {
 				opts.Logger.Error("failed to create preconf encryptor", "error", err)
 				return nil, errors.Join(err, nd.Close())
 			}
+			var exposureMgr preconfirmation.ExposureManager
+			if opts.ProviderExposureLimits != nil && opts.ProviderExposureLimits.Enabled() {
+				mgr := exposure.NewManager(
+					opts.ProviderExposureLimits,
+					opts.KeySigner.GetAddress(),
+					stakeMgr,
+					opts.Logger.With("component", "exposure"),
+				)
+				if err := mgr.Restore(preconfStore); err != nil {
+					opts.Logger.Error("failed to restore exposure reservations", "error", err)
+					return nil, errors.Join(err, nd.Close())
+				}
+				srv.RegisterMetricsCollectors(mgr.Metrics()...)
+				exposureMgr = mgr
+				opts.Logger.Info("provider exposure limits enabled")
+			}
 			preconfProto := preconfirmation.New(
 				topo,
 				p2pSvc,
 				preconfEncryptor,
 				depositMgr,
+				exposureMgr,
+				nil,
 				bidProcessor,
 				commitmentDA,
 				tracker,
@@ -641,6 +762,8 @@ func NewNode(opts *Options) (*Node, error) {
 				p2pSvc,
 				preconfEncryptor,
 				depositMgr,
+				nil,
+				providerReputation,
 				bidProcessor,
 				commitmentDA,
 				tracker,
@@ -683,6 +806,7 @@ func NewNode(opts *Options) (*Node, error) {
 				depositManagerContract,
 				contractRPC,
 				depositManagerImplAddr,
+				providerReputation,
 			)
 			bidderapiv1.RegisterBidderServer(grpcServer, bidderAPI)
 
@@ -810,6 +934,14 @@ func NewNode(opts *Options) (*Node, error) {
 		return nil, errors.Join(err, nd.Close())
 	}
 
+	if opts.PeerType != p2p.PeerTypeBootnode.String() {
+		err := commitmentapiv1.RegisterCommitmentsHandler(handlerCtx, gatewayMux, grpcConn)
+		if err != nil {
+			opts.Logger.Error("failed to register commitments handler", "err", err)
+			return nil, errors.Join(err, nd.Close())
+		}
+	}
+
 	switch opts.PeerType {
 	case p2p.PeerTypeProvider.String():
 		err := providerapiv1.RegisterProviderHandler(handlerCtx, gatewayMux, grpcConn)
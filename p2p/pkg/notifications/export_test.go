@@ -0,0 +1,7 @@
+package notifications
+
+import "time"
+
+func (n *Notifications) Prune(cutoff time.Time) (int, error) {
+	return n.prune(cutoff)
+}
@@ -1,7 +1,12 @@
 package notifications
 
 import (
+	"log/slog"
+	"sync"
+	"time"
+
 	"github.com/cskr/pubsub/v2"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
 )
 
 type Topic string
@@ -38,20 +43,46 @@ var validTopic = map[Topic]struct{}{
 	TopicTransactionPayment:     {},
 }
 
+// replayableTopic contains the topics which are sequenced and persisted. The
+// peer and validator notifications only describe the current state of the
+// network and are not worth replaying, so they are published live only.
+var replayableTopic = map[Topic]struct{}{
+	TopicProviderRegistered:    {},
+	TopicProviderDeposit:       {},
+	TopicProviderSlashed:       {},
+	TopicProviderDeregistered:  {},
+	TopicCommitmentStoreFailed: {},
+	TopicCommitmentOpenFailed:  {},
+	TopicOtherProviderWonBlock: {},
+	TopicTransactionSettled:    {},
+	TopicTransactionPayment:    {},
+}
+
 func IsTopicValid(topic Topic) bool {
 	_, ok := validTopic[topic]
 	return ok
 }
 
+func IsTopicReplayable(topic Topic) bool {
+	_, ok := replayableTopic[topic]
+	return ok
+}
+
 // Notification is a struct that represents a notification. It has a Topic field
 // that represents the topic of the notification and a Value field that represents
 // the value of the notification. The Value field is a map[string]any, which means
-// that it can be any type.
+// that it can be any type. The sequence number is assigned when the notification
+// is published, starting at 1. It stays 0 for the topics which are not replayable.
 type Notification struct {
+	seq   uint64
 	topic Topic
 	value map[string]any
 }
 
+func (n *Notification) Sequence() uint64 {
+	return n.seq
+}
+
 func (n *Notification) Topic() Topic {
 	return n.topic
 }
@@ -80,10 +111,28 @@ type Notifiee interface {
 	Unsubscribe(chan *Notification) <-chan struct{}
 }
 
+// Replayer is an interface that is used to replay the retained notifications
+// published after the given sequence number, so 0 replays all of them. The fn
+// callback is called in the order of the sequence numbers, the replay stops on
+// the first error.
+type Replayer interface {
+	Replay(after uint64, topics []Topic, fn func(*Notification) error) error
+}
+
 // Notifications is the implementation of the Notifier and Notifiee interfaces. It
-// uses the pubsub package to implement the Notifier and Notifiee interfaces.
+// uses the pubsub package to implement the Notifier and Notifiee interfaces. If
+// created with a storage, the notifications of the replayable topics are also
+// persisted for the retention period so that they can be replayed by subscribers.
 type Notifications struct {
 	ps *pubsub.PubSub[Topic, *Notification]
+
+	mu        sync.Mutex
+	seq       uint64
+	st        storage.Storage
+	retention time.Duration
+	logger    *slog.Logger
+	quit      chan struct{}
+	done      chan struct{}
 }
 
 func New(bufferCapacity int) *Notifications {
@@ -92,7 +141,54 @@ func New(bufferCapacity int) *Notifications {
 	}
 }
 
+// NewWithStore creates notifications which are persisted in the storage. The
+// notifications older than the retention are pruned periodically, a zero
+// retention keeps them forever.
+func NewWithStore(
+	bufferCapacity int,
+	st storage.Storage,
+	retention time.Duration,
+	logger *slog.Logger,
+) (*Notifications, error) {
+	seq, err := lastSequence(st)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Notifications{
+		ps:        pubsub.New[Topic, *Notification](bufferCapacity),
+		seq:       seq,
+		st:        st,
+		retention: retention,
+		logger:    logger,
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go n.pruneLoop()
+	return n, nil
+}
+
 func (n *Notifications) Notify(notification *Notification) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !IsTopicReplayable(notification.Topic()) {
+		n.ps.Pub(notification, notification.Topic())
+		return
+	}
+
+	n.seq++
+	notification.seq = n.seq
+	if n.st != nil {
+		if err := n.persist(notification, time.Now()); err != nil {
+			n.logger.Error(
+				"failed to persist notification",
+				"error", err,
+				"topic", notification.Topic(),
+				"sequence", notification.Sequence(),
+			)
+		}
+	}
 	n.ps.Pub(notification, notification.Topic())
 }
 
@@ -111,5 +207,9 @@ func (n *Notifications) Unsubscribe(ch chan *Notification) <-chan struct{} {
 }
 
 func (n *Notifications) Shutdown() {
+	if n.quit != nil {
+		close(n.quit)
+		<-n.done
+	}
 	n.ps.Shutdown()
 }
@@ -1,11 +1,14 @@
 package notifications_test
 
 import (
+	"io"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/primev/mev-commit/p2p/pkg/notifications"
+	inmemstorage "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	"github.com/primev/mev-commit/x/util"
 )
 
 func TestNotifications(t *testing.T) {
@@ -100,3 +103,106 @@ func TestNotifications(t *testing.T) {
 		t.Error("channel should be closed")
 	}
 }
+
+func TestNotificationsReplay(t *testing.T) {
+	t.Parallel()
+
+	st := inmemstorage.New()
+	logger := util.NewTestLogger(io.Discard)
+
+	n, err := notifications.NewWithStore(10, st, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := n.Subscribe(notifications.TopicProviderSlashed)
+
+	for i := range 3 {
+		n.Notify(notifications.NewNotification(
+			notifications.TopicProviderSlashed,
+			map[string]any{"amount": "10", "index": float64(i)},
+		))
+		n.Notify(notifications.NewNotification(
+			notifications.TopicPeerConnected,
+			map[string]any{"peer_id": "1234"},
+		))
+	}
+
+	for i := range 3 {
+		got := <-ch
+		if want := uint64(i + 1); got.Sequence() != want {
+			t.Fatalf("expected sequence %d, got %d", want, got.Sequence())
+		}
+	}
+	n.Shutdown()
+
+	// Restart with the same storage, the sequence numbers continue.
+	n, err = notifications.NewWithStore(10, st, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(n.Shutdown)
+
+	notification := notifications.NewNotification(
+		notifications.TopicProviderSlashed,
+		map[string]any{"amount": "10", "index": float64(3)},
+	)
+	n.Notify(notification)
+	if notification.Sequence() != 4 {
+		t.Fatalf("expected sequence 4, got %d", notification.Sequence())
+	}
+
+	// Only the replayable topics are persisted.
+	err = n.Replay(0, []notifications.Topic{notifications.TopicPeerConnected}, func(*notifications.Notification) error {
+		t.Error("unexpected peer notification replayed")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var replayed []*notifications.Notification
+	err = n.Replay(1, []notifications.Topic{notifications.TopicProviderSlashed}, func(n *notifications.Notification) error {
+		replayed = append(replayed, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != 3 {
+		t.Fatalf("expected 3 notifications, got %d", len(replayed))
+	}
+	for i, r := range replayed {
+		if want := uint64(i + 2); r.Sequence() != want {
+			t.Errorf("expected sequence %d, got %d", want, r.Sequence())
+		}
+		if r.Topic() != notifications.TopicProviderSlashed {
+			t.Errorf("unexpected topic %s", r.Topic())
+		}
+		if r.Value()["index"] != float64(i+1) {
+			t.Errorf("expected index %d, got %v", i+1, r.Value()["index"])
+		}
+	}
+
+	pruned, err := n.Prune(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 4 {
+		t.Fatalf("expected 4 pruned notifications, got %d", pruned)
+	}
+	err = n.Replay(0, []notifications.Topic{notifications.TopicProviderSlashed}, func(*notifications.Notification) error {
+		t.Error("unexpected notification after pruning")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pruning does not reset the sequence numbers.
+	notification = notifications.NewNotification(notifications.TopicProviderSlashed, nil)
+	n.Notify(notification)
+	if notification.Sequence() != 5 {
+		t.Fatalf("expected sequence 5, got %d", notification.Sequence())
+	}
+}
@@ -0,0 +1,199 @@
+package notifications
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+)
+
+const (
+	notificationsNS = "notifications/"
+	sequenceKey     = "notifications-seq"
+
+	pruneInterval = time.Minute
+)
+
+var (
+	notificationKey = func(seq uint64) string {
+		return fmt.Sprintf("%s%020d", notificationsNS, seq)
+	}
+)
+
+type record struct {
+	Sequence  uint64         `json:"sequence"`
+	Topic     Topic          `json:"topic"`
+	Value     map[string]any `json:"value"`
+	Timestamp int64          `json:"timestamp"`
+}
+
+// lastSequence returns the sequence number of the last persisted notification
+// so that the numbering continues across restarts.
+func lastSequence(st storage.Storage) (uint64, error) {
+	val, err := st.Get(sequenceKey)
+	switch {
+	case errors.Is(err, storage.ErrKeyNotFound):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	case len(val) != 8:
+		return 0, fmt.Errorf("invalid notification sequence length: %d", len(val))
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+func (n *Notifications) persist(notification *Notification, now time.Time) error {
+	buf, err := json.Marshal(record{
+		Sequence:  notification.seq,
+		Topic:     notification.topic,
+		Value:     notification.value,
+		Timestamp: now.UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], notification.seq)
+
+	if b, ok := n.st.(storage.Batcher); ok {
+		batch := b.Batch()
+		if err := batch.Put(notificationKey(notification.seq), buf); err != nil {
+			return err
+		}
+		if err := batch.Put(sequenceKey, seq[:]); err != nil {
+			return err
+		}
+		return batch.Write()
+	}
+
+	if err := n.st.Put(notificationKey(notification.seq), buf); err != nil {
+		return err
+	}
+	return n.st.Put(sequenceKey, seq[:])
+}
+
+// Replay calls fn for every retained notification with a sequence number
+// greater than after which belongs to one of the topics. Without a storage
+// there is nothing to replay.
+func (n *Notifications) Replay(after uint64, topics []Topic, fn func(*Notification) error) error {
+	if n.st == nil {
+		return nil
+	}
+
+	// The notifications are read under the lock and the callback is called
+	// outside of it, so that slow subscribers do not block the publishers.
+	notifications, err := n.retained(after, topics)
+	if err != nil {
+		return err
+	}
+	for _, notification := range notifications {
+		if err := fn(notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Notifications) retained(after uint64, topics []Topic) ([]*Notification, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var (
+		notifications []*Notification
+		decodeErr     error
+	)
+	if after == math.MaxUint64 {
+		return nil, nil
+	}
+
+	// The keys are ordered by sequence number, so the walk starts right at
+	// the first notification after the resume cursor.
+	err := n.st.WalkPrefixFrom(notificationsNS, notificationKey(after+1), func(key string, val []byte) bool {
+		var r record
+		if err := json.Unmarshal(val, &r); err != nil {
+			decodeErr = fmt.Errorf("failed to decode notification %q: %w", key, err)
+			return true
+		}
+		if slices.Contains(topics, r.Topic) {
+			notifications = append(notifications, &Notification{
+				seq:   r.Sequence,
+				topic: r.Topic,
+				value: r.Value,
+			})
+		}
+		return false
+	})
+	if err := errors.Join(err, decodeErr); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// prune deletes the notifications persisted before the cutoff. The keys are
+// ordered by sequence number, so the walk stops at the first notification
+// which is still retained.
+func (n *Notifications) prune(cutoff time.Time) (int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var (
+		keys      []string
+		decodeErr error
+	)
+	err := n.st.WalkPrefix(notificationsNS, func(key string, val []byte) bool {
+		var r record
+		if err := json.Unmarshal(val, &r); err != nil {
+			decodeErr = fmt.Errorf("failed to decode notification %q: %w", key, err)
+			return true
+		}
+		if r.Timestamp >= cutoff.UnixNano() {
+			return true
+		}
+		keys = append(keys, key)
+		return false
+	})
+	if err := errors.Join(err, decodeErr); err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if err := n.st.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	return len(keys), nil
+}
+
+func (n *Notifications) pruneLoop() {
+	defer close(n.done)
+
+	if n.retention == 0 {
+		<-n.quit
+		return
+	}
+
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.quit:
+			return
+		case <-ticker.C:
+			pruned, err := n.prune(time.Now().Add(-n.retention))
+			if err != nil {
+				n.logger.Error("failed to prune notifications", "error", err)
+				continue
+			}
+			if pruned > 0 {
+				n.logger.Debug("pruned notifications", "count", pruned)
+			}
+		}
+	}
+}
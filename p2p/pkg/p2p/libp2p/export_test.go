@@ -1,8 +1,10 @@
 package libp2p
 
 import (
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/primev/mev-commit/p2p/pkg/p2p"
+	"github.com/primev/mev-commit/x/keysigner"
 )
 
 var (
@@ -33,3 +35,17 @@ func (s *Service) AddrString() string {
 func (s *Service) PeerCount() int {
 	return len(s.host.Network().Peers())
 }
+
+var ErrRawKeyUnavailable = errRawKeyUnavailable
+
+func NewSignerKey(ks keysigner.KeySigner) (crypto.PrivKey, []byte, error) {
+	k, err := newSignerKey(ks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k, k.quicSeed, nil
+}
+
+func NewIdentityKey(ks keysigner.KeySigner, store Store) (crypto.PrivKey, bool, error) {
+	return newIdentityKey(ks, store)
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p/core"
 	handshakepb "github.com/primev/mev-commit/p2p/gen/go/handshake/v1"
 	p2pcrypto "github.com/primev/mev-commit/p2p/pkg/crypto"
@@ -41,6 +40,10 @@ type Service struct {
 	register      ProviderRegistry
 	handshakeReq  *handshakepb.HandshakeReq
 	getEthAddress func(core.PeerID) (common.Address, error)
+	// identity is the libp2p identity of the node if it is not derived from
+	// the key of the key signer, in which case it is covered by the handshake
+	// signature to bind it to the ethereum address.
+	identity core.PeerID
 }
 
 func New(
@@ -51,6 +54,7 @@ func New(
 	providerKeys *p2p.Keys,
 	register ProviderRegistry,
 	getEthAddress func(core.PeerID) (common.Address, error),
+	identity core.PeerID,
 ) (*Service, error) {
 	s := &Service{
 		ks:            ks,
@@ -60,6 +64,7 @@ func New(
 		providerKeys:  providerKeys,
 		register:      register,
 		getEthAddress: getEthAddress,
+		identity:      identity,
 	}
 
 	err := s.setHandshakeReq()
@@ -91,7 +96,15 @@ func (h *Service) verifyReq(
 	}
 
 	if !bytes.Equal(observedEthAddress.Bytes(), ethAddress.Bytes()) {
-		return common.Address{}, ErrObservedAddressMismatch
+		// Peers whose libp2p identity is separate from their ethereum account
+		// sign their peer ID along with the handshake data.
+		verified, ethAddress, err = h.signer.Verify(req.Sig, append(unsignedData, peerID...))
+		if err != nil {
+			return common.Address{}, errors.Join(err, ErrObservedAddressMismatch)
+		}
+		if !verified {
+			return common.Address{}, ErrObservedAddressMismatch
+		}
 	}
 
 	if req.PeerType == p2p.PeerTypeProvider.String() {
@@ -105,8 +118,10 @@ func (h *Service) verifyReq(
 
 func (h *Service) createSignature() ([]byte, error) {
 	unsignedData := []byte(h.peerType.String() + h.passcode)
-	hash := crypto.Keccak256Hash(unsignedData)
-	sig, err := h.ks.SignHash(hash.Bytes())
+	if h.identity != "" {
+		unsignedData = append(unsignedData, h.identity...)
+	}
+	sig, err := keysigner.SignKeccak256(h.ks, unsignedData)
 	if err != nil {
 		return nil, err
 	}
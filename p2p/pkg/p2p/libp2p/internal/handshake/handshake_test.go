@@ -92,6 +92,7 @@ func TestHandshake(t *testing.T) {
 			func(p core.PeerID) (common.Address, error) {
 				return address2, nil
 			},
+			"",
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -133,6 +134,7 @@ func TestHandshake(t *testing.T) {
 			func(p core.PeerID) (common.Address, error) {
 				return address1, nil
 			},
+			"",
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -14,12 +14,10 @@ import (
 	ma "github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
 	"github.com/primev/mev-commit/x/keysigner"
-	"github.com/primev/mev-commit/x/util"
 	"google.golang.org/grpc/status"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p"
-	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -28,6 +26,9 @@ import (
 	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	connmgr "github.com/libp2p/go-libp2p/p2p/net/connmgr"
+	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	ws "github.com/libp2p/go-libp2p/p2p/transport/websocket"
 	"github.com/primev/mev-commit/p2p/pkg/p2p"
 	"github.com/primev/mev-commit/p2p/pkg/p2p/libp2p/internal/handshake"
 	"github.com/primev/mev-commit/p2p/pkg/signer"
@@ -72,16 +73,9 @@ type Options struct {
 }
 
 func New(opts *Options) (*Service, error) {
-	privKey, err := opts.KeySigner.GetPrivateKey()
+	libp2pKey, separateIdentity, err := newIdentityKey(opts.KeySigner, opts.Store)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get priv key: %w", err)
-	}
-	defer opts.KeySigner.ZeroPrivateKey(privKey)
-
-	padded32BytePrivKey := util.PadKeyTo32Bytes(privKey.D)
-	libp2pKey, err := libp2pcrypto.UnmarshalSecp256k1PrivateKey(padded32BytePrivKey)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create identity key: %w", err)
 	}
 
 	connmgr, err := connmgr.NewConnManager(
@@ -136,20 +130,28 @@ func New(opts *Options) (*Service, error) {
 		return addrs
 	}
 
-	host, err := libp2p.New(
+	hostOpts := []libp2p.Option{
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/%s/tcp/%d", opts.ListenAddr, opts.ListenPort)),
 		libp2p.AddrsFactory(addressFactory),
 		libp2p.ConnectionGater(conngtr),
 		libp2p.Identity(libp2pKey),
 		libp2p.ConnectionManager(connmgr),
-		libp2p.DefaultTransports,
+		// WebTransport is left out as it requires the raw identity key.
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.Transport(quic.NewTransport),
+		libp2p.Transport(ws.New),
 		libp2p.DefaultSecurity,
 		libp2p.Peerstore(pstore),
 		libp2p.ResourceManager(rmgr),
 		libp2p.NATPortMap(),
 		libp2p.EnableNATService(),
 		libp2p.MultiaddrResolver(madns.DefaultResolver),
-	)
+	}
+	if sk, ok := libp2pKey.(*signerKey); ok {
+		hostOpts = append(hostOpts, libp2p.QUICReuse(sk.newQUICConnManager))
+	}
+
+	host, err := libp2p.New(hostOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -158,9 +160,10 @@ func New(opts *Options) (*Service, error) {
 		opts.Logger.Info("p2p address", "addr", addr, "host_address", host.ID())
 	}
 
-	ethAddress, err := GetEthAddressFromPeerID(host.ID())
-	if err != nil {
-		return nil, err
+	ethAddress := opts.KeySigner.GetAddress()
+	var identity peer.ID
+	if separateIdentity {
+		identity = host.ID()
 	}
 
 	var providerKeys *p2p.Keys
@@ -179,6 +182,7 @@ func New(opts *Options) (*Service, error) {
 		providerKeys,
 		opts.Register,
 		GetEthAddressFromPeerID,
+		identity,
 	)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package libp2p
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -18,6 +19,8 @@ type Store interface {
 	BN254PrivateKey() (*fr.Element, error)
 	SetBN254PublicKey(*bn254.G1Affine) error
 	BN254PublicKey() (*bn254.G1Affine, error)
+	SetIdentityPrivateKey(*ecdsa.PrivateKey) error
+	IdentityPrivateKey() (*ecdsa.PrivateKey, error)
 }
 
 func getOrSetProviderKeys(store Store) (*p2p.Keys, error) {
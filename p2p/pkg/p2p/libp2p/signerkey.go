@@ -0,0 +1,166 @@
+package libp2p
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	dcrecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/ethereum/go-ethereum/crypto"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	pb "github.com/libp2p/go-libp2p/core/crypto/pb"
+	"github.com/libp2p/go-libp2p/p2p/transport/quicreuse"
+	"github.com/primev/mev-commit/x/keysigner"
+	"github.com/quic-go/quic-go"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	statelessResetKeyInfo = "libp2p quic stateless reset key"
+	tokenGeneratorKeyInfo = "libp2p quic token generator key"
+)
+
+var errRawKeyUnavailable = errors.New("the libp2p identity key is held by the key signer and cannot be exported")
+
+var (
+	// identityData is signed once on startup to recover the public key of the
+	// key signer.
+	identityData = []byte("mev-commit libp2p identity")
+	// quicKeysData is signed once on startup with the libp2p signature scheme
+	// to derive the QUIC keys, which also checks that the key signer is able
+	// to sign libp2p messages.
+	quicKeysData = []byte("mev-commit libp2p quic keys")
+)
+
+// newIdentityKey returns the libp2p identity key of the node. Key signers
+// which sign hashes back the identity with the ethereum account of the node.
+// Signers which only sign data, like the Web3Signer backed remote signer,
+// cannot produce the signatures over sha256 digests required by libp2p, so a
+// separate identity key is generated once and kept in the store instead. The
+// handshake then binds the separate identity to the ethereum account. The
+// returned flag reports whether the identity is separate.
+func newIdentityKey(ks keysigner.KeySigner, store Store) (libp2pcrypto.PrivKey, bool, error) {
+	if _, ok := ks.(keysigner.DataSigner); !ok {
+		key, err := newSignerKey(ks)
+		return key, false, err
+	}
+
+	prvKey, err := store.IdentityPrivateKey()
+	if err != nil {
+		return nil, false, err
+	}
+	if prvKey == nil {
+		prvKey, err = crypto.GenerateKey()
+		if err != nil {
+			return nil, false, err
+		}
+		if err := store.SetIdentityPrivateKey(prvKey); err != nil {
+			return nil, false, err
+		}
+	}
+	return (*libp2pcrypto.Secp256k1PrivateKey)(secp256k1.PrivKeyFromBytes(crypto.FromECDSA(prvKey))), true, nil
+}
+
+// signerKey is a libp2p secp256k1 private key backed by a key signer. It
+// allows the node identity to be the same as its ethereum account without
+// the private key being loaded in memory.
+type signerKey struct {
+	signer   keysigner.KeySigner
+	pub      libp2pcrypto.PubKey
+	quicSeed []byte
+}
+
+var _ libp2pcrypto.PrivKey = (*signerKey)(nil)
+
+func newSignerKey(ks keysigner.KeySigner) (*signerKey, error) {
+	sig, err := keysigner.SignKeccak256(ks, identityData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign identity data: %w", err)
+	}
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(identityData), sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != ks.GetAddress() {
+		return nil, errors.New("recovered public key does not match signer address")
+	}
+	pub, err := libp2pcrypto.UnmarshalSecp256k1PublicKey(crypto.CompressPubkey(pubKey))
+	if err != nil {
+		return nil, err
+	}
+	k := &signerKey{signer: ks, pub: pub}
+
+	// The seed is secret and stable across restarts as long as the key signer
+	// produces deterministic (RFC 6979) signatures.
+	k.quicSeed, err = k.Sign(quicKeysData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive quic keys: %w", err)
+	}
+	return k, nil
+}
+
+// Sign produces a DER encoded signature over the sha256 hash of the message,
+// which is what libp2p expects from secp256k1 keys.
+func (k *signerKey) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+	sig, err := k.signer.SignHash(hash[:])
+	if errors.Is(err, keysigner.ErrHashSigningUnsupported) {
+		return nil, fmt.Errorf("key signer %s cannot sign the sha256 digests required by libp2p: %w", k.signer, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sig[:32]) || s.SetByteSlice(sig[32:64]) {
+		return nil, errors.New("invalid signature values")
+	}
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+	return dcrecdsa.NewSignature(&r, &s).Serialize(), nil
+}
+
+func (k *signerKey) GetPublic() libp2pcrypto.PubKey {
+	return k.pub
+}
+
+func (k *signerKey) Type() pb.KeyType {
+	return pb.KeyType_Secp256k1
+}
+
+func (k *signerKey) Raw() ([]byte, error) {
+	return nil, errRawKeyUnavailable
+}
+
+func (k *signerKey) Equals(o libp2pcrypto.Key) bool {
+	other, ok := o.(*signerKey)
+	if !ok {
+		return false
+	}
+	return k.pub.Equals(other.pub)
+}
+
+// newQUICConnManager creates the QUIC connection manager. By default libp2p
+// derives its keys from the raw identity key, which is not available with the
+// signer backed key, so they are derived the same way from the quic seed.
+func (k *signerKey) newQUICConnManager() (*quicreuse.ConnManager, error) {
+	var (
+		resetKey quic.StatelessResetKey
+		tokenKey quic.TokenGeneratorKey
+	)
+	resetReader := hkdf.New(sha256.New, k.quicSeed, nil, []byte(statelessResetKeyInfo))
+	if _, err := io.ReadFull(resetReader, resetKey[:]); err != nil {
+		return nil, err
+	}
+	tokenReader := hkdf.New(sha256.New, k.quicSeed, nil, []byte(tokenGeneratorKeyInfo))
+	if _, err := io.ReadFull(tokenReader, tokenKey[:]); err != nil {
+		return nil, err
+	}
+	return quicreuse.NewConnManager(resetKey, tokenKey)
+}
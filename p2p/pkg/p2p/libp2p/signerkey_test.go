@@ -0,0 +1,191 @@
+package libp2p_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/mev-commit/p2p/pkg/keysstore"
+	"github.com/primev/mev-commit/p2p/pkg/p2p"
+	"github.com/primev/mev-commit/p2p/pkg/p2p/libp2p"
+	inmemstorage "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	"github.com/primev/mev-commit/x/keysigner"
+	mockkeysigner "github.com/primev/mev-commit/x/keysigner/mock"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type dataOnlySigner struct {
+	*mockkeysigner.MockKeySigner
+}
+
+func (s dataOnlySigner) SignHash(_ []byte) ([]byte, error) {
+	return nil, keysigner.ErrHashSigningUnsupported
+}
+
+func (s dataOnlySigner) SignData(data []byte) ([]byte, error) {
+	return s.MockKeySigner.SignHash(crypto.Keccak256(data))
+}
+
+func TestSignerKey(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := mockkeysigner.NewMockKeySigner(privKey, crypto.PubkeyToAddress(privKey.PublicKey))
+
+	key, seed, err := libp2p.NewSignerKey(ks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, seedAgain, err := libp2p.NewSignerKey(ks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(seed, seedAgain) {
+		t.Fatal("expected the quic keys to be derived deterministically")
+	}
+
+	msg := []byte("test")
+	sig, err := key.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := key.GetPublic().Verify(msg, sig)
+	if err != nil || !ok {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+
+	if _, err := key.Raw(); !errors.Is(err, libp2p.ErrRawKeyUnavailable) {
+		t.Fatalf("expected raw key error, got %v", err)
+	}
+
+	_, _, err = libp2p.NewSignerKey(dataOnlySigner{ks})
+	if !errors.Is(err, keysigner.ErrHashSigningUnsupported) {
+		t.Fatalf("expected hash signing error, got %v", err)
+	}
+}
+
+// newTestWeb3Signer starts a signing service exposing the Web3Signer eth1 API,
+// which only signs the keccak256 hash of the given data.
+func newTestWeb3Signer(t *testing.T) *keysigner.RemoteSigner {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)[1:])
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /upcheck", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /api/v1/eth1/publicKeys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]string{pubKey})
+	})
+	mux.HandleFunc("POST /api/v1/eth1/sign/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Data string `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, err := hexutil.Decode(req.Data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sig, err := crypto.Sign(crypto.Keccak256(data), key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sig[crypto.RecoveryIDOffset] += 27
+		_, _ = w.Write([]byte(hexutil.Encode(sig)))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	rs, err := keysigner.NewRemoteSigner(srv.URL, common.Address{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func TestRemoteSignerIdentity(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestWeb3Signer(t)
+	store := keysstore.New(inmemstorage.New())
+
+	key, separate, err := libp2p.NewIdentityKey(rs, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !separate {
+		t.Fatal("expected a separate identity key for the remote signer")
+	}
+	keyAgain, _, err := libp2p.NewIdentityKey(rs, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equals(keyAgain) {
+		t.Fatal("expected the identity key to be kept in the store")
+	}
+
+	msg := []byte("test")
+	sig, err := key.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := key.GetPublic().Verify(msg, sig)
+	if err != nil || !ok {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+
+	svc, err := libp2p.New(&libp2p.Options{
+		KeySigner:  rs,
+		Secret:     "test",
+		ListenPort: 0,
+		ListenAddr: "0.0.0.0",
+		PeerType:   p2p.PeerTypeProvider,
+		Register:   &testRegistry{},
+		Store:      store,
+		Logger:     newTestLogger(t, os.Stdout),
+		MetricsReg: prometheus.NewRegistry(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newTestService(t)
+	t.Cleanup(func() {
+		if err := errors.Join(svc.Close(), client.Close()); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	svAddr, err := svc.Addrs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := client.Connect(context.Background(), svAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.EthAddress != rs.GetAddress() {
+		t.Fatalf("expected eth address %s, got %s", rs.GetAddress().Hex(), p.EthAddress.Hex())
+	}
+}
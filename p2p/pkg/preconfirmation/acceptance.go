@@ -0,0 +1,42 @@
+package preconfirmation
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// weightedAmounts splits the bid amount among the providers by their
+// commitment rates r, offering amount * r / sum(r^2) to each of them. As the
+// bidder pays each provider which commits, the expected total payment is the
+// bid amount. No provider is offered more than the bid amount or less than 1
+// wei.
+func weightedAmounts(
+	amount *big.Int,
+	providers []common.Address,
+	rate func(common.Address) float64,
+) map[common.Address]*big.Int {
+	rates := make(map[common.Address]float64, len(providers))
+	var sumSquares float64
+	for _, provider := range providers {
+		r := rate(provider)
+		rates[provider] = r
+		sumSquares += r * r
+	}
+
+	amounts := make(map[common.Address]*big.Int, len(providers))
+	for provider, r := range rates {
+		weighted, _ := new(big.Float).Mul(
+			new(big.Float).SetInt(amount),
+			big.NewFloat(r/sumSquares),
+		).Int(nil)
+		switch {
+		case weighted.Cmp(amount) > 0:
+			weighted.Set(amount)
+		case weighted.Sign() <= 0:
+			weighted.SetInt64(1)
+		}
+		amounts[provider] = weighted
+	}
+	return amounts
+}
@@ -0,0 +1,55 @@
+package preconfirmation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWeightedAmounts(t *testing.T) {
+	t.Parallel()
+
+	reliable := common.HexToAddress("0x1")
+	unreliable := common.HexToAddress("0x2")
+	unknown := common.HexToAddress("0x3")
+
+	rates := map[common.Address]float64{
+		reliable:   0.9,
+		unreliable: 0.1,
+	}
+	rate := func(provider common.Address) float64 {
+		if r, found := rates[provider]; found {
+			return r
+		}
+		return 0.5
+	}
+
+	amount := big.NewInt(1_000_000)
+	amounts := weightedAmounts(amount, []common.Address{reliable, unreliable, unknown}, rate)
+
+	// rates are 0.9, 0.1 and 0.5
+	for provider, want := range map[common.Address]int64{
+		reliable:   841_121,
+		unreliable: 93_457,
+		unknown:    467_289,
+	} {
+		if diff := new(big.Int).Sub(amounts[provider], big.NewInt(want)); diff.CmpAbs(big.NewInt(1)) > 0 {
+			t.Errorf("provider %s: expected amount %d, got %s", provider, want, amounts[provider])
+		}
+	}
+
+	// The expected payment does not exceed the bid amount.
+	expected := 0.9*float64(amounts[reliable].Int64()) +
+		0.1*float64(amounts[unreliable].Int64()) +
+		0.5*float64(amounts[unknown].Int64())
+	if expected > float64(amount.Int64())+1 {
+		t.Errorf("expected payment %f exceeds bid amount %s", expected, amount)
+	}
+
+	// A single provider is never offered more than the bid amount.
+	single := weightedAmounts(amount, []common.Address{unreliable}, rate)
+	if single[unreliable].Cmp(amount) != 0 {
+		t.Errorf("expected the bid amount for a single provider, got %s", single[unreliable])
+	}
+}
@@ -104,12 +104,13 @@ func (e *encryptor) ConstructEncryptedBid(
 	}
 	bid.NikePublicKey = p2pcrypto.BN254PublicKeyToBytes(pk)
 
-	bidHash, err := GetBidHash(bid, e.domainSeparatorBidHash)
+	bidData, err := getBidSigningData(bid, e.domainSeparatorBidHash)
 	if err != nil {
 		return nil, nil, err
 	}
+	bidHash := crypto.Keccak256(bidData)
 
-	sig, err := e.keySigner.SignHash(bidHash)
+	sig, err := keysigner.SignKeccak256(e.keySigner, bidData)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,12 +149,13 @@ func (e *encryptor) ConstructEncryptedPreConfirmation(
 		ProviderAddress: e.address,
 	}
 
-	preConfirmationHash, err := GetPreConfirmationHash(preConfirmation, sharedKeyProvider, e.domainSeparatorPreConfHash)
+	preConfirmationData, err := getPreConfirmationSigningData(preConfirmation, sharedKeyProvider, e.domainSeparatorPreConfHash)
 	if err != nil {
 		return nil, nil, err
 	}
+	preConfirmationHash := crypto.Keccak256(preConfirmationData)
 
-	sig, err := e.keySigner.SignHash(preConfirmationHash)
+	sig, err := keysigner.SignKeccak256(e.keySigner, preConfirmationData)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -276,15 +278,22 @@ func eipVerify(
 // GetBidHash returns the hash of the bid message. This is done manually to match the
 // Solidity implementation. If the types change, this will need to be updated.
 func GetBidHash(bid *preconfpb.Bid, domainSeparatorHash common.Hash) ([]byte, error) {
+	data, err := getBidSigningData(bid, domainSeparatorHash)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(data), nil
+}
+
+// getBidSigningData returns the EIP-712 encoded bid, whose hash is the bid digest.
+func getBidSigningData(bid *preconfpb.Bid, domainSeparatorHash common.Hash) ([]byte, error) {
 	// Compute the struct hash
 	structHash, err := computeBidStructHash(bid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bid struct hash %w", err)
 	}
 
-	// Final EIP-712 hash
-	eip712Hash := computeEIP712Hash(domainSeparatorHash, structHash)
-	return eip712Hash, nil
+	return computeEIP712Data(domainSeparatorHash, structHash), nil
 }
 
 func computeBidStructHash(bid *preconfpb.Bid) (common.Hash, error) {
@@ -363,15 +372,23 @@ func computeBidStructHash(bid *preconfpb.Bid) (common.Hash, error) {
 // GetPreConfirmationHash returns the hash of the preconfirmation message. This is done manually to match the
 // Solidity implementation. If the types change, this will need to be updated.
 func GetPreConfirmationHash(c *preconfpb.PreConfirmation, sharedKey *bn254.G1Affine, domainSeparatorHash common.Hash) ([]byte, error) {
+	data, err := getPreConfirmationSigningData(c, sharedKey, domainSeparatorHash)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(data), nil
+}
+
+// getPreConfirmationSigningData returns the EIP-712 encoded commitment, whose
+// hash is the commitment digest.
+func getPreConfirmationSigningData(c *preconfpb.PreConfirmation, sharedKey *bn254.G1Affine, domainSeparatorHash common.Hash) ([]byte, error) {
 	// Compute the struct hash
 	structHash, err := computePreConfStructHash(c, sharedKey)
 	if err != nil {
 		return nil, err
 	}
 
-	// Final EIP-712 hash
-	eip712Hash := computeEIP712Hash(domainSeparatorHash, structHash)
-	return eip712Hash, nil
+	return computeEIP712Data(domainSeparatorHash, structHash), nil
 }
 
 func computePreConfStructHash(c *preconfpb.PreConfirmation, sharedKey *bn254.G1Affine) (common.Hash, error) {
@@ -460,10 +477,9 @@ func ComputeDomainSeparator(name string, chainId *big.Int, verifyingContract com
 	return crypto.Keccak256Hash(encodedDomain), nil
 }
 
-func computeEIP712Hash(domainSeparatorHash, structHash common.Hash) []byte {
-	// EIP-712 hash format: "\x19\x01" || domainSeparator || structHash
-	eip712Data := append([]byte("\x19\x01"), append(domainSeparatorHash.Bytes(), structHash.Bytes()...)...)
-	return crypto.Keccak256Hash(eip712Data).Bytes()
+func computeEIP712Data(domainSeparatorHash, structHash common.Hash) []byte {
+	// EIP-712 data format: "\x19\x01" || domainSeparator || structHash
+	return append([]byte("\x19\x01"), append(domainSeparatorHash.Bytes(), structHash.Bytes()...)...)
 }
 
 func transformSignatureVValue(sig []byte) {
@@ -1,12 +1,15 @@
 package preconfirmation
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"math/big"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -23,6 +26,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -35,6 +39,8 @@ type Preconfirmation struct {
 	topo            Topology
 	streamer        p2p.Streamer
 	depositMgr      DepositManager
+	exposureMgr     ExposureManager
+	reputation      ProviderReputation
 	processer       BidProcessor
 	commitmentDA    PreconfContract
 	tracker         Tracker
@@ -62,6 +68,26 @@ type DepositManager interface {
 	) (func() error, error)
 }
 
+// ExposureManager limits the slash exposure the provider takes per block. The
+// returned function releases the reserved exposure of a bid which did not end
+// up as a commitment.
+type ExposureManager interface {
+	ReserveExposure(
+		ctx context.Context,
+		bid *preconfpb.Bid,
+		bidderAddr common.Address,
+	) (func(), error)
+}
+
+// ProviderReputation keeps the statistics of the providers the bidder sends
+// bids to and scores them between 0 and 1.
+type ProviderReputation interface {
+	BidSent(provider common.Address)
+	CommitmentReceived(provider common.Address, latency time.Duration)
+	CommitmentRate(provider common.Address) float64
+	Score(provider common.Address) float64
+}
+
 type Tracker interface {
 	TrackCommitment(ctx context.Context, cm *store.Commitment, txn *types.Transaction) error
 }
@@ -93,6 +119,8 @@ func New(
 	streamer p2p.Streamer,
 	encryptor Encryptor,
 	depositMgr DepositManager,
+	exposureMgr ExposureManager,
+	reputation ProviderReputation,
 	processor BidProcessor,
 	commitmentDA PreconfContract,
 	tracker Tracker,
@@ -105,6 +133,8 @@ func New(
 		streamer:        streamer,
 		encryptor:       encryptor,
 		depositMgr:      depositMgr,
+		exposureMgr:     exposureMgr,
+		reputation:      reputation,
 		processer:       processor,
 		commitmentDA:    commitmentDA,
 		tracker:         tracker,
@@ -127,21 +157,45 @@ func (p *Preconfirmation) Streams() []p2p.StreamDesc {
 	return []p2p.StreamDesc{p.bidStream()}
 }
 
+// ProviderStrategy selects the providers a bid is sent to. The zero value
+// sends the same bid to all the connected providers at once.
+type ProviderStrategy struct {
+	// Providers restricts the bid to these providers if not empty.
+	Providers []common.Address
+	// MaxCommitments caps the number of commitments for the bid. The bid is
+	// sent in waves, to the providers with the highest commitment rate first,
+	// and each wave contacts only as many providers as commitments are still
+	// missing.
+	MaxCommitments int
+	// WeightByAcceptance offers each provider a share of the bid amount
+	// derived from its commitment rate.
+	WeightByAcceptance bool
+	// MinReputationScore excludes the providers whose reputation score is
+	// lower if set.
+	MinReputationScore float64
+}
+
+// outgoingBid is the signed bid sent to a provider along with the key needed
+// to verify the preconfirmation.
+type outgoingBid struct {
+	bid            *preconfpb.Bid
+	encryptedBid   *preconfpb.EncryptedBid
+	nikePrivateKey *fr.Element
+}
+
 // SendBid is meant to be called by the bidder to construct and send bids to the provider.
 // It takes the txHash, the bid amount in wei and the maximum valid block number.
 // It waits for preConfirmations from all providers and then returns.
 // It returns an error if the bid is not valid.
+// A nil strategy sends the bid to all the providers.
 func (p *Preconfirmation) SendBid(
 	ctx context.Context,
 	bid *preconfpb.Bid,
+	strategy *ProviderStrategy,
 ) (chan *preconfpb.PreConfirmation, error) {
-	startTime := time.Now()
-	encryptedBid, nikePrivateKey, err := p.encryptor.ConstructEncryptedBid(bid)
-	if err != nil {
-		p.logger.Error("constructing encrypted bid", "error", err, "bid", bid)
-		return nil, err
+	if strategy == nil {
+		strategy = new(ProviderStrategy)
 	}
-	p.metrics.BidConstructDurationSummary.Observe(time.Since(startTime).Seconds())
 
 	providers := p.topo.GetPeers(topology.Query{Type: p2p.PeerTypeProvider})
 	if len(providers) == 0 {
@@ -165,107 +219,263 @@ func (p *Preconfirmation) SendBid(
 		}
 	}
 
+	if len(strategy.Providers) > 0 {
+		providers = slices.DeleteFunc(providers, func(peer p2p.Peer) bool {
+			return !slices.Contains(strategy.Providers, peer.EthAddress)
+		})
+		if len(providers) == 0 {
+			p.logger.Error("none of the selected providers available", "providers", strategy.Providers, "bid", bid)
+			return nil, errors.New("none of the selected providers available")
+		}
+	}
+
+	if strategy.MinReputationScore > 0 {
+		if p.reputation == nil {
+			return nil, errors.New("provider reputation not available")
+		}
+		providers = slices.DeleteFunc(providers, func(peer p2p.Peer) bool {
+			return p.reputation.Score(peer.EthAddress) < strategy.MinReputationScore
+		})
+		if len(providers) == 0 {
+			p.logger.Error("no providers with the minimum reputation score available", "minScore", strategy.MinReputationScore, "bid", bid)
+			return nil, errors.New("no providers with the minimum reputation score available")
+		}
+	}
+
+	bids, err := p.constructBids(bid, providers, strategy.WeightByAcceptance)
+	if err != nil {
+		return nil, err
+	}
+
+	if strategy.MaxCommitments > 0 {
+		// The rates are read from the store once, not on every comparison.
+		rates := make(map[common.Address]float64, len(providers))
+		for _, peer := range providers {
+			rates[peer.EthAddress] = p.commitmentRate(peer.EthAddress)
+		}
+		slices.SortStableFunc(providers, func(a, b p2p.Peer) int {
+			return cmp.Compare(rates[b.EthAddress], rates[a.EthAddress])
+		})
+	}
+
 	// Create a new channel to receive preConfirmations
 	preConfirmations := make(chan *preconfpb.PreConfirmation, len(providers))
 
-	wg := sync.WaitGroup{}
-	for idx := range providers {
-		wg.Add(1)
-		go func(provider p2p.Peer) {
-			defer wg.Done()
-			logger := p.logger.With("provider", provider, "bid", bid.TxHash)
+	go func() {
+		defer close(preConfirmations)
 
-			providerStream, err := p.streamer.NewStream(
-				ctx,
-				provider,
-				nil,
-				p.bidStream(),
-			)
-			if err != nil {
-				logger.Error("creating stream", "error", err)
-				return
+		received := 0
+		for len(providers) > 0 && ctx.Err() == nil {
+			waveSize := len(providers)
+			if strategy.MaxCommitments > 0 {
+				waveSize = min(waveSize, strategy.MaxCommitments-received)
 			}
+			wave := providers[:waveSize]
+			providers = providers[waveSize:]
 
-			err = providerStream.WriteMsg(ctx, encryptedBid)
-			if err != nil {
-				_ = providerStream.Reset()
-				logger.Error("writing message", "error", err)
+			received += p.sendBidWave(ctx, wave, bids, preConfirmations)
+			if strategy.MaxCommitments > 0 && received >= strategy.MaxCommitments {
 				return
 			}
-			p.metrics.SentBidsCount.Inc()
+		}
+	}()
 
-			writeToReadStartTime := time.Now()
-			encryptedPreConfirmation := new(preconfpb.EncryptedPreConfirmation)
-			err = providerStream.ReadMsg(ctx, encryptedPreConfirmation)
-			if err != nil {
-				_ = providerStream.Reset()
-				logger.Error("reading message", "error", err)
-				return
-			}
-			writeToReadDuration := time.Since(writeToReadStartTime).Seconds()
+	return preConfirmations, nil
+}
 
-			_ = providerStream.Close()
+// commitmentRate returns the share of the bids the provider committed to as
+// recorded by the reputation, or 1/2 if the reputation is not available.
+func (p *Preconfirmation) commitmentRate(provider common.Address) float64 {
+	if p.reputation == nil {
+		return 0.5
+	}
+	return p.reputation.CommitmentRate(provider)
+}
 
-			// Process preConfirmation as a bidder
-			verifyStartTime := time.Now()
-			sharedSecretKey, providerAddress, err := p.encryptor.VerifyEncryptedPreConfirmation(
-				bid,
-				provider.Keys.NIKEPublicKey,
-				nikePrivateKey,
-				encryptedPreConfirmation,
-			)
-			if err != nil {
-				logger.Error("verifying provider signature", "error", err)
-				return
-			}
-			verifyDuration := time.Since(verifyStartTime).Seconds()
-			p.metrics.VerifyPreconfDurationSummary.Observe(verifyDuration)
-
-			wireLatency := time.Since(time.Unix(0, encryptedPreConfirmation.DispatchTimestamp)).Seconds()
-			logger.Info(
-				"successfully received preconf",
-				"totalDuration", writeToReadDuration,
-				"wireLatency", wireLatency,
-			)
+// constructBids signs and encrypts the bid for each of the providers. Unless
+// the amounts are weighted, all the providers get the same bid.
+func (p *Preconfirmation) constructBids(
+	bid *preconfpb.Bid,
+	providers []p2p.Peer,
+	weightByAcceptance bool,
+) (map[common.Address]*outgoingBid, error) {
+	construct := func(b *preconfpb.Bid) (*outgoingBid, error) {
+		startTime := time.Now()
+		encryptedBid, nikePrivateKey, err := p.encryptor.ConstructEncryptedBid(b)
+		if err != nil {
+			p.logger.Error("constructing encrypted bid", "error", err, "bid", b)
+			return nil, err
+		}
+		p.metrics.BidConstructDurationSummary.Observe(time.Since(startTime).Seconds())
+		return &outgoingBid{bid: b, encryptedBid: encryptedBid, nikePrivateKey: nikePrivateKey}, nil
+	}
 
-			preConfirmation := &preconfpb.PreConfirmation{
-				Bid:               bid,
-				SharedSecret:      sharedSecretKey,
-				Digest:            encryptedPreConfirmation.Commitment,
-				Signature:         encryptedPreConfirmation.Signature,
-				DispatchTimestamp: encryptedPreConfirmation.DispatchTimestamp,
-			}
+	bids := make(map[common.Address]*outgoingBid, len(providers))
+	if !weightByAcceptance {
+		out, err := construct(bid)
+		if err != nil {
+			return nil, err
+		}
+		for _, provider := range providers {
+			bids[provider.EthAddress] = out
+		}
+		return bids, nil
+	}
 
-			preConfirmation.ProviderAddress = make([]byte, len(providerAddress))
-			copy(preConfirmation.ProviderAddress, providerAddress[:])
+	amount, ok := new(big.Int).SetString(bid.BidAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid bid amount: %s", bid.BidAmount)
+	}
+	addrs := make([]common.Address, 0, len(providers))
+	for _, provider := range providers {
+		addrs = append(addrs, provider.EthAddress)
+	}
 
-			encryptedAndDecryptedPreconfirmation := &store.Commitment{
-				EncryptedPreConfirmation: encryptedPreConfirmation,
-				PreConfirmation:          preConfirmation,
+	// Providers offered the same amount share the bid.
+	byAmount := make(map[string]*outgoingBid)
+	for addr, providerAmount := range weightedAmounts(amount, addrs, p.commitmentRate) {
+		out, found := byAmount[providerAmount.String()]
+		if !found {
+			b := proto.Clone(bid).(*preconfpb.Bid)
+			b.BidAmount = providerAmount.String()
+			var err error
+			if out, err = construct(b); err != nil {
+				return nil, err
 			}
+			byAmount[providerAmount.String()] = out
+		}
+		bids[addr] = out
+	}
+	return bids, nil
+}
+
+// sendBidWave sends the bid to the providers concurrently and returns the
+// number of preconfirmations received.
+func (p *Preconfirmation) sendBidWave(
+	ctx context.Context,
+	providers []p2p.Peer,
+	bids map[common.Address]*outgoingBid,
+	preConfirmations chan<- *preconfpb.PreConfirmation,
+) int {
+	var (
+		wg       sync.WaitGroup
+		received atomic.Int64
+	)
+	for idx := range providers {
+		wg.Add(1)
+		go func(provider p2p.Peer) {
+			defer wg.Done()
 
-			p.metrics.ReceivedPreconfsCount.Inc()
-			// Track the preconfirmation
-			if err := p.tracker.TrackCommitment(ctx, encryptedAndDecryptedPreconfirmation, nil); err != nil {
-				logger.Error("tracking commitment", "error", err)
+			preConfirmation, err := p.sendBidToProvider(ctx, provider, bids[provider.EthAddress])
+			if err != nil {
 				return
 			}
+			received.Add(1)
 
 			select {
 			case preConfirmations <- preConfirmation:
 			case <-ctx.Done():
-				logger.Error("context cancelled", "error", ctx.Err())
+				p.logger.Error("context cancelled", "error", ctx.Err())
 				return
 			}
 		}(providers[idx])
 	}
+	wg.Wait()
+	return int(received.Load())
+}
 
-	go func() {
-		wg.Wait()
-		close(preConfirmations)
-	}()
+func (p *Preconfirmation) sendBidToProvider(
+	ctx context.Context,
+	provider p2p.Peer,
+	out *outgoingBid,
+) (*preconfpb.PreConfirmation, error) {
+	bid := out.bid
+	logger := p.logger.With("provider", provider, "bid", bid.TxHash)
+
+	providerStream, err := p.streamer.NewStream(
+		ctx,
+		provider,
+		nil,
+		p.bidStream(),
+	)
+	if err != nil {
+		logger.Error("creating stream", "error", err)
+		return nil, err
+	}
 
-	return preConfirmations, nil
+	err = providerStream.WriteMsg(ctx, out.encryptedBid)
+	if err != nil {
+		_ = providerStream.Reset()
+		logger.Error("writing message", "error", err)
+		return nil, err
+	}
+	p.metrics.SentBidsCount.Inc()
+	if p.reputation != nil {
+		p.reputation.BidSent(provider.EthAddress)
+	}
+
+	writeToReadStartTime := time.Now()
+	encryptedPreConfirmation := new(preconfpb.EncryptedPreConfirmation)
+	err = providerStream.ReadMsg(ctx, encryptedPreConfirmation)
+	if err != nil {
+		_ = providerStream.Reset()
+		logger.Error("reading message", "error", err)
+		return nil, err
+	}
+	writeToRead := time.Since(writeToReadStartTime)
+	writeToReadDuration := writeToRead.Seconds()
+
+	_ = providerStream.Close()
+
+	// Process preConfirmation as a bidder
+	verifyStartTime := time.Now()
+	sharedSecretKey, providerAddress, err := p.encryptor.VerifyEncryptedPreConfirmation(
+		bid,
+		provider.Keys.NIKEPublicKey,
+		out.nikePrivateKey,
+		encryptedPreConfirmation,
+	)
+	if err != nil {
+		logger.Error("verifying provider signature", "error", err)
+		return nil, err
+	}
+	verifyDuration := time.Since(verifyStartTime).Seconds()
+	p.metrics.VerifyPreconfDurationSummary.Observe(verifyDuration)
+	if p.reputation != nil {
+		p.reputation.CommitmentReceived(provider.EthAddress, writeToRead)
+	}
+
+	wireLatency := time.Since(time.Unix(0, encryptedPreConfirmation.DispatchTimestamp)).Seconds()
+	logger.Info(
+		"successfully received preconf",
+		"totalDuration", writeToReadDuration,
+		"wireLatency", wireLatency,
+	)
+
+	preConfirmation := &preconfpb.PreConfirmation{
+		Bid:               bid,
+		SharedSecret:      sharedSecretKey,
+		Digest:            encryptedPreConfirmation.Commitment,
+		Signature:         encryptedPreConfirmation.Signature,
+		DispatchTimestamp: encryptedPreConfirmation.DispatchTimestamp,
+	}
+
+	preConfirmation.ProviderAddress = make([]byte, len(providerAddress))
+	copy(preConfirmation.ProviderAddress, providerAddress[:])
+
+	encryptedAndDecryptedPreconfirmation := &store.Commitment{
+		EncryptedPreConfirmation: encryptedPreConfirmation,
+		PreConfirmation:          preConfirmation,
+	}
+
+	p.metrics.ReceivedPreconfsCount.Inc()
+	// Track the preconfirmation
+	if err := p.tracker.TrackCommitment(ctx, encryptedAndDecryptedPreconfirmation, nil); err != nil {
+		logger.Error("tracking commitment", "error", err)
+		return nil, err
+	}
+
+	return preConfirmation, nil
 }
 
 var ErrInvalidBidderTypeForBid = errors.New("invalid bidder type for bid")
@@ -315,6 +525,19 @@ func (p *Preconfirmation) handleBid(
 		}
 	}()
 
+	// Bids breaching the exposure limits never reach the decision stream.
+	if p.exposureMgr != nil {
+		release, err := p.exposureMgr.ReserveExposure(ctx, bid, *bidderAddr)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if !successful {
+				release()
+			}
+		}()
+	}
+
 	// try to get a decision within 30 seconds
 	ctx, cancel := context.WithTimeout(ctx, p.providerTimeout)
 	defer cancel()
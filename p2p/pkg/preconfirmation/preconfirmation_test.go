@@ -6,6 +6,7 @@ import (
 	"io"
 	"log/slog"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -120,6 +121,35 @@ func (t *testDepositManager) CheckAndDeductDeposit(
 	return func() error { return nil }, nil
 }
 
+type testReputation struct {
+	mu          sync.Mutex
+	bidsSent    int
+	commitments int
+	score       float64
+}
+
+func (t *testReputation) BidSent(_ common.Address) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.bidsSent++
+}
+
+func (t *testReputation) CommitmentReceived(_ common.Address, _ time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.commitments++
+}
+
+func (t *testReputation) CommitmentRate(_ common.Address) float64 {
+	return 0.5
+}
+
+func (t *testReputation) Score(_ common.Address) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.score
+}
+
 type testTracker struct{}
 
 func (t *testTracker) TrackCommitment(
@@ -204,11 +234,14 @@ func TestPreconfBidSubmission(t *testing.T) {
 		}
 
 		depositMgr := &testDepositManager{}
+		reputation := &testReputation{score: 0.6}
 		p := preconfirmation.New(
 			topo,
 			svc,
 			signer,
 			depositMgr,
+			nil,
+			reputation,
 			proc,
 			&testCommitmentDA{},
 			&testTracker{},
@@ -223,7 +256,7 @@ func TestPreconfBidSubmission(t *testing.T) {
 
 		svc.SetPeerHandler(server, p.Streams()[0])
 
-		respC, err := p.SendBid(context.Background(), bid)
+		respC, err := p.SendBid(context.Background(), bid, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -237,5 +270,53 @@ func TestPreconfBidSubmission(t *testing.T) {
 		if string(commitment.Signature) != "test" {
 			t.Fatalf("preConfirmation signature is not equal to test")
 		}
+
+		respC, err = p.SendBid(context.Background(), bid, &preconfirmation.ProviderStrategy{
+			Providers:          []common.Address{server.EthAddress},
+			MaxCommitments:     1,
+			WeightByAcceptance: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments := 0
+		for commitment := range respC {
+			if commitment.Bid.BidAmount != "10" {
+				t.Fatalf("expected the full bid amount for a single provider, got %s", commitment.Bid.BidAmount)
+			}
+			commitments++
+		}
+		if commitments != 1 {
+			t.Fatalf("expected 1 commitment, got %d", commitments)
+		}
+
+		_, err = p.SendBid(context.Background(), bid, &preconfirmation.ProviderStrategy{
+			Providers: []common.Address{common.HexToAddress("0x3")},
+		})
+		if err == nil {
+			t.Fatal("expected error for a bid without any selected provider")
+		}
+
+		respC, err = p.SendBid(context.Background(), bid, &preconfirmation.ProviderStrategy{
+			MinReputationScore: 0.5,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range respC {
+		}
+		reputation.mu.Lock()
+		if reputation.bidsSent != 3 || reputation.commitments != 3 {
+			t.Fatalf("expected 3 bids and commitments recorded, got %d and %d", reputation.bidsSent, reputation.commitments)
+		}
+		reputation.score = 0.4
+		reputation.mu.Unlock()
+
+		_, err = p.SendBid(context.Background(), bid, &preconfirmation.ProviderStrategy{
+			MinReputationScore: 0.5,
+		})
+		if err == nil {
+			t.Fatal("expected error for a bid without any provider with the minimum score")
+		}
 	})
 }
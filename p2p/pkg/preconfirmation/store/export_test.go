@@ -3,4 +3,5 @@ package store
 var (
 	CmtIndexNS      = cmtIndexNS
 	IndexToDigestNS = indexToDigestNS
+	QueryIndexNS    = "cq/"
 )
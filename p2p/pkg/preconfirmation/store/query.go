@@ -0,0 +1,330 @@
+package store
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// The query indexes map the filterable attributes of a commitment to its key.
+// Every index key ends with the sort key of the commitment, so that all the
+// indexes are ordered by block number and then by the bid amount, same as the
+// commitments themselves.
+const (
+	statusIndexNS       = "cq/s/"
+	counterpartyIndexNS = "cq/a/"
+	txHashIndexNS       = "cq/t/"
+	blockIndexNS        = "cq/b/"
+
+	queryIndexesVersionKey = "cq-version"
+	queryIndexesVersion    = "1"
+
+	blockNumberWidth = 20
+)
+
+var (
+	// ErrInvalidCursor is returned when the query cursor cannot be decoded.
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+var (
+	sortKey = func(blockNum int64, bidAmt string, index []byte) string {
+		bidAmtInt, ok := new(big.Int).SetString(bidAmt, 10)
+		if !ok {
+			return ""
+		}
+		invertedBidAmount := new(big.Int).Sub(MaxBidAmount, bidAmtInt)
+		return fmt.Sprintf("%0*d/%064x/%x", blockNumberWidth, blockNum, invertedBidAmount, index)
+	}
+	statusIndexPrefix = func(status CommitmentStatus) string {
+		return fmt.Sprintf("%s%s/", statusIndexNS, status)
+	}
+	counterpartyIndexPrefix = func(address []byte) string {
+		return fmt.Sprintf("%s%x/", counterpartyIndexNS, address)
+	}
+	txHashIndexPrefix = func(txHash string) string {
+		return fmt.Sprintf("%s%s/", txHashIndexNS, normalizeTxHash(txHash))
+	}
+)
+
+func normalizeTxHash(txHash string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(txHash), "0x"))
+}
+
+// Query specifies the filters applied to the commitments. The zero value of a
+// field means no filtering on it.
+type Query struct {
+	Statuses      []CommitmentStatus
+	Counterparty  *common.Address
+	TxHash        string
+	FromBlock     int64
+	ToBlock       int64
+	FromTimestamp int64
+	ToTimestamp   int64
+}
+
+func (q *Query) matches(c *Commitment) bool {
+	if len(q.Statuses) > 0 && !slices.Contains(q.Statuses, c.Status) {
+		return false
+	}
+	if q.Counterparty != nil {
+		isProvider := common.BytesToAddress(c.ProviderAddress) == *q.Counterparty
+		isBidder := c.BidderAddress != nil && *c.BidderAddress == *q.Counterparty
+		if !isProvider && !isBidder {
+			return false
+		}
+	}
+	if q.TxHash != "" && !slices.Contains(txHashes(c), normalizeTxHash(q.TxHash)) {
+		return false
+	}
+	blockNum := c.Bid.BlockNumber
+	if q.FromBlock != 0 && blockNum < q.FromBlock {
+		return false
+	}
+	if q.ToBlock != 0 && blockNum > q.ToBlock {
+		return false
+	}
+	ts := DispatchTimestamp(c)
+	if q.FromTimestamp != 0 && ts < q.FromTimestamp {
+		return false
+	}
+	if q.ToTimestamp != 0 && ts > q.ToTimestamp {
+		return false
+	}
+	return true
+}
+
+// DispatchTimestamp returns the dispatch timestamp of the commitment. The
+// bidder only knows the timestamp of the decrypted preconfirmation, while the
+// provider stores it with the encrypted one.
+func DispatchTimestamp(c *Commitment) int64 {
+	if c.PreConfirmation != nil && c.PreConfirmation.DispatchTimestamp != 0 {
+		return c.PreConfirmation.DispatchTimestamp
+	}
+	if c.EncryptedPreConfirmation != nil {
+		return c.EncryptedPreConfirmation.DispatchTimestamp
+	}
+	return 0
+}
+
+func txHashes(c *Commitment) []string {
+	var hashes []string
+	for _, h := range strings.Split(c.Bid.TxHash, ",") {
+		if h = normalizeTxHash(h); h != "" {
+			hashes = append(hashes, h)
+		}
+	}
+	return hashes
+}
+
+func commitmentSortKey(c *Commitment) string {
+	return sortKey(c.Bid.BlockNumber, c.Bid.BidAmount, c.Commitment)
+}
+
+// queryIndexKeys returns the keys of all the query indexes of the commitment
+// except the status index, which changes during the lifetime of the commitment.
+func queryIndexKeys(c *Commitment) []string {
+	sk := commitmentSortKey(c)
+	if sk == "" {
+		return nil
+	}
+
+	keys := []string{blockIndexNS + sk}
+	if len(c.ProviderAddress) > 0 {
+		keys = append(keys, counterpartyIndexPrefix(c.ProviderAddress)+sk)
+	}
+	if c.BidderAddress != nil && !bytes.Equal(c.BidderAddress.Bytes(), c.ProviderAddress) {
+		keys = append(keys, counterpartyIndexPrefix(c.BidderAddress.Bytes())+sk)
+	}
+	for _, h := range txHashes(c) {
+		keys = append(keys, txHashIndexPrefix(h)+sk)
+	}
+	return keys
+}
+
+func statusIndexKey(c *Commitment) string {
+	sk := commitmentSortKey(c)
+	if sk == "" {
+		return ""
+	}
+	return statusIndexPrefix(c.Status) + sk
+}
+
+func putQueryIndexes(writer storage.Writer, c *Commitment) error {
+	key := commitmentKey(c.Bid.BlockNumber, c.Bid.BidAmount, c.Commitment)
+	if key == "" {
+		return nil
+	}
+	for _, idxKey := range append(queryIndexKeys(c), statusIndexKey(c)) {
+		if err := writer.Put(idxKey, []byte(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatusIndex moves the commitment from the index of the previous status
+// to the index of the current one.
+func updateStatusIndex(writer storage.Writer, c *Commitment, prev CommitmentStatus) error {
+	if prev == c.Status {
+		return nil
+	}
+	key := statusIndexKey(c)
+	if key == "" {
+		return nil
+	}
+	if err := writer.Delete(statusIndexPrefix(prev) + commitmentSortKey(c)); err != nil {
+		return err
+	}
+	return writer.Put(key, []byte(commitmentKey(c.Bid.BlockNumber, c.Bid.BidAmount, c.Commitment)))
+}
+
+// BuildQueryIndexes creates the query indexes for the commitments stored
+// before the indexes were introduced. It is a no-op once the indexes are
+// built.
+func (s *Store) BuildQueryIndexes() (retErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.st.Get(queryIndexesVersionKey)
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, storage.ErrKeyNotFound):
+		return err
+	}
+
+	commitments := make([]*Commitment, 0)
+	err = s.st.WalkPrefix(commitmentNS, func(_ string, value []byte) bool {
+		commitment := new(Commitment)
+		if err := msgpack.Unmarshal(value, commitment); err != nil {
+			return false
+		}
+		commitments = append(commitments, commitment)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
+	for _, c := range commitments {
+		if err := putQueryIndexes(writer, c); err != nil {
+			return err
+		}
+	}
+	return writer.Put(queryIndexesVersionKey, []byte(queryIndexesVersion))
+}
+
+// QueryCommitments returns up to limit commitments matching the query, after
+// the position of the cursor. The returned cursor is empty when there are no
+// more commitments to return.
+func (s *Store) QueryCommitments(q *Query, cursor string, limit int) ([]*Commitment, string, error) {
+	if q == nil {
+		q = &Query{}
+	}
+	if limit <= 0 {
+		limit = defaultListOpts.Limit
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, "", ErrInvalidCursor
+	}
+
+	// Use the most selective index available, the rest of the filters are
+	// applied on the commitments.
+	var indexPrefix string
+	switch {
+	case q.TxHash != "":
+		indexPrefix = txHashIndexPrefix(q.TxHash)
+	case q.Counterparty != nil:
+		indexPrefix = counterpartyIndexPrefix(q.Counterparty.Bytes())
+	case len(q.Statuses) == 1:
+		indexPrefix = statusIndexPrefix(q.Statuses[0])
+	default:
+		indexPrefix = blockIndexNS
+	}
+
+	// The sort keys start with the zero padded block number, so the walk can
+	// be narrowed to the common prefix of the block range.
+	from := fmt.Sprintf("%0*d", blockNumberWidth, q.FromBlock)
+	to := fmt.Sprintf("%0*d", blockNumberWidth, int64(^uint64(0)>>1))
+	if q.ToBlock != 0 {
+		to = fmt.Sprintf("%0*d", blockNumberWidth, q.ToBlock)
+	}
+	commonLen := 0
+	for commonLen < len(from) && from[commonLen] == to[commonLen] {
+		commonLen++
+	}
+
+	// The walk seeks to the cursor, or to the first block of the range, so
+	// that every page only reads the keys it returns. The key of the cursor
+	// itself was returned on the previous page.
+	start := indexPrefix + from
+	if cursorKey := indexPrefix + string(after); len(after) > 0 && cursorKey > start {
+		start = cursorKey
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var (
+		commitments = make([]*Commitment, 0, limit)
+		next        string
+		walkErr     error
+	)
+	err = s.st.WalkPrefixFrom(indexPrefix+from[:commonLen], start, func(key string, value []byte) bool {
+		sk := key[len(indexPrefix):]
+		if len(after) > 0 && sk == string(after) {
+			return false
+		}
+		blockNum, err := strconv.ParseInt(sk[:min(blockNumberWidth, len(sk))], 10, 64)
+		if err != nil {
+			walkErr = fmt.Errorf("invalid index key %q: %w", key, err)
+			return true
+		}
+		if blockNum < q.FromBlock {
+			return false
+		}
+		if q.ToBlock != 0 && blockNum > q.ToBlock {
+			return true
+		}
+
+		buf, err := s.st.Get(string(value))
+		if err != nil {
+			walkErr = fmt.Errorf("failed to get commitment %q: %w", key, err)
+			return true
+		}
+		commitment := new(Commitment)
+		if err := msgpack.Unmarshal(buf, commitment); err != nil {
+			walkErr = fmt.Errorf("failed to decode commitment %q: %w", key, err)
+			return true
+		}
+		if !q.matches(commitment) {
+			return false
+		}
+
+		commitments = append(commitments, commitment)
+		if len(commitments) == limit {
+			next = base64.RawURLEncoding.EncodeToString([]byte(sk))
+			return true
+		}
+		return false
+	})
+	if err := errors.Join(err, walkErr); err != nil {
+		return nil, "", err
+	}
+	return commitments, next, nil
+}
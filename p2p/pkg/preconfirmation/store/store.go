@@ -92,6 +92,15 @@ type Commitment struct {
 	Refund        string
 	BidderAddress *common.Address
 	BidAmount     *big.Int
+	OpenAttempts  []*OpenAttempt
+}
+
+// OpenAttempt is an openCommitment transaction sent for the commitment.
+type OpenAttempt struct {
+	TxnHash string
+	// Time is the unix time at which the outcome of the attempt was known.
+	Time  int64
+	Error string
 }
 
 type BlockWinner struct {
@@ -118,21 +127,8 @@ func (s *Store) AddCommitment(commitment *Commitment) (err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	var writer storage.Writer
-	if w, ok := s.st.(storage.Batcher); ok {
-		batch := w.Batch()
-		writer = batch
-		defer func() {
-			switch {
-			case err != nil:
-				batch.Reset()
-			case err == nil:
-				err = batch.Write()
-			}
-		}()
-	} else {
-		writer = s.st
-	}
+	writer, write := s.batchWriter()
+	defer write(&err)
 
 	key := commitmentKey(commitment.Bid.BlockNumber, commitment.Bid.BidAmount, commitment.Commitment)
 
@@ -141,10 +137,25 @@ func (s *Store) AddCommitment(commitment *Commitment) (err error) {
 		return err
 	}
 
+	// The commitment might be added again with a different status, in which
+	// case the previous status index entry is removed.
+	if prevBuf, err := s.st.Get(key); err == nil {
+		prev := new(Commitment)
+		if err := msgpack.Unmarshal(prevBuf, prev); err == nil && prev.Status != commitment.Status {
+			if err := writer.Delete(statusIndexKey(prev)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := writer.Put(key, buf); err != nil {
 		return err
 	}
 
+	if err := putQueryIndexes(writer, commitment); err != nil {
+		return err
+	}
+
 	cIndexKey := cmtIndexKey(commitment.Commitment)
 	cIndexValue := CommitmentIndexValue{
 		BlockNumber: commitment.Bid.BlockNumber,
@@ -159,13 +170,31 @@ func (s *Store) AddCommitment(commitment *Commitment) (err error) {
 	return writer.Put(cIndexKey, cIndexValueBuf)
 }
 
+// batchWriter returns a batch of the storage if it supports batches, so that
+// a commitment and its indexes are written together. The returned function
+// writes the batch, or drops it if *err is set.
+func (s *Store) batchWriter() (storage.Writer, func(err *error)) {
+	b, ok := s.st.(storage.Batcher)
+	if !ok {
+		return s.st, func(*error) {}
+	}
+	batch := b.Batch()
+	return batch, func(err *error) {
+		if *err != nil {
+			batch.Reset()
+			return
+		}
+		*err = batch.Write()
+	}
+}
+
 func (s *Store) SetStatus(
 	blockNumber int64,
 	bidAmt string,
 	cDigest []byte,
 	status CommitmentStatus,
 	details string,
-) error {
+) (retErr error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -176,6 +205,7 @@ func (s *Store) SetStatus(
 		if errors.Is(err, storage.ErrKeyNotFound) {
 			return nil
 		}
+		return err
 	}
 
 	commitment := new(Commitment)
@@ -184,6 +214,7 @@ func (s *Store) SetStatus(
 		return err
 	}
 
+	prevStatus := commitment.Status
 	commitment.Status = status
 	commitment.Details = details
 
@@ -192,7 +223,62 @@ func (s *Store) SetStatus(
 		return err
 	}
 
-	return s.st.Put(key, buf)
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
+	if err := writer.Put(key, buf); err != nil {
+		return err
+	}
+
+	return updateStatusIndex(writer, commitment, prevStatus)
+}
+
+// AddOpenAttempt records the outcome of an attempt to open the commitment
+// along with the resulting status of the commitment.
+func (s *Store) AddOpenAttempt(
+	blockNumber int64,
+	bidAmt string,
+	cDigest []byte,
+	attempt *OpenAttempt,
+	status CommitmentStatus,
+	details string,
+) (retErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := commitmentKey(blockNumber, bidAmt, cDigest)
+
+	cmtBuf, err := s.st.Get(key)
+	if err != nil {
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	commitment := new(Commitment)
+	if err := msgpack.Unmarshal(cmtBuf, commitment); err != nil {
+		return err
+	}
+
+	prevStatus := commitment.Status
+	commitment.Status = status
+	commitment.Details = details
+	commitment.OpenAttempts = append(commitment.OpenAttempts, attempt)
+
+	buf, err := msgpack.Marshal(commitment)
+	if err != nil {
+		return err
+	}
+
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
+	if err := writer.Put(key, buf); err != nil {
+		return err
+	}
+
+	return updateStatusIndex(writer, commitment, prevStatus)
 }
 
 func (s *Store) GetCommitments(blockNum int64) ([]*Commitment, error) {
@@ -261,6 +347,27 @@ func (s *Store) ListCommitments(opts *ListOpts) ([]*Commitment, error) {
 	return commitments, nil
 }
 
+// WalkCommitments calls fn for every commitment in the store. The walk stops
+// when fn returns true.
+func (s *Store) WalkCommitments(fn func(*Commitment) bool) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var unmarshalErr error
+	err := s.st.WalkPrefix(commitmentNS, func(key string, value []byte) bool {
+		commitment := new(Commitment)
+		if err := msgpack.Unmarshal(value, commitment); err != nil {
+			unmarshalErr = fmt.Errorf("failed to unmarshal commitment %s: %w", key, err)
+			return true
+		}
+		return fn(commitment)
+	})
+	if err != nil {
+		return err
+	}
+	return unmarshalErr
+}
+
 func (s *Store) SetCommitmentIndexByDigest(cDigest, cIndex [32]byte) (retErr error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -273,6 +380,7 @@ func (s *Store) SetCommitmentIndexByDigest(cDigest, cIndex [32]byte) (retErr err
 		return err
 	}
 
+	prevStatus := cmt.Status
 	cmt.CommitmentIndex = cIndex[:]
 	if cmt.Status == CommitmentStatusPending {
 		cmt.Status = CommitmentStatusStored
@@ -284,30 +392,22 @@ func (s *Store) SetCommitmentIndexByDigest(cDigest, cIndex [32]byte) (retErr err
 
 	commitmentKey := commitmentKey(cmt.Bid.BlockNumber, cmt.Bid.BidAmount, cmt.Commitment)
 
-	var writer storage.Writer
-	if w, ok := s.st.(storage.Batcher); ok {
-		batch := w.Batch()
-		writer = batch
-		defer func() {
-			switch {
-			case retErr != nil:
-				batch.Reset()
-			case retErr == nil:
-				err = batch.Write()
-			}
-		}()
-	} else {
-		writer = s.st
-	}
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
 	if err := writer.Put(commitmentKey, buf); err != nil {
 		return err
 	}
 
+	if err := updateStatusIndex(writer, cmt, prevStatus); err != nil {
+		return err
+	}
+
 	indexToDigest := indexToDigestKey(cmt.CommitmentIndex)
 	return writer.Put(indexToDigest, []byte(commitmentKey))
 }
 
-func (s *Store) UpdateSettlement(index []byte, isSlash bool) error {
+func (s *Store) UpdateSettlement(index []byte, isSlash bool) (retErr error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -338,11 +438,13 @@ func (s *Store) UpdateSettlement(index []byte, isSlash bool) error {
 					"transaction_hashes": cmt.Bid.TxHash,
 					"is_slashed":         isSlash,
 					"provider":           common.Bytes2Hex(cmt.ProviderAddress),
+					"commitment_index":   common.Bytes2Hex(index),
 				}),
 			)
 		}
 	}()
 
+	prevStatus := cmt.Status
 	if isSlash {
 		cmt.Status = CommitmentStatusSlashed
 	} else {
@@ -354,7 +456,60 @@ func (s *Store) UpdateSettlement(index []byte, isSlash bool) error {
 		return err
 	}
 
-	return s.st.Put(string(commitmentKey), buf)
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
+	if err := writer.Put(string(commitmentKey), buf); err != nil {
+		return err
+	}
+
+	return updateStatusIndex(writer, cmt, prevStatus)
+}
+
+// RevertSettlement moves a settled or slashed commitment back to opened after
+// the settlement was removed from the chain by a reorg.
+func (s *Store) RevertSettlement(index []byte) (retErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	commitmentKey, err := s.st.Get(indexToDigestKey(index))
+	if err != nil {
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	cmtBuf, err := s.st.Get(string(commitmentKey))
+	if err != nil {
+		return err
+	}
+
+	cmt := new(Commitment)
+	if err := msgpack.Unmarshal(cmtBuf, cmt); err != nil {
+		return err
+	}
+
+	if cmt.Status != CommitmentStatusSettled && cmt.Status != CommitmentStatusSlashed {
+		return nil
+	}
+
+	prevStatus := cmt.Status
+	cmt.Status = CommitmentStatusOpened
+
+	buf, err := msgpack.Marshal(cmt)
+	if err != nil {
+		return err
+	}
+
+	writer, write := s.batchWriter()
+	defer write(&retErr)
+
+	if err := writer.Put(string(commitmentKey), buf); err != nil {
+		return err
+	}
+
+	return updateStatusIndex(writer, cmt, prevStatus)
 }
 
 func (s *Store) UpdatePayment(digest []byte, payment, refund string) error {
@@ -450,6 +605,10 @@ func (s *Store) ClearCommitmentIndexes(uptoBlock int64) error {
 			if commitment.CommitmentIndex != nil {
 				keys = append(keys, indexToDigestKey(commitment.CommitmentIndex))
 			}
+			if sk := statusIndexKey(&commitment); sk != "" {
+				keys = append(keys, sk)
+			}
+			keys = append(keys, queryIndexKeys(&commitment)...)
 			return false
 		}
 		// DB is expected to be sorted by block number, so we can stop here
@@ -2,6 +2,10 @@ package store_test
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -9,6 +13,7 @@ import (
 	"github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
 	"github.com/primev/mev-commit/p2p/pkg/storage"
 	inmem "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	pebblestorage "github.com/primev/mev-commit/p2p/pkg/storage/pebble"
 )
 
 func TestStore_AddCommitment(t *testing.T) {
@@ -365,6 +370,70 @@ func TestStore_SetStatus(t *testing.T) {
 	}
 }
 
+func TestStore_AddOpenAttempt(t *testing.T) {
+	st := store.New(inmem.New())
+	digest := [32]byte{}
+	copy(digest[:], []byte("commitment"))
+
+	commitment := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: digest[:],
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				BlockNumber: 1,
+				BidAmount:   "100",
+			},
+		},
+	}
+	err := st.AddCommitment(commitment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = st.AddOpenAttempt(1, "100", digest[:], &store.OpenAttempt{
+		Time:  1,
+		Error: "nonce too low",
+	}, store.CommitmentStatusFailed, "failed to open commitment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = st.AddOpenAttempt(1, "100", digest[:], &store.OpenAttempt{
+		TxnHash: common.HexToHash("0x01").Hex(),
+		Time:    2,
+	}, store.CommitmentStatusOpened, "opened (attempt 2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundCommitment, err := st.GetCommitmentByDigest(digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if foundCommitment.Status != store.CommitmentStatusOpened {
+		t.Fatalf("expected status %s, got %s", store.CommitmentStatusOpened, foundCommitment.Status)
+	}
+	if len(foundCommitment.OpenAttempts) != 2 {
+		t.Fatalf("expected 2 open attempts, got %d", len(foundCommitment.OpenAttempts))
+	}
+	if foundCommitment.OpenAttempts[0].Error != "nonce too low" {
+		t.Fatalf("expected first attempt error 'nonce too low', got '%s'", foundCommitment.OpenAttempts[0].Error)
+	}
+	if foundCommitment.OpenAttempts[1].TxnHash != common.HexToHash("0x01").Hex() {
+		t.Fatalf("unexpected second attempt txn hash %s", foundCommitment.OpenAttempts[1].TxnHash)
+	}
+
+	failed, _, err := st.QueryCommitments(&store.Query{
+		Statuses: []store.CommitmentStatus{store.CommitmentStatusFailed},
+	}, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 0 {
+		t.Fatalf("expected no failed commitments, got %d", len(failed))
+	}
+}
+
 func TestStore_UpdateSettlementSettled(t *testing.T) {
 	st := store.New(inmem.New())
 	digest := [32]byte{}
@@ -453,6 +522,65 @@ func TestStore_UpdateSettlementSlashed(t *testing.T) {
 	}
 }
 
+func TestStore_RevertSettlement(t *testing.T) {
+	st := store.New(inmem.New())
+	digest := [32]byte{}
+	copy(digest[:], []byte("commitment"))
+
+	index := [32]byte{}
+	copy(index[:], []byte("index"))
+
+	commitment := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: digest[:],
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				BlockNumber: 1,
+				BidAmount:   "100",
+			},
+		},
+	}
+	err := st.AddCommitment(commitment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = st.SetCommitmentIndexByDigest(digest, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = st.UpdateSettlement(index[:], true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = st.RevertSettlement(index[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundCommitment, err := st.GetCommitmentByDigest(digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if foundCommitment.Status != store.CommitmentStatusOpened {
+		t.Fatalf("expected status %s, got %s", store.CommitmentStatusOpened, foundCommitment.Status)
+	}
+
+	slashed, _, err := st.QueryCommitments(&store.Query{
+		Statuses: []store.CommitmentStatus{store.CommitmentStatusSlashed},
+	}, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashed) != 0 {
+		t.Fatalf("expected no slashed commitments, got %d", len(slashed))
+	}
+}
+
 func TestStore_UpdatePayment(t *testing.T) {
 	st := store.New(inmem.New())
 	digest := [32]byte{}
@@ -536,3 +664,294 @@ func TestStore_GetAllCommitments(t *testing.T) {
 		t.Fatalf("expected 2 commitments, got %d", len(allCommitments))
 	}
 }
+
+func TestStore_QueryCommitments(t *testing.T) {
+	inmemstore := inmem.New()
+	st := store.New(inmemstore)
+
+	bidder := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	provider1 := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	provider2 := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	newCommitment := func(i int, provider common.Address, txHash string) *store.Commitment {
+		return &store.Commitment{
+			EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+				Commitment:        common.BigToHash(big.NewInt(int64(i))).Bytes(),
+				DispatchTimestamp: int64(1000 + i),
+			},
+			PreConfirmation: &preconfpb.PreConfirmation{
+				Bid: &preconfpb.Bid{
+					TxHash:      txHash,
+					BlockNumber: int64(10 + i/2),
+					BidAmount:   fmt.Sprintf("%d", 100+i),
+				},
+				ProviderAddress: provider.Bytes(),
+			},
+			BidderAddress: &bidder,
+			Status:        store.CommitmentStatusPending,
+		}
+	}
+
+	commitments := make([]*store.Commitment, 0, 10)
+	for i := range 10 {
+		provider := provider1
+		if i%2 == 1 {
+			provider = provider2
+		}
+		c := newCommitment(i, provider, fmt.Sprintf("%064x,%064x", i, 100+i))
+		if err := st.AddCommitment(c); err != nil {
+			t.Fatal(err)
+		}
+		commitments = append(commitments, c)
+	}
+
+	if err := st.SetStatus(
+		commitments[3].Bid.BlockNumber,
+		commitments[3].Bid.BidAmount,
+		commitments[3].Commitment,
+		store.CommitmentStatusFailed,
+		"failed",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	digests := func(cmts []*store.Commitment) []int {
+		idxs := make([]int, 0, len(cmts))
+		for _, c := range cmts {
+			idxs = append(idxs, int(new(big.Int).SetBytes(c.Commitment).Int64()))
+		}
+		return idxs
+	}
+
+	tests := []struct {
+		name  string
+		query *store.Query
+		want  []int
+	}{
+		{
+			name:  "all",
+			query: &store.Query{},
+			// ordered by block number and then by the bid amount descending
+			want: []int{1, 0, 3, 2, 5, 4, 7, 6, 9, 8},
+		},
+		{
+			name:  "status",
+			query: &store.Query{Statuses: []store.CommitmentStatus{store.CommitmentStatusFailed}},
+			want:  []int{3},
+		},
+		{
+			name: "multiple statuses",
+			query: &store.Query{
+				Statuses: []store.CommitmentStatus{store.CommitmentStatusFailed, store.CommitmentStatusPending},
+				ToBlock:  11,
+			},
+			want: []int{1, 0, 3, 2},
+		},
+		{
+			name:  "provider",
+			query: &store.Query{Counterparty: &provider2, FromBlock: 12},
+			want:  []int{5, 7, 9},
+		},
+		{
+			name:  "bidder",
+			query: &store.Query{Counterparty: &bidder, FromBlock: 13, ToBlock: 13},
+			want:  []int{7, 6},
+		},
+		{
+			name:  "tx hash",
+			query: &store.Query{TxHash: fmt.Sprintf("0x%064X", 104)},
+			want:  []int{4},
+		},
+		{
+			name:  "timestamp",
+			query: &store.Query{FromTimestamp: 1002, ToTimestamp: 1004},
+			want:  []int{3, 2, 4},
+		},
+		{
+			name:  "block range",
+			query: &store.Query{FromBlock: 11, ToBlock: 12},
+			want:  []int{3, 2, 5, 4},
+		},
+		{
+			name:  "no match",
+			query: &store.Query{FromBlock: 100},
+			want:  []int{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmts, next, err := st.QueryCommitments(tc.query, "", 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if next != "" {
+				t.Fatalf("expected no cursor, got %q", next)
+			}
+			if got := digests(cmts); !slices.Equal(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		var (
+			got    []int
+			cursor string
+			pages  int
+		)
+		for {
+			cmts, next, err := st.QueryCommitments(&store.Query{Counterparty: &provider1}, cursor, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, digests(cmts)...)
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		if want := []int{0, 2, 4, 6, 8}; !slices.Equal(got, want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		if pages != 3 {
+			t.Fatalf("expected 3 pages, got %d", pages)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, _, err := st.QueryCommitments(&store.Query{}, "!!", 10)
+		if !errors.Is(err, store.ErrInvalidCursor) {
+			t.Fatalf("expected invalid cursor error, got %v", err)
+		}
+	})
+
+	t.Run("clear indexes", func(t *testing.T) {
+		if err := st.ClearCommitmentIndexes(100); err != nil {
+			t.Fatal(err)
+		}
+		entries := 0
+		err := inmemstore.WalkPrefix(store.QueryIndexNS, func(_ string, _ []byte) bool {
+			entries++
+			return false
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entries != 0 {
+			t.Fatalf("expected 0 entries, got %d", entries)
+		}
+	})
+}
+
+func TestStore_BuildQueryIndexes(t *testing.T) {
+	inmemstore := inmem.New()
+	st := store.New(inmemstore)
+
+	commitment := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: []byte("commitment"),
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				BlockNumber: 1,
+				BidAmount:   "100",
+			},
+		},
+		Status: store.CommitmentStatusOpened,
+	}
+	if err := st.AddCommitment(commitment); err != nil {
+		t.Fatal(err)
+	}
+
+	// remove the indexes to simulate commitments stored by an older version
+	if err := inmemstore.DeletePrefix(store.QueryIndexNS); err != nil {
+		t.Fatal(err)
+	}
+
+	query := &store.Query{Statuses: []store.CommitmentStatus{store.CommitmentStatusOpened}}
+	cmts, _, err := st.QueryCommitments(query, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cmts) != 0 {
+		t.Fatalf("expected 0 commitments, got %d", len(cmts))
+	}
+
+	if err := st.BuildQueryIndexes(); err != nil {
+		t.Fatal(err)
+	}
+
+	cmts, _, err = st.QueryCommitments(query, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cmts) != 1 {
+		t.Fatalf("expected 1 commitment, got %d", len(cmts))
+	}
+}
+
+func TestStore_StatusUpdatesWithBatches(t *testing.T) {
+	db, err := pebblestorage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	st := store.New(db)
+	digest := [32]byte{}
+	copy(digest[:], []byte("commitment"))
+	index := [32]byte{}
+	copy(index[:], []byte("index"))
+
+	commitment := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: digest[:],
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				BlockNumber: 1,
+				BidAmount:   "100",
+			},
+		},
+		Status: store.CommitmentStatusPending,
+	}
+	if err := st.AddCommitment(commitment); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SetCommitmentIndexByDigest(digest, index); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SetStatus(1, "100", digest[:], store.CommitmentStatusOpened, "opened"); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.UpdateSettlement(index[:], false); err != nil {
+		t.Fatal(err)
+	}
+
+	for status, want := range map[store.CommitmentStatus]int{
+		store.CommitmentStatusPending: 0,
+		store.CommitmentStatusStored:  0,
+		store.CommitmentStatusOpened:  0,
+		store.CommitmentStatusSettled: 1,
+	} {
+		cmts, _, err := st.QueryCommitments(&store.Query{
+			Statuses: []store.CommitmentStatus{status},
+		}, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cmts) != want {
+			t.Fatalf("expected %d commitments with status %s, got %d", want, status, len(cmts))
+		}
+	}
+
+	found, err := st.GetCommitmentByDigest(digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Status != store.CommitmentStatusSettled {
+		t.Fatalf("expected status %s, got %s", store.CommitmentStatusSettled, found.Status)
+	}
+}
@@ -8,6 +8,7 @@ type metrics struct {
 	totalEncryptedCommitments      prometheus.Counter
 	totalCommitmentsToOpen         prometheus.Counter
 	totalOpenedCommitments         prometheus.Counter
+	totalOpenRetries               prometheus.Counter
 	blockCommitmentProcessDuration prometheus.Gauge
 }
 
@@ -31,6 +32,12 @@ func newMetrics() *metrics {
 			Name:      "total_opened_commitments",
 			Help:      "Total number of opened commitments",
 		}),
+		totalOpenRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "mev_commit",
+			Subsystem: "preconftracker",
+			Name:      "total_open_retries",
+			Help:      "Total number of retried commitment opens",
+		}),
 		blockCommitmentProcessDuration: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: "mev_commit",
 			Subsystem: "preconftracker",
@@ -45,6 +52,7 @@ func (m *metrics) Metrics() []prometheus.Collector {
 		m.totalEncryptedCommitments,
 		m.totalCommitmentsToOpen,
 		m.totalOpenedCommitments,
+		m.totalOpenRetries,
 		m.blockCommitmentProcessDuration,
 	}
 }
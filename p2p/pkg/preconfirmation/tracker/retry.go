@@ -0,0 +1,269 @@
+package preconftracker
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+)
+
+const defaultMaxOpenAttempts = 3
+
+var (
+	ErrCommitmentNotFound    = errors.New("commitment not found")
+	ErrCommitmentNotOpenable = errors.New("commitment cannot be opened")
+)
+
+// WithOpenRetries sets the maximum number of attempts to open a commitment
+// and the settlement window, the number of L1 blocks after the block of the
+// commitment during which it is opened and a failed open is retried. The
+// commitments of the older blocks are not opened anymore, as the oracle has
+// processed their block by then. Values below one keep the defaults.
+func WithOpenRetries(maxAttempts int, window int64) Option {
+	return func(t *Tracker) {
+		if maxAttempts > 0 {
+			t.maxOpenAttempts = maxAttempts
+		}
+		if window > 0 {
+			t.openWindow = window
+		}
+	}
+}
+
+// openWindowClosed returns true if the commitments of the block are not opened
+// anymore once the latest L1 block is recorded.
+func (t *Tracker) openWindowClosed(blockNumber, latest int64) bool {
+	return latest-blockNumber > t.openWindow
+}
+
+// openRetry is a commitment whose open failed and is attempted again once
+// the next L1 block is recorded by the BlockTracker.
+type openRetry struct {
+	commitment *store.Commitment
+	attempts   int
+	afterBlock int64
+}
+
+func (t *Tracker) triggerOpenRetries() {
+	select {
+	case t.triggerRetry <- struct{}{}:
+	default:
+	}
+}
+
+// scheduleOpenRetry schedules the retry of the failed open of the commitment
+// if attempts are left and the settlement window of its block is still open.
+// It returns false if the open is not retried.
+func (t *Tracker) scheduleOpenRetry(commitment *store.Commitment, attempts int) bool {
+	digest := hex.EncodeToString(commitment.Commitment)
+	latest := t.latestL1Block.Load()
+	switch {
+	case attempts >= t.maxOpenAttempts:
+		t.logger.Warn("giving up opening commitment", "commitmentDigest", digest, "attempts", attempts)
+		return false
+	// The retry is due with the next L1 block.
+	case t.openWindowClosed(commitment.Bid.BlockNumber, latest+1):
+		t.logger.Warn(
+			"settlement window closed, not retrying commitment open",
+			"commitmentDigest", digest,
+			"blockNumber", commitment.Bid.BlockNumber,
+			"latestBlock", latest,
+		)
+		return false
+	}
+
+	t.retryMu.Lock()
+	t.retries[digest] = &openRetry{
+		commitment: commitment,
+		attempts:   attempts,
+		afterBlock: latest,
+	}
+	t.retryMu.Unlock()
+	t.logger.Info("commitment open retry scheduled", "commitmentDigest", digest, "attempts", attempts)
+	return true
+}
+
+// restoreOpenRetries schedules again the retries of the failed opens found
+// in the store, as the schedule is lost when the node restarts. The retries
+// are due with the first L1 block recorded after the start, which also closes
+// the ones whose settlement window has passed in the meantime.
+func (t *Tracker) restoreOpenRetries() error {
+	var cursor string
+	for {
+		commitments, next, err := t.store.QueryCommitments(
+			&store.Query{Statuses: []store.CommitmentStatus{store.CommitmentStatusFailed}},
+			cursor,
+			0,
+		)
+		if err != nil {
+			return err
+		}
+
+		t.retryMu.Lock()
+		for _, c := range commitments {
+			// A commitment which failed to be stored has no open attempts.
+			if len(c.OpenAttempts) == 0 || len(c.OpenAttempts) >= t.maxOpenAttempts {
+				continue
+			}
+			t.retries[hex.EncodeToString(c.Commitment)] = &openRetry{
+				commitment: c,
+				attempts:   len(c.OpenAttempts),
+			}
+		}
+		t.retryMu.Unlock()
+
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	t.retryMu.Lock()
+	restored := len(t.retries)
+	t.retryMu.Unlock()
+	if restored > 0 {
+		t.logger.Info("commitment open retries restored", "count", restored)
+	}
+	return nil
+}
+
+// retryOpenCommitments retries the failed opens once a newer L1 block is
+// recorded, so that the new transaction is priced for the current block.
+func (t *Tracker) retryOpenCommitments(ctx context.Context) {
+	latest := t.latestL1Block.Load()
+
+	var due []*openRetry
+	t.retryMu.Lock()
+	for digest, r := range t.retries {
+		if r.afterBlock < latest {
+			due = append(due, r)
+			delete(t.retries, digest)
+		}
+	}
+	t.retryMu.Unlock()
+
+	for _, r := range due {
+		digest := hex.EncodeToString(r.commitment.Commitment)
+		if t.openWindowClosed(r.commitment.Bid.BlockNumber, latest) {
+			t.logger.Warn(
+				"settlement window closed, not retrying commitment open",
+				"commitmentDigest", digest,
+				"blockNumber", r.commitment.Bid.BlockNumber,
+				"latestBlock", latest,
+			)
+			t.recordOpen(r.commitment, false)
+			continue
+		}
+
+		// The commitment could be opened by the counterparty in the meantime.
+		commitment, err := t.store.GetCommitmentByDigest(r.commitment.Commitment)
+		if err != nil {
+			t.logger.Error("failed to get commitment", "commitmentDigest", digest, "error", err)
+			continue
+		}
+		if commitment.Status != store.CommitmentStatusFailed {
+			t.logger.Info("commitment no longer needs to be opened", "commitmentDigest", digest, "status", commitment.Status)
+			continue
+		}
+
+		if _, err := t.openAgain(ctx, commitment, r.attempts+1); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			t.logger.Error("failed to retry commitment open", "commitmentDigest", digest, "error", err)
+			if !t.scheduleOpenRetry(commitment, r.attempts+1) {
+				t.recordOpen(commitment, false)
+			}
+		}
+	}
+}
+
+func (t *Tracker) recordOpen(commitment *store.Commitment, opened bool) {
+	if t.openRecorder != nil {
+		t.openRecorder.OpenResult(common.BytesToAddress(commitment.ProviderAddress), opened)
+	}
+}
+
+// ReopenCommitment sends a new openCommitment transaction for the commitment
+// with the digest, regardless of the retry limits. It is used by operators to
+// open a commitment whose open failed.
+func (t *Tracker) ReopenCommitment(ctx context.Context, digest []byte) (common.Hash, error) {
+	commitment, err := t.store.GetCommitmentByDigest(digest)
+	if err != nil {
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return common.Hash{}, ErrCommitmentNotFound
+		}
+		return common.Hash{}, err
+	}
+
+	switch commitment.Status {
+	case store.CommitmentStatusStored, store.CommitmentStatusFailed:
+	default:
+		return common.Hash{}, fmt.Errorf("%w: status %s", ErrCommitmentNotOpenable, commitment.Status)
+	}
+	if commitment.CommitmentIndex == nil {
+		return common.Hash{}, fmt.Errorf("%w: commitment index not found", ErrCommitmentNotOpenable)
+	}
+
+	t.retryMu.Lock()
+	delete(t.retries, hex.EncodeToString(digest))
+	t.retryMu.Unlock()
+
+	txnHash, err := t.openAgain(ctx, commitment, len(commitment.OpenAttempts)+1)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	t.logger.Info("commitment reopened by operator", "commitmentDigest", hex.EncodeToString(digest), "txnHash", txnHash)
+	return txnHash, nil
+}
+
+// openAgain sends a new open transaction for the commitment. The gas
+// parameters are estimated for the current block instead of using the
+// configured defaults, which could be the reason the previous attempt failed.
+func (t *Tracker) openAgain(ctx context.Context, commitment *store.Commitment, attempt int) (common.Hash, error) {
+	opts, err := t.optsGetter(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get transact opts: %w", err)
+	}
+	fresh := *opts
+	fresh.GasLimit = 0
+	fresh.GasPrice = nil
+	fresh.GasTipCap = nil
+	fresh.GasFeeCap = nil
+
+	txn, err := t.sendOpenCommitment(&fresh, commitment)
+	if err != nil {
+		if ctx.Err() == nil {
+			if err := t.store.AddOpenAttempt(
+				commitment.Bid.BlockNumber,
+				commitment.Bid.BidAmount,
+				commitment.Commitment,
+				&store.OpenAttempt{Time: time.Now().Unix(), Error: err.Error()},
+				store.CommitmentStatusFailed,
+				fmt.Sprintf("failed to open commitment: %s (attempt %d)", err, attempt),
+			); err != nil {
+				t.logger.Error("failed to record open attempt", "error", err)
+			}
+		}
+		return common.Hash{}, err
+	}
+	t.metrics.totalOpenRetries.Inc()
+
+	select {
+	case <-ctx.Done():
+		return common.Hash{}, ctx.Err()
+	case t.statusUpdate <- statusUpdateTask{
+		commitment: commitment,
+		txnHash:    txn.Hash(),
+		nonce:      txn.Nonce(),
+		onSuccess:  store.CommitmentStatusOpened,
+		attempt:    attempt,
+	}:
+	}
+	return txn.Hash(), nil
+}
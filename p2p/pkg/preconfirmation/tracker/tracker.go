@@ -8,6 +8,8 @@ import (
 	"log/slog"
 	"math/big"
 	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -53,9 +55,20 @@ type Tracker struct {
 	processed       chan *oracle.OracleCommitmentProcessed
 	rewards         chan *bidderregistry.BidderregistryFundsRewarded
 	returns         chan *bidderregistry.BidderregistryFundsUnlocked
+	revertedCmts    chan *preconfcommstore.PreconfmanagerOpenedCommitmentStored
+	revertedProc    chan *oracle.OracleCommitmentProcessed
+	revertedRewards chan *bidderregistry.BidderregistryFundsRewarded
+	revertedReturns chan *bidderregistry.BidderregistryFundsUnlocked
 	statusUpdate    chan statusUpdateTask
 	blockOpened     chan int64
 	triggerOpen     chan struct{}
+	triggerRetry    chan struct{}
+	latestL1Block   atomic.Int64
+	maxOpenAttempts int
+	openWindow      int64
+	retryMu         sync.Mutex
+	retries         map[string]*openRetry
+	openRecorder    OpenRecorder
 	metrics         *metrics
 	logger          *slog.Logger
 }
@@ -76,8 +89,18 @@ type CommitmentStore interface {
 		status store.CommitmentStatus,
 		details string,
 	) error
+	AddOpenAttempt(
+		blockNumber int64,
+		bidAmt string,
+		commitmentDigest []byte,
+		attempt *store.OpenAttempt,
+		status store.CommitmentStatus,
+		details string,
+	) error
 	GetCommitmentByDigest(digest []byte) (*store.Commitment, error)
+	QueryCommitments(q *store.Query, cursor string, limit int) ([]*store.Commitment, string, error)
 	UpdateSettlement(index []byte, isSlash bool) error
+	RevertSettlement(index []byte) error
 	UpdatePayment(digest []byte, payment, refund string) error
 	ClearCommitmentIndexes(upto int64) error
 	AddWinner(winner *store.BlockWinner) error
@@ -96,6 +119,21 @@ type Watcher interface {
 	WatchTx(txnHash common.Hash, nonce uint64) <-chan txmonitor.Result
 }
 
+// OpenRecorder records whether the commitments of a provider could be opened.
+type OpenRecorder interface {
+	OpenResult(provider common.Address, opened bool)
+}
+
+type Option func(*Tracker)
+
+// WithOpenRecorder sets the recorder of the outcomes of the commitment opens.
+// A failed open is recorded once it is not retried anymore.
+func WithOpenRecorder(recorder OpenRecorder) Option {
+	return func(t *Tracker) {
+		t.openRecorder = recorder
+	}
+}
+
 func NewTracker(
 	chainID *big.Int,
 	peerType p2p.PeerType,
@@ -109,8 +147,9 @@ func NewTracker(
 	providerNikeSecretKey *fr.Element,
 	optsGetter OptsGetter,
 	logger *slog.Logger,
+	opts ...Option,
 ) *Tracker {
-	return &Tracker{
+	t := &Tracker{
 		ctxChainIDData:  []byte(fmt.Sprintf("mev-commit opening %s", chainID.String())),
 		peerType:        peerType,
 		self:            self,
@@ -128,12 +167,24 @@ func NewTracker(
 		processed:       make(chan *oracle.OracleCommitmentProcessed),
 		rewards:         make(chan *bidderregistry.BidderregistryFundsRewarded),
 		returns:         make(chan *bidderregistry.BidderregistryFundsUnlocked),
+		revertedCmts:    make(chan *preconfcommstore.PreconfmanagerOpenedCommitmentStored),
+		revertedProc:    make(chan *oracle.OracleCommitmentProcessed),
+		revertedRewards: make(chan *bidderregistry.BidderregistryFundsRewarded),
+		revertedReturns: make(chan *bidderregistry.BidderregistryFundsUnlocked),
 		statusUpdate:    make(chan statusUpdateTask),
 		blockOpened:     make(chan int64),
 		triggerOpen:     make(chan struct{}),
+		triggerRetry:    make(chan struct{}, 1),
+		maxOpenAttempts: defaultMaxOpenAttempts,
+		openWindow:      allowedDelayToOpenCommitment,
+		retries:         make(map[string]*openRetry),
 		metrics:         newMetrics(),
 		logger:          logger,
 	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
 }
 
 func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
@@ -141,18 +192,21 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 
 	eg, egCtx := errgroup.WithContext(ctx)
 
+	// A reorged NewL1Block is emitted again for the new chain and overwrites
+	// the stored winner, and the index of a reorged UnopenedCommitmentStored
+	// is derived from the commitment, so neither of them is reverted.
 	evts := []events.EventHandler{
 		events.NewChannelEventHandler(egCtx, "NewL1Block", t.newL1Blocks),
 		events.NewChannelEventHandler(egCtx, "UnopenedCommitmentStored", t.unopenedCmts),
-		events.NewChannelEventHandler(egCtx, "OpenedCommitmentStored", t.commitments),
-		events.NewChannelEventHandler(egCtx, "CommitmentProcessed", t.processed),
-		events.NewChannelEventHandler(egCtx, "FundsRewarded", t.rewards),
+		events.NewRevertibleChannelEventHandler(egCtx, "OpenedCommitmentStored", t.commitments, t.revertedCmts),
+		events.NewRevertibleChannelEventHandler(egCtx, "CommitmentProcessed", t.processed, t.revertedProc),
+		events.NewRevertibleChannelEventHandler(egCtx, "FundsRewarded", t.rewards, t.revertedRewards),
 	}
 
 	if t.peerType == p2p.PeerTypeBidder {
 		evts = append(
 			evts,
-			events.NewChannelEventHandler(egCtx, "FundsUnlocked", t.returns),
+			events.NewRevertibleChannelEventHandler(egCtx, "FundsUnlocked", t.returns, t.revertedReturns),
 		)
 	}
 
@@ -163,6 +217,10 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 		return doneChan
 	}
 
+	if err := t.restoreOpenRetries(); err != nil {
+		t.logger.Error("failed to restore commitment open retries", "error", err)
+	}
+
 	eg.Go(func() error {
 		defer sub.Unsubscribe()
 		select {
@@ -186,6 +244,7 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 					continue
 				}
 				t.triggerOpenCommitments()
+				t.triggerOpenRetries()
 			}
 		}
 	})
@@ -234,8 +293,8 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 			oldBlockNos := make([]int64, 0)
 			winners = slices.DeleteFunc(winners, func(item *store.BlockWinner) bool {
 				// the last block is the latest, so if any of the previous blocks are
-				// older than the allowed delay, we should not open the commitments
-				if winners[len(winners)-1].BlockNumber-item.BlockNumber > allowedDelayToOpenCommitment {
+				// outside of the settlement window, we should not open the commitments
+				if t.openWindowClosed(item.BlockNumber, winners[len(winners)-1].BlockNumber) {
 					oldBlockNos = append(oldBlockNos, item.BlockNumber)
 					return true
 				}
@@ -290,6 +349,11 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 					t.logger.Error("failed to update commitment index", "error", err)
 					continue
 				}
+			case cp := <-t.revertedProc:
+				if err := t.store.RevertSettlement(cp.CommitmentIndex[:]); err != nil {
+					t.logger.Error("failed to revert settlement", "error", err)
+					continue
+				}
 			}
 		}
 	})
@@ -305,6 +369,11 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 					t.logger.Error("failed to update payment", "error", err)
 					continue
 				}
+			case fr := <-t.revertedRewards:
+				if err := t.store.UpdatePayment(fr.CommitmentDigest[:], "", ""); err != nil {
+					t.logger.Error("failed to revert payment", "error", err)
+					continue
+				}
 			}
 		}
 	})
@@ -320,6 +389,23 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 					t.logger.Error("failed to handle opened commitment stored", "error", err)
 					continue
 				}
+			case cs := <-t.revertedCmts:
+				if err := t.revertOpenedCommitmentStored(cs); err != nil {
+					t.logger.Error("failed to revert opened commitment stored", "error", err)
+					continue
+				}
+			}
+		}
+	})
+
+	eg.Go(func() error {
+		for {
+			select {
+			case <-egCtx.Done():
+				t.logger.Info("retryOpenCommitments context done")
+				return nil
+			case <-t.triggerRetry:
+				t.retryOpenCommitments(egCtx)
 			}
 		}
 	})
@@ -344,6 +430,11 @@ func (t *Tracker) Start(ctx context.Context) <-chan struct{} {
 						t.logger.Error("failed to update payment", "error", err)
 						continue
 					}
+				case fr := <-t.revertedReturns:
+					if err := t.store.UpdatePayment(fr.CommitmentDigest[:], "", ""); err != nil {
+						t.logger.Error("failed to revert refund", "error", err)
+						continue
+					}
 				}
 			}
 		})
@@ -408,6 +499,10 @@ func (t *Tracker) handleNewL1Block(
 		"winner", newL1Block.Winner,
 	)
 
+	if blockNumber := newL1Block.BlockNumber.Int64(); blockNumber > t.latestL1Block.Load() {
+		t.latestL1Block.Store(blockNumber)
+	}
+
 	return t.store.AddWinner(&store.BlockWinner{
 		BlockNumber: newL1Block.BlockNumber.Int64(),
 		Winner:      newL1Block.Winner,
@@ -419,6 +514,8 @@ type statusUpdateTask struct {
 	txnHash    common.Hash
 	nonce      uint64
 	onSuccess  store.CommitmentStatus
+	// attempt is the number of the open attempt, starting from 1.
+	attempt int
 }
 
 func (t *Tracker) statusUpdater(
@@ -459,6 +556,9 @@ func (t *Tracker) statusUpdater(
 							status = store.CommitmentStatusOpened
 							details = fmt.Sprintf("opened by %s", t.peerType)
 						}
+						if task.attempt > 1 {
+							details = fmt.Sprintf("%s (attempt %d)", details, task.attempt)
+						}
 					}
 					t.logger.Info(
 						"commitment status update",
@@ -466,13 +566,33 @@ func (t *Tracker) statusUpdater(
 						"status", status,
 						"details", details,
 					)
-					if err := t.store.SetStatus(
-						task.commitment.Bid.BlockNumber,
-						task.commitment.Bid.BidAmount,
-						task.commitment.Commitment,
-						status,
-						details,
-					); err != nil {
+					var err error
+					if task.onSuccess == store.CommitmentStatusOpened {
+						attempt := &store.OpenAttempt{
+							TxnHash: task.txnHash.Hex(),
+							Time:    time.Now().Unix(),
+						}
+						if r.Err != nil {
+							attempt.Error = r.Err.Error()
+						}
+						err = t.store.AddOpenAttempt(
+							task.commitment.Bid.BlockNumber,
+							task.commitment.Bid.BidAmount,
+							task.commitment.Commitment,
+							attempt,
+							status,
+							details,
+						)
+					} else {
+						err = t.store.SetStatus(
+							task.commitment.Bid.BlockNumber,
+							task.commitment.Bid.BidAmount,
+							task.commitment.Commitment,
+							status,
+							details,
+						)
+					}
+					if err != nil {
 						t.logger.Error("failed to set status", "error", err)
 					}
 					if status == store.CommitmentStatusFailed {
@@ -496,13 +616,19 @@ func (t *Tracker) statusUpdater(
 								),
 							)
 						case store.CommitmentStatusOpened:
+							notificationPayload["attempt"] = task.attempt
 							t.notifier.Notify(
 								notifications.NewNotification(
 									notifications.TopicCommitmentOpenFailed,
 									notificationPayload,
 								),
 							)
+							if !t.scheduleOpenRetry(task.commitment, task.attempt) {
+								t.recordOpen(task.commitment, false)
+							}
 						}
+					} else if task.onSuccess == store.CommitmentStatusOpened {
+						t.recordOpen(task.commitment, true)
 					}
 				}
 				return nil
@@ -568,49 +694,13 @@ func (t *Tracker) openCommitments(
 		}
 		startTime := time.Now()
 
-		var commitmentIdx [32]byte
-		copy(commitmentIdx[:], commitment.CommitmentIndex[:])
-
-		bidAmt, ok := new(big.Int).SetString(commitment.Bid.BidAmount, 10)
-		if !ok {
-			t.logger.Error("failed to parse bid amount", "bidAmount", commitment.Bid.BidAmount)
-			continue
-		}
-
-		slashAmt, ok := new(big.Int).SetString(commitment.Bid.SlashAmount, 10)
-		if !ok {
-			t.logger.Error("failed to parse slash amount", "slashAmount", commitment.Bid.SlashAmount)
-			continue
-		}
-
 		opts, err := t.optsGetter(ctx)
 		if err != nil {
 			t.logger.Error("failed to get transact opts", "error", err)
 			continue
 		}
 
-		zkProof, err := t.generateZKProof(commitment)
-		if err != nil {
-			t.logger.Error("failed to generate ZK proof", "error", err)
-			continue
-		}
-
-		txn, err := t.preconfContract.OpenCommitment(
-			opts,
-			preconfcommstore.IPreconfManagerOpenCommitmentParams{
-				UnopenedCommitmentIndex: commitmentIdx,
-				BidAmt:                  bidAmt,
-				BlockNumber:             uint64(commitment.Bid.BlockNumber),
-				TxnHash:                 commitment.Bid.TxHash,
-				RevertingTxHashes:       commitment.Bid.RevertingTxHashes,
-				DecayStartTimeStamp:     uint64(commitment.Bid.DecayStartTimestamp),
-				DecayEndTimeStamp:       uint64(commitment.Bid.DecayEndTimestamp),
-				BidSignature:            commitment.Bid.Signature,
-				SlashAmt:                slashAmt,
-				ZkProof:                 zkProof,
-				BidOptions:              commitment.Bid.BidOptions,
-			},
-		)
+		txn, err := t.sendOpenCommitment(opts, commitment)
 		if err != nil {
 			t.logger.Error("failed to open commitment", "error", err)
 			continue
@@ -631,6 +721,7 @@ func (t *Tracker) openCommitments(
 			txnHash:    txn.Hash(),
 			nonce:      txn.Nonce(),
 			onSuccess:  store.CommitmentStatusOpened,
+			attempt:    1,
 		}:
 		}
 	}
@@ -658,6 +749,47 @@ func (t *Tracker) openCommitments(
 	return nil
 }
 
+// sendOpenCommitment sends the openCommitment transaction of the commitment.
+func (t *Tracker) sendOpenCommitment(
+	opts *bind.TransactOpts,
+	commitment *store.Commitment,
+) (*types.Transaction, error) {
+	var commitmentIdx [32]byte
+	copy(commitmentIdx[:], commitment.CommitmentIndex[:])
+
+	bidAmt, ok := new(big.Int).SetString(commitment.Bid.BidAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse bid amount %q", commitment.Bid.BidAmount)
+	}
+
+	slashAmt, ok := new(big.Int).SetString(commitment.Bid.SlashAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse slash amount %q", commitment.Bid.SlashAmount)
+	}
+
+	zkProof, err := t.generateZKProof(commitment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ZK proof: %w", err)
+	}
+
+	return t.preconfContract.OpenCommitment(
+		opts,
+		preconfcommstore.IPreconfManagerOpenCommitmentParams{
+			UnopenedCommitmentIndex: commitmentIdx,
+			BidAmt:                  bidAmt,
+			BlockNumber:             uint64(commitment.Bid.BlockNumber),
+			TxnHash:                 commitment.Bid.TxHash,
+			RevertingTxHashes:       commitment.Bid.RevertingTxHashes,
+			DecayStartTimeStamp:     uint64(commitment.Bid.DecayStartTimestamp),
+			DecayEndTimeStamp:       uint64(commitment.Bid.DecayEndTimestamp),
+			BidSignature:            commitment.Bid.Signature,
+			SlashAmt:                slashAmt,
+			ZkProof:                 zkProof,
+			BidOptions:              commitment.Bid.BidOptions,
+		},
+	)
+}
+
 func (t *Tracker) clearCommitments(ctx context.Context, blockOpened <-chan int64) error {
 	for {
 		select {
@@ -732,6 +864,36 @@ func (t *Tracker) handleOpenedCommitmentStored(
 	return nil
 }
 
+// revertOpenedCommitmentStored moves an opened commitment back to stored after
+// the open was removed from the chain by a reorg, so that it is opened again.
+func (t *Tracker) revertOpenedCommitmentStored(
+	cs *preconfcommstore.PreconfmanagerOpenedCommitmentStored,
+) error {
+	cmt, err := t.store.GetCommitmentByDigest(cs.CommitmentDigest[:])
+	if err != nil {
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get commitment by digest: %w", err)
+	}
+
+	if cmt.Status != store.CommitmentStatusOpened {
+		return nil
+	}
+
+	t.logger.Warn(
+		"opened commitment removed by reorg",
+		"commitmentDigest", hex.EncodeToString(cs.CommitmentDigest[:]),
+	)
+	return t.store.SetStatus(
+		cmt.Bid.BlockNumber,
+		cmt.Bid.BidAmount,
+		cs.CommitmentDigest[:],
+		store.CommitmentStatusStored,
+		"open removed by reorg",
+	)
+}
+
 func (t *Tracker) generateZKProof(
 	commitment *store.Commitment,
 ) ([]*big.Int, error) {
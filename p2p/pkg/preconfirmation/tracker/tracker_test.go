@@ -3,6 +3,7 @@ package preconftracker_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -705,6 +706,351 @@ func TestOtherProviderWonBlockNotification(t *testing.T) {
 	<-doneChan
 }
 
+func TestTrackerRetryOpenCommitment(t *testing.T) {
+	t.Parallel()
+
+	pcABI, err := abi.JSON(strings.NewReader(preconf.PreconfmanagerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	btABI, err := abi.JSON(strings.NewReader(blocktracker.BlocktrackerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	brABI, err := abi.JSON(strings.NewReader(bidderregistry.BidderregistryABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orABI, err := abi.JSON(strings.NewReader(oracle.OracleABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evtMgr := events.NewListener(
+		util.NewTestLogger(os.Stdout),
+		&btABI,
+		&pcABI,
+		&brABI,
+		&orABI,
+	)
+
+	st := store.New(inmemstorage.New())
+
+	contract := &testPreconfContract{
+		openedCommitments: make(chan openedCommitment, 10),
+		startNonce:        1,
+	}
+
+	watcher := &mockWatcher{}
+	watcher.failNonce.Store(1) // fail the first open transaction
+	notifier := &mockNotifier{
+		evt: make(chan *notifications.Notification, 10),
+	}
+
+	sk, pk, err := crypto.GenerateKeyPairBN254()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tracker := preconftracker.NewTracker(
+		big.NewInt(5),
+		p2p.PeerTypeProvider,
+		common.HexToAddress("0x1234"),
+		evtMgr,
+		st,
+		contract,
+		watcher,
+		notifier,
+		pk,
+		sk,
+		func(context.Context) (*bind.TransactOpts, error) {
+			return &bind.TransactOpts{
+				From:     common.HexToAddress("0x1234"),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+			}, nil
+		},
+		util.NewTestLogger(os.Stdout),
+		preconftracker.WithOpenRetries(2, 5),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneChan := tracker.Start(ctx)
+
+	provider := common.HexToAddress("0x1234")
+	bidderAddr := common.HexToAddress("0x3333")
+
+	_, pkBid, err := crypto.GenerateKeyPairBN254()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharedKey := crypto.DeriveSharedKey(sk, pkBid)
+
+	digest := common.HexToHash("0xabc")
+	cmt := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: digest.Bytes(),
+			Signature:  []byte("sig"),
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				TxHash:              common.HexToHash("0xdeadbeef").String(),
+				BidAmount:           "100",
+				SlashAmount:         "0",
+				BlockNumber:         1,
+				DecayStartTimestamp: 1,
+				DecayEndTimestamp:   2,
+				Digest:              digest.Bytes(),
+				Signature:           []byte("bidsig"),
+				NikePublicKey:       crypto.BN254PublicKeyToBytes(pkBid),
+			},
+			Digest:          digest.Bytes(),
+			Signature:       []byte("pcs"),
+			ProviderAddress: provider.Bytes(),
+			SharedSecret:    crypto.BN254PublicKeyToBytes(sharedKey),
+		},
+		BidderAddress: &bidderAddr,
+		BidAmount:     big.NewInt(100),
+	}
+
+	if err := tracker.TrackCommitment(context.Background(), cmt, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := publishUnopenedCommitment(evtMgr, &pcABI, preconf.PreconfmanagerUnopenedCommitmentStored{
+		Committer:           provider,
+		CommitmentIndex:     common.HexToHash("0x01"),
+		CommitmentDigest:    digest,
+		CommitmentSignature: cmt.EncryptedPreConfirmation.Signature,
+		DispatchTimestamp:   uint64(1),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	publishNewWinner(evtMgr, &btABI, blocktracker.BlocktrackerNewL1Block{
+		BlockNumber: big.NewInt(1),
+		Winner:      provider,
+	})
+
+	select {
+	case <-contract.openedCommitments:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the commitment to be opened")
+	}
+
+	select {
+	case n := <-notifier.evt:
+		if n.Topic() != notifications.TopicCommitmentOpenFailed {
+			t.Fatalf("expected topic %s, got %s", notifications.TopicCommitmentOpenFailed, n.Topic())
+		}
+		if got, ok := n.Value()["attempt"].(int); !ok || got != 1 {
+			t.Fatalf("expected attempt 1, got %v", n.Value()["attempt"])
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for TopicCommitmentOpenFailed notification")
+	}
+
+	// The failed open is retried on the next L1 block.
+	publishNewWinner(evtMgr, &btABI, blocktracker.BlocktrackerNewL1Block{
+		BlockNumber: big.NewInt(2),
+		Winner:      common.HexToAddress("0x5678"),
+	})
+
+	select {
+	case oc := <-contract.openedCommitments:
+		if !bytes.Equal(oc.encryptedCommitmentIndex[:], common.HexToHash("0x01").Bytes()) {
+			t.Fatalf("expected commitment index %x, got %x", common.HexToHash("0x01"), oc.encryptedCommitmentIndex)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the commitment to be opened again")
+	}
+
+	start := time.Now()
+	for {
+		c, err := st.GetCommitmentByDigest(digest.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Status == store.CommitmentStatusOpened {
+			if len(c.OpenAttempts) != 2 {
+				t.Fatalf("expected 2 open attempts, got %d", len(c.OpenAttempts))
+			}
+			if c.OpenAttempts[0].Error == "" || c.OpenAttempts[1].Error != "" {
+				t.Fatalf("unexpected open attempts %+v, %+v", c.OpenAttempts[0], c.OpenAttempts[1])
+			}
+			if !strings.HasSuffix(c.Details, "(attempt 2)") {
+				t.Fatalf("expected details of the second attempt, got %s", c.Details)
+			}
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("timeout waiting for the commitment to be opened, status %s", c.Status)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	_, err = tracker.ReopenCommitment(context.Background(), digest.Bytes())
+	if !errors.Is(err, preconftracker.ErrCommitmentNotOpenable) {
+		t.Fatalf("expected error %v, got %v", preconftracker.ErrCommitmentNotOpenable, err)
+	}
+	_, err = tracker.ReopenCommitment(context.Background(), common.HexToHash("0xdef").Bytes())
+	if !errors.Is(err, preconftracker.ErrCommitmentNotFound) {
+		t.Fatalf("expected error %v, got %v", preconftracker.ErrCommitmentNotFound, err)
+	}
+
+	cancel()
+	<-doneChan
+}
+
+func TestTrackerRestoreOpenRetries(t *testing.T) {
+	t.Parallel()
+
+	pcABI, err := abi.JSON(strings.NewReader(preconf.PreconfmanagerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	btABI, err := abi.JSON(strings.NewReader(blocktracker.BlocktrackerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	brABI, err := abi.JSON(strings.NewReader(bidderregistry.BidderregistryABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orABI, err := abi.JSON(strings.NewReader(oracle.OracleABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evtMgr := events.NewListener(
+		util.NewTestLogger(os.Stdout),
+		&btABI,
+		&pcABI,
+		&brABI,
+		&orABI,
+	)
+
+	sk, pk, err := crypto.GenerateKeyPairBN254()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pkBid, err := crypto.GenerateKeyPairBN254()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharedKey := crypto.DeriveSharedKey(sk, pkBid)
+
+	provider := common.HexToAddress("0x1234")
+	bidderAddr := common.HexToAddress("0x3333")
+	digest := common.HexToHash("0xabc")
+	cmt := &store.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: digest.Bytes(),
+			Signature:  []byte("sig"),
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				TxHash:              common.HexToHash("0xdeadbeef").String(),
+				BidAmount:           "100",
+				SlashAmount:         "0",
+				BlockNumber:         1,
+				DecayStartTimestamp: 1,
+				DecayEndTimestamp:   2,
+				Digest:              digest.Bytes(),
+				Signature:           []byte("bidsig"),
+				NikePublicKey:       crypto.BN254PublicKeyToBytes(pkBid),
+			},
+			Digest:          digest.Bytes(),
+			Signature:       []byte("pcs"),
+			ProviderAddress: provider.Bytes(),
+			SharedSecret:    crypto.BN254PublicKeyToBytes(sharedKey),
+		},
+		Status:        store.CommitmentStatusStored,
+		BidderAddress: &bidderAddr,
+		BidAmount:     big.NewInt(100),
+	}
+
+	// The store of a node which restarted after the first open failed.
+	st := store.New(inmemstorage.New())
+	if err := st.AddCommitment(cmt); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.SetCommitmentIndexByDigest(digest, common.HexToHash("0x01")); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AddOpenAttempt(
+		1,
+		"100",
+		digest.Bytes(),
+		&store.OpenAttempt{TxnHash: common.HexToHash("0x02").Hex(), Error: "reverted"},
+		store.CommitmentStatusFailed,
+		"failed to open commitment: reverted",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	contract := &testPreconfContract{
+		openedCommitments: make(chan openedCommitment, 10),
+		startNonce:        1,
+	}
+
+	tracker := preconftracker.NewTracker(
+		big.NewInt(5),
+		p2p.PeerTypeProvider,
+		provider,
+		evtMgr,
+		st,
+		contract,
+		&mockWatcher{},
+		&mockNotifier{evt: make(chan *notifications.Notification, 10)},
+		pk,
+		sk,
+		func(context.Context) (*bind.TransactOpts, error) {
+			return &bind.TransactOpts{From: provider}, nil
+		},
+		util.NewTestLogger(os.Stdout),
+		preconftracker.WithOpenRetries(3, 5),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneChan := tracker.Start(ctx)
+
+	publishNewWinner(evtMgr, &btABI, blocktracker.BlocktrackerNewL1Block{
+		BlockNumber: big.NewInt(2),
+		Winner:      common.HexToAddress("0x5678"),
+	})
+
+	select {
+	case oc := <-contract.openedCommitments:
+		if !bytes.Equal(oc.encryptedCommitmentIndex[:], common.HexToHash("0x01").Bytes()) {
+			t.Fatalf("expected commitment index %x, got %x", common.HexToHash("0x01"), oc.encryptedCommitmentIndex)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the restored retry to open the commitment")
+	}
+
+	start := time.Now()
+	for {
+		c, err := st.GetCommitmentByDigest(digest.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Status == store.CommitmentStatusOpened {
+			if len(c.OpenAttempts) != 2 {
+				t.Fatalf("expected 2 open attempts, got %d", len(c.OpenAttempts))
+			}
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("timeout waiting for the commitment to be opened, status %s", c.Status)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	cancel()
+	<-doneChan
+}
+
 type openedCommitment struct {
 	encryptedCommitmentIndex [32]byte
 	bid                      *big.Int
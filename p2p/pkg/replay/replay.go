@@ -0,0 +1,258 @@
+package replay
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	depositstore "github.com/primev/mev-commit/p2p/pkg/depositmanager/store"
+	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+	"github.com/primev/mev-commit/p2p/pkg/txnstore"
+)
+
+const (
+	EventBalance      = "balance"
+	EventCommitment   = "commitment"
+	EventBlockWinner  = "block_winner"
+	EventPendingTxn   = "pending_txn"
+	EventBlockSummary = "block_summary"
+)
+
+// Event is a single line of the timeline. Only the fields relevant to the
+// event type are set.
+type Event struct {
+	Type string `json:"type"`
+	// Timestamp is the time of the event in unix milliseconds. It is not set
+	// for the balances, which are a snapshot of the current state.
+	Timestamp        int64  `json:"timestamp,omitempty"`
+	BlockNumber      int64  `json:"block_number,omitempty"`
+	Bidder           string `json:"bidder,omitempty"`
+	Provider         string `json:"provider,omitempty"`
+	Winner           string `json:"winner,omitempty"`
+	TxnHash          string `json:"txn_hash,omitempty"`
+	BidAmount        string `json:"bid_amount,omitempty"`
+	SlashAmount      string `json:"slash_amount,omitempty"`
+	CommitmentDigest string `json:"commitment_digest,omitempty"`
+	CommitmentIndex  string `json:"commitment_index,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Details          string `json:"details,omitempty"`
+	Payment          string `json:"payment,omitempty"`
+	Refund           string `json:"refund,omitempty"`
+	Balance          string `json:"balance,omitempty"`
+	Nonce            uint64 `json:"nonce,omitempty"`
+}
+
+// BlockSummary reconstructs what happened to the bids for a block from the
+// final state of its commitments.
+type BlockSummary struct {
+	Type        string `json:"type"`
+	BlockNumber int64  `json:"block_number"`
+	Winner      string `json:"winner,omitempty"`
+	// Received is the number of bids for which a commitment was made.
+	Received int `json:"received"`
+	// Accepted is the number of commitments stored on-chain.
+	Accepted int `json:"accepted"`
+	Opened   int `json:"opened"`
+	Settled  int `json:"settled"`
+	Slashed  int `json:"slashed"`
+	Failed   int `json:"failed"`
+}
+
+type Options struct {
+	// FromBlock and ToBlock limit the replayed blocks. Zero values disable
+	// the corresponding bound. The balances and the pending transactions are
+	// not bound to a block and are always replayed.
+	FromBlock int64
+	ToBlock   int64
+}
+
+func (o *Options) contains(blockNumber int64) bool {
+	if o.FromBlock != 0 && blockNumber < o.FromBlock {
+		return false
+	}
+	if o.ToBlock != 0 && blockNumber > o.ToBlock {
+		return false
+	}
+	return true
+}
+
+// Replay walks the commitments, deposit balances, block winners and pending
+// transactions of the node storage. The timeline is written to w as JSON
+// lines ordered by time, followed by the summary of each block.
+func Replay(st storage.Storage, opts *Options, w io.Writer) error {
+	if opts == nil {
+		opts = new(Options)
+	}
+
+	timeline, summaries, err := build(st, opts)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	for _, e := range timeline {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	for _, s := range summaries {
+		if err := enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func build(st storage.Storage, opts *Options) ([]*Event, []*BlockSummary, error) {
+	var (
+		timeline  []*Event
+		summaries = make(map[int64]*BlockSummary)
+		// lastDispatch is the time of the last commitment of each block. The
+		// winner is only known after the commitments for the block were made.
+		lastDispatch = make(map[int64]int64)
+	)
+
+	summary := func(blockNumber int64) *BlockSummary {
+		s, found := summaries[blockNumber]
+		if !found {
+			s = &BlockSummary{Type: EventBlockSummary, BlockNumber: blockNumber}
+			summaries[blockNumber] = s
+		}
+		return s
+	}
+
+	balances, err := depositstore.New(st).Balances()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read balances: %w", err)
+	}
+	for bidder, balance := range balances {
+		timeline = append(timeline, &Event{
+			Type:    EventBalance,
+			Bidder:  bidder.Hex(),
+			Balance: balance.String(),
+		})
+	}
+
+	cmtStore := preconfstore.New(st)
+	err = cmtStore.WalkCommitments(func(c *preconfstore.Commitment) bool {
+		if c.PreConfirmation == nil || c.Bid == nil || !opts.contains(c.Bid.BlockNumber) {
+			return false
+		}
+		e := commitmentEvent(c)
+		timeline = append(timeline, e)
+		lastDispatch[e.BlockNumber] = max(lastDispatch[e.BlockNumber], e.Timestamp)
+		summary(e.BlockNumber).count(c.Status)
+		return false
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read commitments: %w", err)
+	}
+
+	winners, err := cmtStore.BlockWinners()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read block winners: %w", err)
+	}
+	for _, w := range winners {
+		if !opts.contains(w.BlockNumber) {
+			continue
+		}
+		timeline = append(timeline, &Event{
+			Type:        EventBlockWinner,
+			Timestamp:   lastDispatch[w.BlockNumber],
+			BlockNumber: w.BlockNumber,
+			Winner:      w.Winner.Hex(),
+		})
+		summary(w.BlockNumber).Winner = w.Winner.Hex()
+	}
+
+	txns, err := txnstore.New(st).PendingTxns()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read pending transactions: %w", err)
+	}
+	for _, txn := range txns {
+		timeline = append(timeline, &Event{
+			Type:      EventPendingTxn,
+			Timestamp: txn.Created * 1000,
+			TxnHash:   txn.Hash.Hex(),
+			Nonce:     txn.Nonce,
+		})
+	}
+
+	slices.SortStableFunc(timeline, compareEvents)
+
+	sorted := slices.Sorted(maps.Keys(summaries))
+	blocks := make([]*BlockSummary, 0, len(sorted))
+	for _, blockNumber := range sorted {
+		blocks = append(blocks, summaries[blockNumber])
+	}
+	return timeline, blocks, nil
+}
+
+func commitmentEvent(c *preconfstore.Commitment) *Event {
+	e := &Event{
+		Type:        EventCommitment,
+		Timestamp:   preconfstore.DispatchTimestamp(c),
+		BlockNumber: c.Bid.BlockNumber,
+		Provider:    common.BytesToAddress(c.ProviderAddress).Hex(),
+		TxnHash:     c.Bid.TxHash,
+		BidAmount:   c.Bid.BidAmount,
+		SlashAmount: c.Bid.SlashAmount,
+		Status:      string(c.Status),
+		Details:     c.Details,
+		Payment:     c.Payment,
+		Refund:      c.Refund,
+	}
+	if c.BidderAddress != nil {
+		e.Bidder = c.BidderAddress.Hex()
+	}
+	if c.EncryptedPreConfirmation != nil {
+		e.CommitmentDigest = common.Bytes2Hex(c.Commitment)
+		e.CommitmentIndex = common.Bytes2Hex(c.CommitmentIndex)
+	}
+	return e
+}
+
+// eventOrder orders the events with the same timestamp and block number, the
+// winner of a block is decided after its commitments are made.
+var eventOrder = map[string]int{
+	EventBalance:     0,
+	EventCommitment:  1,
+	EventBlockWinner: 2,
+	EventPendingTxn:  3,
+}
+
+func compareEvents(a, b *Event) int {
+	return cmp.Or(
+		cmp.Compare(a.Timestamp, b.Timestamp),
+		cmp.Compare(a.BlockNumber, b.BlockNumber),
+		cmp.Compare(eventOrder[a.Type], eventOrder[b.Type]),
+		cmp.Compare(a.Bidder, b.Bidder),
+		cmp.Compare(a.CommitmentDigest, b.CommitmentDigest),
+	)
+}
+
+func (s *BlockSummary) count(status preconfstore.CommitmentStatus) {
+	s.Received++
+	switch status {
+	case preconfstore.CommitmentStatusStored:
+		s.Accepted++
+	case preconfstore.CommitmentStatusOpened:
+		s.Accepted++
+		s.Opened++
+	case preconfstore.CommitmentStatusSettled:
+		s.Accepted++
+		s.Opened++
+		s.Settled++
+	case preconfstore.CommitmentStatusSlashed:
+		s.Accepted++
+		s.Opened++
+		s.Slashed++
+	case preconfstore.CommitmentStatusFailed:
+		s.Failed++
+	}
+}
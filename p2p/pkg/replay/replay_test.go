@@ -0,0 +1,154 @@
+package replay_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/go-cmp/cmp"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	depositstore "github.com/primev/mev-commit/p2p/pkg/depositmanager/store"
+	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/replay"
+	pebblestorage "github.com/primev/mev-commit/p2p/pkg/storage/pebble"
+	"github.com/primev/mev-commit/p2p/pkg/txnstore"
+)
+
+func commitment(
+	digest string,
+	blockNumber int64,
+	dispatch int64,
+	status preconfstore.CommitmentStatus,
+) *preconfstore.Commitment {
+	bidder := common.HexToAddress("0x1")
+	return &preconfstore.Commitment{
+		EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+			Commitment: []byte(digest),
+		},
+		PreConfirmation: &preconfpb.PreConfirmation{
+			Bid: &preconfpb.Bid{
+				TxHash:      "0xabc",
+				BidAmount:   "100",
+				SlashAmount: "10",
+				BlockNumber: blockNumber,
+			},
+			ProviderAddress:   common.HexToAddress("0x2").Bytes(),
+			DispatchTimestamp: dispatch,
+		},
+		Status:        status,
+		BidderAddress: &bidder,
+	}
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	st, err := pebblestorage.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmtStore := preconfstore.New(st)
+	for _, c := range []*preconfstore.Commitment{
+		commitment("c1", 10, 2000, preconfstore.CommitmentStatusSettled),
+		commitment("c2", 10, 1000, preconfstore.CommitmentStatusSlashed),
+		commitment("c3", 10, 3000, preconfstore.CommitmentStatusFailed),
+		commitment("c4", 9, 500, preconfstore.CommitmentStatusStored),
+		commitment("c5", 11, 4000, preconfstore.CommitmentStatusOpened),
+	} {
+		if err := cmtStore.AddCommitment(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cmtStore.AddWinner(&preconfstore.BlockWinner{
+		BlockNumber: 10,
+		Winner:      common.HexToAddress("0x2"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := depositstore.New(st).SetBalance(common.HexToAddress("0x1"), big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := txnstore.New(st).Save(context.Background(), common.HexToHash("0x3"), 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ro, err := pebblestorage.NewReadOnly(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := ro.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	if err := replay.Replay(ro, &replay.Options{FromBlock: 10, ToBlock: 10}, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		types     []string
+		summaries []replay.BlockSummary
+	)
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatal(err)
+		}
+		typ := line["type"].(string)
+		if typ == replay.EventBlockSummary {
+			var s replay.BlockSummary
+			if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+				t.Fatal(err)
+			}
+			summaries = append(summaries, s)
+			continue
+		}
+		if typ == replay.EventCommitment {
+			typ += "/" + line["status"].(string)
+		}
+		types = append(types, typ)
+	}
+
+	wantTypes := []string{
+		replay.EventBalance,
+		replay.EventCommitment + "/slashed",
+		replay.EventCommitment + "/settled",
+		replay.EventCommitment + "/failed",
+		replay.EventBlockWinner,
+		replay.EventPendingTxn,
+	}
+	if diff := cmp.Diff(wantTypes, types); diff != "" {
+		t.Fatalf("unexpected timeline (-want +got):\n%s", diff)
+	}
+
+	wantSummaries := []replay.BlockSummary{{
+		Type:        replay.EventBlockSummary,
+		BlockNumber: 10,
+		Winner:      common.HexToAddress("0x2").Hex(),
+		Received:    3,
+		Accepted:    2,
+		Opened:      2,
+		Settled:     1,
+		Slashed:     1,
+		Failed:      1,
+	}}
+	if diff := cmp.Diff(wantSummaries, summaries); diff != "" {
+		t.Fatalf("unexpected summaries (-want +got):\n%s", diff)
+	}
+
+	if err := ro.Put("key", []byte("value")); err == nil {
+		t.Fatal("expected write to read-only storage to fail")
+	}
+}
@@ -0,0 +1,7 @@
+package reputation
+
+import "github.com/primev/mev-commit/p2p/pkg/notifications"
+
+func (r *Reputation) HandleSettlement(n *notifications.Notification) {
+	r.handleSettlement(n)
+}
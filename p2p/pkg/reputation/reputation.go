@@ -0,0 +1,365 @@
+package reputation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/p2p/pkg/notifications"
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	statsNS   = "rep/"
+	settledNS = "rep-settled/"
+)
+
+var (
+	statsKey = func(provider common.Address) string {
+		return fmt.Sprintf("%s%s", statsNS, provider)
+	}
+	// settledKey marks a commitment whose settlement was recorded, with the
+	// outcome as the value, so that a settlement notified again after a reorg
+	// or a replay is not counted twice.
+	settledKey = func(commitmentIndex string) string {
+		return fmt.Sprintf("%s%s", settledNS, commitmentIndex)
+	}
+)
+
+const (
+	outcomeHonored byte = iota
+	outcomeSlashed
+)
+
+// Weights of the rates in the score of a provider. Honoring the commitments
+// matters the most to the bidder, as a slashed commitment means the
+// transaction was not included.
+const (
+	commitmentWeight = 0.3
+	openWeight       = 0.2
+	honoredWeight    = 0.5
+)
+
+// Stats are the statistics kept by the bidder node for a provider.
+type Stats struct {
+	Provider common.Address
+	// BidsSent is the number of bids sent to the provider.
+	BidsSent uint64
+	// Commitments is the number of commitments received for the bids.
+	Commitments uint64
+	// CommitLatency is the total time it took the provider to commit.
+	CommitLatency time.Duration
+	// OpensSucceeded and OpensFailed count the commitments of the blocks won
+	// by the provider which were or could not be opened.
+	OpensSucceeded uint64
+	OpensFailed    uint64
+	// Honored and Slashed count the settled commitments.
+	Honored uint64
+	Slashed uint64
+	// UpdatedAt is the unix time of the last update.
+	UpdatedAt int64
+}
+
+// smoothed returns the share of the successes in the total. The counts are
+// smoothed, so a provider without history has a rate of 1/2 and a single
+// outcome does not decide the rate.
+func smoothed(successes, total uint64) float64 {
+	return float64(successes+1) / float64(total+2)
+}
+
+// CommitmentRate returns the share of the bids the provider committed to.
+func (s *Stats) CommitmentRate() float64 {
+	return smoothed(s.Commitments, s.BidsSent)
+}
+
+// OpenSuccessRate returns the share of the commitments which were opened.
+func (s *Stats) OpenSuccessRate() float64 {
+	return smoothed(s.OpensSucceeded, s.OpensSucceeded+s.OpensFailed)
+}
+
+// HonoredRate returns the share of the settled commitments which were not
+// slashed.
+func (s *Stats) HonoredRate() float64 {
+	return smoothed(s.Honored, s.Honored+s.Slashed)
+}
+
+// AvgCommitLatency returns the average time it took the provider to commit.
+func (s *Stats) AvgCommitLatency() time.Duration {
+	if s.Commitments == 0 {
+		return 0
+	}
+	return s.CommitLatency / time.Duration(s.Commitments)
+}
+
+// Score returns the weighted average of the rates of the provider, between
+// 0 and 1. A provider without history scores 1/2.
+func (s *Stats) Score() float64 {
+	return commitmentWeight*s.CommitmentRate() +
+		openWeight*s.OpenSuccessRate() +
+		honoredWeight*s.HonoredRate()
+}
+
+// Reputation keeps the statistics of the providers in the node storage, so
+// that they survive restarts. The bids and commitments are recorded by the
+// preconfirmation protocol, the opens by the tracker and the settlements are
+// taken from the TopicTransactionSettled notifications. The updates of a
+// provider are serialized by a lock of its own, so that the bids sent to
+// different providers do not wait for each other.
+type Reputation struct {
+	locksMu  sync.Mutex
+	locks    map[common.Address]*sync.Mutex
+	st       storage.Storage
+	notifiee notifications.Notifiee
+	logger   *slog.Logger
+}
+
+func New(
+	st storage.Storage,
+	notifiee notifications.Notifiee,
+	logger *slog.Logger,
+) *Reputation {
+	return &Reputation{
+		locks:    make(map[common.Address]*sync.Mutex),
+		st:       st,
+		notifiee: notifiee,
+		logger:   logger,
+	}
+}
+
+func (r *Reputation) Start(ctx context.Context) <-chan struct{} {
+	doneChan := make(chan struct{})
+
+	notifCh := r.notifiee.Subscribe(notifications.TopicTransactionSettled)
+
+	go func() {
+		defer close(doneChan)
+		defer func() {
+			<-r.notifiee.Unsubscribe(notifCh)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				r.logger.Info("reputation context done")
+				return
+			case n, ok := <-notifCh:
+				if !ok {
+					return
+				}
+				r.handleSettlement(n)
+			}
+		}
+	}()
+
+	return doneChan
+}
+
+func (r *Reputation) handleSettlement(n *notifications.Notification) {
+	val := n.Value()
+	providerHex, ok := val["provider"].(string)
+	if !ok || !common.IsHexAddress(providerHex) {
+		r.logger.Error("invalid provider in settlement notification", "provider", val["provider"])
+		return
+	}
+	isSlashed, ok := val["is_slashed"].(bool)
+	if !ok {
+		r.logger.Error("invalid slash flag in settlement notification", "isSlashed", val["is_slashed"])
+		return
+	}
+	commitmentIndex, ok := val["commitment_index"].(string)
+	if !ok || commitmentIndex == "" {
+		r.logger.Error("invalid commitment index in settlement notification", "commitmentIndex", val["commitment_index"])
+		return
+	}
+
+	provider := common.HexToAddress(providerHex)
+	unlock := r.lock(provider)
+	defer unlock()
+
+	outcome := outcomeHonored
+	if isSlashed {
+		outcome = outcomeSlashed
+	}
+
+	prev, err := r.st.Get(settledKey(commitmentIndex))
+	switch {
+	case errors.Is(err, storage.ErrKeyNotFound):
+	case err != nil:
+		r.logger.Error("failed to get settled commitment", "commitmentIndex", commitmentIndex, "error", err)
+		return
+	case len(prev) == 1 && prev[0] == outcome:
+		r.logger.Debug("settlement already recorded", "provider", provider, "commitmentIndex", commitmentIndex)
+		return
+	}
+
+	r.updateLocked(provider, func(s *Stats) {
+		// A reorg can change the outcome of a recorded settlement.
+		if len(prev) == 1 {
+			switch {
+			case prev[0] == outcomeSlashed && s.Slashed > 0:
+				s.Slashed--
+			case prev[0] == outcomeHonored && s.Honored > 0:
+				s.Honored--
+			}
+		}
+		if isSlashed {
+			s.Slashed++
+		} else {
+			s.Honored++
+		}
+	})
+
+	if err := r.st.Put(settledKey(commitmentIndex), []byte{outcome}); err != nil {
+		r.logger.Error("failed to store settled commitment", "commitmentIndex", commitmentIndex, "error", err)
+	}
+}
+
+// BidSent records a bid sent to the provider.
+func (r *Reputation) BidSent(provider common.Address) {
+	r.update(provider, func(s *Stats) {
+		s.BidsSent++
+	})
+}
+
+// CommitmentReceived records a commitment of the provider received after the
+// latency.
+func (r *Reputation) CommitmentReceived(provider common.Address, latency time.Duration) {
+	r.update(provider, func(s *Stats) {
+		s.Commitments++
+		s.CommitLatency += latency
+	})
+}
+
+// OpenResult records the outcome of opening a commitment of the provider.
+// A failed open is only recorded once no more attempts are made.
+func (r *Reputation) OpenResult(provider common.Address, opened bool) {
+	r.update(provider, func(s *Stats) {
+		if opened {
+			s.OpensSucceeded++
+		} else {
+			s.OpensFailed++
+		}
+	})
+}
+
+// Score returns the score of the provider. A provider without history or
+// whose statistics are not available scores 1/2.
+func (r *Reputation) Score(provider common.Address) float64 {
+	return r.statsOrEmpty(provider).Score()
+}
+
+// CommitmentRate returns the share of the bids the provider committed to. A
+// provider without history or whose statistics are not available has a rate
+// of 1/2.
+func (r *Reputation) CommitmentRate(provider common.Address) float64 {
+	return r.statsOrEmpty(provider).CommitmentRate()
+}
+
+func (r *Reputation) statsOrEmpty(provider common.Address) *Stats {
+	stats, err := r.Stats(provider)
+	if err != nil {
+		r.logger.Error("failed to get provider stats", "provider", provider, "error", err)
+		return &Stats{Provider: provider}
+	}
+	return stats
+}
+
+// Stats returns the statistics of the provider. A provider without history
+// has zero statistics.
+func (r *Reputation) Stats(provider common.Address) (*Stats, error) {
+	return r.get(provider)
+}
+
+// AllStats returns the statistics of all the providers with history.
+func (r *Reputation) AllStats() ([]*Stats, error) {
+	var (
+		all     []*Stats
+		walkErr error
+	)
+	err := r.st.WalkPrefix(statsNS, func(key string, val []byte) bool {
+		if !common.IsHexAddress(strings.TrimPrefix(key, statsNS)) {
+			return false
+		}
+		stats := new(Stats)
+		if err := msgpack.Unmarshal(val, stats); err != nil {
+			walkErr = fmt.Errorf("failed to unmarshal stats %s: %w", key, err)
+			return true
+		}
+		all = append(all, stats)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if walkErr != nil {
+		return nil, walkErr
+	}
+	return all, nil
+}
+
+func (r *Reputation) get(provider common.Address) (*Stats, error) {
+	buf, err := r.st.Get(statsKey(provider))
+	switch {
+	case errors.Is(err, storage.ErrKeyNotFound):
+		return &Stats{Provider: provider}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	stats := new(Stats)
+	if err := msgpack.Unmarshal(buf, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// lock locks the statistics of the provider and returns the function
+// unlocking them.
+func (r *Reputation) lock(provider common.Address) func() {
+	r.locksMu.Lock()
+	l, found := r.locks[provider]
+	if !found {
+		l = new(sync.Mutex)
+		r.locks[provider] = l
+	}
+	r.locksMu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// update applies the change to the statistics of the provider. The
+// statistics are best effort, so the errors are only logged.
+func (r *Reputation) update(provider common.Address, change func(*Stats)) {
+	unlock := r.lock(provider)
+	defer unlock()
+
+	r.updateLocked(provider, change)
+}
+
+// updateLocked applies the change to the statistics of the provider, which
+// must be locked by the caller.
+func (r *Reputation) updateLocked(provider common.Address, change func(*Stats)) {
+	stats, err := r.get(provider)
+	if err != nil {
+		r.logger.Error("failed to get provider stats", "provider", provider, "error", err)
+		return
+	}
+	change(stats)
+	stats.UpdatedAt = time.Now().Unix()
+
+	buf, err := msgpack.Marshal(stats)
+	if err != nil {
+		r.logger.Error("failed to marshal provider stats", "provider", provider, "error", err)
+		return
+	}
+	if err := r.st.Put(statsKey(provider), buf); err != nil {
+		r.logger.Error("failed to store provider stats", "provider", provider, "error", err)
+	}
+}
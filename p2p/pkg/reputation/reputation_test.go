@@ -0,0 +1,153 @@
+package reputation_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/mev-commit/p2p/pkg/notifications"
+	"github.com/primev/mev-commit/p2p/pkg/reputation"
+	inmem "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	"github.com/primev/mev-commit/x/util"
+)
+
+func TestReputation(t *testing.T) {
+	t.Parallel()
+
+	st := inmem.New()
+	notifier := notifications.New(10)
+	t.Cleanup(notifier.Shutdown)
+
+	r := reputation.New(st, notifier, util.NewTestLogger(io.Discard))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := r.Start(ctx)
+
+	provider1 := common.HexToAddress("0x1")
+	provider2 := common.HexToAddress("0x2")
+
+	if score := r.Score(provider1); score != 0.5 {
+		t.Fatalf("expected score 0.5 without history, got %f", score)
+	}
+
+	for range 4 {
+		r.BidSent(provider1)
+		r.BidSent(provider2)
+	}
+	r.CommitmentReceived(provider1, 100*time.Millisecond)
+	r.CommitmentReceived(provider1, 300*time.Millisecond)
+	r.OpenResult(provider1, true)
+	r.OpenResult(provider2, false)
+
+	for i, slashed := range []bool{false, false, true} {
+		notifier.Notify(notifications.NewNotification(notifications.TopicTransactionSettled, map[string]any{
+			"transaction_hashes": "0xabc",
+			"is_slashed":         slashed,
+			"provider":           common.Bytes2Hex(provider1.Bytes()),
+			"commitment_index":   fmt.Sprintf("%02x", i),
+		}))
+	}
+
+	start := time.Now()
+	for {
+		stats, err := r.Stats(provider1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Honored+stats.Slashed == 3 {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("timeout waiting for the settlements to be recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	// The statistics are persisted in the storage.
+	r = reputation.New(st, notifier, util.NewTestLogger(io.Discard))
+
+	stats, err := r.Stats(provider1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.BidsSent != 4 ||
+		stats.Commitments != 2 ||
+		stats.OpensSucceeded != 1 ||
+		stats.Honored != 2 ||
+		stats.Slashed != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if stats.AvgCommitLatency() != 200*time.Millisecond {
+		t.Fatalf("expected average latency 200ms, got %s", stats.AvgCommitLatency())
+	}
+	if rate := stats.CommitmentRate(); rate != 0.5 {
+		t.Fatalf("expected commitment rate 0.5, got %f", rate)
+	}
+	if rate := r.CommitmentRate(provider2); rate != 1.0/6 {
+		t.Fatalf("expected commitment rate 1/6, got %f", rate)
+	}
+	if rate := stats.HonoredRate(); rate != 0.6 {
+		t.Fatalf("expected honored rate 0.6, got %f", rate)
+	}
+	// 0.3 * 0.5 + 0.2 * 2/3 + 0.5 * 0.6
+	if score := r.Score(provider1); math.Abs(score-(0.15+0.4/3+0.3)) > 1e-9 {
+		t.Fatalf("unexpected score %f", score)
+	}
+	if r.Score(provider2) >= r.Score(provider1) {
+		t.Fatalf("expected provider 2 to score lower than provider 1")
+	}
+
+	all, err := r.AllStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected stats of 2 providers, got %d", len(all))
+	}
+}
+
+func TestReputationSettlementReplay(t *testing.T) {
+	t.Parallel()
+
+	r := reputation.New(inmem.New(), notifications.New(10), util.NewTestLogger(io.Discard))
+	provider := common.HexToAddress("0x1")
+
+	settle := func(index string, slashed bool) {
+		r.HandleSettlement(notifications.NewNotification(notifications.TopicTransactionSettled, map[string]any{
+			"transaction_hashes": "0xabc",
+			"is_slashed":         slashed,
+			"provider":           common.Bytes2Hex(provider.Bytes()),
+			"commitment_index":   index,
+		}))
+	}
+
+	settle("01", false)
+	settle("02", false)
+	settle("03", true)
+	// replayed settlements are not counted again
+	settle("01", false)
+	settle("03", true)
+	// a reorg changes the outcome of a settlement
+	settle("02", true)
+	// settlements without a commitment index are ignored
+	r.HandleSettlement(notifications.NewNotification(notifications.TopicTransactionSettled, map[string]any{
+		"transaction_hashes": "0xabc",
+		"is_slashed":         false,
+		"provider":           common.Bytes2Hex(provider.Bytes()),
+	}))
+
+	stats, err := r.Stats(provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Honored != 1 || stats.Slashed != 2 {
+		t.Fatalf("expected 1 honored and 2 slashed, got %+v", stats)
+	}
+}
@@ -1,10 +1,12 @@
 package bidderapi
 
 import (
+	"cmp"
 	"context"
 	"encoding/hex"
 	"log/slog"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,7 +20,9 @@ import (
 	providerregistry "github.com/primev/mev-commit/contracts-abi/clients/ProviderRegistry"
 	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
 	preconfirmationv1 "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	"github.com/primev/mev-commit/p2p/pkg/preconfirmation"
 	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/reputation"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -42,6 +46,7 @@ type Service struct {
 	depositManager         DepositManagerContract
 	backend                Backend
 	depositManagerImplAddr common.Address
+	reputation             ReputationStore
 }
 
 func NewService(
@@ -59,6 +64,7 @@ func NewService(
 	depositManager DepositManagerContract,
 	backend Backend,
 	depositManagerImplAddr common.Address,
+	reputation ReputationStore,
 ) *Service {
 	return &Service{
 		owner:                  owner,
@@ -76,11 +82,16 @@ func NewService(
 		depositManager:         depositManager,
 		backend:                backend,
 		depositManagerImplAddr: depositManagerImplAddr,
+		reputation:             reputation,
 	}
 }
 
 type PreconfSender interface {
-	SendBid(ctx context.Context, bid *preconfirmationv1.Bid) (chan *preconfirmationv1.PreConfirmation, error)
+	SendBid(
+		ctx context.Context,
+		bid *preconfirmationv1.Bid,
+		strategy *preconfirmation.ProviderStrategy,
+	) (chan *preconfirmationv1.PreConfirmation, error)
 }
 
 type BidderRegistryContract interface {
@@ -128,6 +139,11 @@ type DepositManagerContract interface {
 	FilterTargetDepositSet(opts *bind.FilterOpts, providers []common.Address) (*depositmanager.DepositmanagerTargetDepositSetIterator, error)
 }
 
+type ReputationStore interface {
+	Stats(provider common.Address) (*reputation.Stats, error)
+	AllStats() ([]*reputation.Stats, error)
+}
+
 type Backend interface {
 	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -211,6 +227,18 @@ func (s *Service) SendBid(
 		}
 	}
 
+	var strategy *preconfirmation.ProviderStrategy
+	if bid.ProviderStrategy != nil {
+		strategy = &preconfirmation.ProviderStrategy{
+			MaxCommitments:     int(bid.ProviderStrategy.MaxCommitments),
+			WeightByAcceptance: bid.ProviderStrategy.WeightByAcceptance,
+			MinReputationScore: bid.ProviderStrategy.MinReputationScore,
+		}
+		for _, provider := range bid.ProviderStrategy.Providers {
+			strategy.Providers = append(strategy.Providers, common.HexToAddress(provider))
+		}
+	}
+
 	respC, err := s.sender.SendBid(
 		ctx,
 		&preconfirmationv1.Bid{
@@ -224,6 +252,7 @@ func (s *Service) SendBid(
 			RawTransactions:     bid.RawTransactions,
 			BidOptions:          optBuf,
 		},
+		strategy,
 	)
 	if err != nil {
 		s.logger.Error("sending bid", "error", err)
@@ -956,6 +985,64 @@ func (s *Service) GetValidProviders(
 	return &bidderapiv1.GetValidProvidersResponse{ValidProviders: validProviders}, nil
 }
 
+func (s *Service) GetProviderReputations(
+	ctx context.Context,
+	r *bidderapiv1.GetProviderReputationsRequest,
+) (*bidderapiv1.GetProviderReputationsResponse, error) {
+	err := s.validator.Validate(r)
+	if err != nil {
+		s.logger.Error("get provider reputations validation", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "validating get provider reputations request: %v", err)
+	}
+
+	if s.reputation == nil {
+		return nil, status.Error(codes.Unimplemented, "provider reputation not available")
+	}
+
+	var stats []*reputation.Stats
+	if len(r.Providers) > 0 {
+		for _, provider := range r.Providers {
+			st, err := s.reputation.Stats(common.HexToAddress(provider))
+			if err != nil {
+				s.logger.Error("getting provider stats", "provider", provider, "error", err)
+				return nil, status.Errorf(codes.Internal, "getting provider stats: %v", err)
+			}
+			stats = append(stats, st)
+		}
+	} else {
+		stats, err = s.reputation.AllStats()
+		if err != nil {
+			s.logger.Error("getting all provider stats", "error", err)
+			return nil, status.Errorf(codes.Internal, "getting all provider stats: %v", err)
+		}
+	}
+
+	slices.SortStableFunc(stats, func(a, b *reputation.Stats) int {
+		return cmp.Compare(b.Score(), a.Score())
+	})
+
+	reputations := make([]*bidderapiv1.ProviderReputation, 0, len(stats))
+	for _, st := range stats {
+		reputations = append(reputations, &bidderapiv1.ProviderReputation{
+			Provider:           st.Provider.Hex(),
+			BidsSent:           st.BidsSent,
+			Commitments:        st.Commitments,
+			CommitmentRate:     st.CommitmentRate(),
+			AvgCommitLatencyMs: st.AvgCommitLatency().Milliseconds(),
+			OpensSucceeded:     st.OpensSucceeded,
+			OpensFailed:        st.OpensFailed,
+			OpenSuccessRate:    st.OpenSuccessRate(),
+			Honored:            st.Honored,
+			Slashed:            st.Slashed,
+			HonoredRate:        st.HonoredRate(),
+			Score:              st.Score(),
+			UpdatedAt:          st.UpdatedAt,
+		})
+	}
+
+	return &bidderapiv1.GetProviderReputationsResponse{Reputations: reputations}, nil
+}
+
 func (s *Service) ClaimSlashedFunds(
 	ctx context.Context,
 	_ *bidderapiv1.EmptyMessage,
@@ -23,11 +23,16 @@ import (
 	providerregistry "github.com/primev/mev-commit/contracts-abi/clients/ProviderRegistry"
 	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
 	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	"github.com/primev/mev-commit/p2p/pkg/preconfirmation"
 	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"github.com/primev/mev-commit/p2p/pkg/reputation"
 	bidderapi "github.com/primev/mev-commit/p2p/pkg/rpc/bidder"
+	inmem "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
 	"github.com/primev/mev-commit/x/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -50,6 +55,7 @@ type testSender struct {
 func (s *testSender) SendBid(
 	ctx context.Context,
 	b *preconfpb.Bid,
+	_ *preconfirmation.ProviderStrategy,
 ) (chan *preconfpb.PreConfirmation, error) {
 	s.bids = append(s.bids, bid{
 		txHex:    b.TxHash,
@@ -283,6 +289,7 @@ func startServerWithStore(t *testing.T, cs *testStore) bidderapiv1.BidderClient
 		nil,
 		nil,
 		common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		nil,
 	)
 
 	baseServer := grpc.NewServer()
@@ -1197,6 +1204,7 @@ func TestDepositManagerStatus(t *testing.T) {
 		dm,
 		be,
 		implAddr,
+		nil,
 	)
 
 	resp, err := svc.DepositManagerStatus(context.Background(), &bidderapiv1.DepositManagerStatusRequest{})
@@ -1221,3 +1229,108 @@ func TestDepositManagerStatus(t *testing.T) {
 		t.Fatalf("expected p2 amount 300, got %s", got[p2.Hex()])
 	}
 }
+
+func TestGetProviderReputations(t *testing.T) {
+	t.Parallel()
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		t.Fatalf("validator: %v", err)
+	}
+
+	good := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	bad := common.HexToAddress("0x0000000000000000000000000000000000000a02")
+
+	rep := reputation.New(inmem.New(), nil, util.NewTestLogger(io.Discard))
+	for range 3 {
+		rep.BidSent(good)
+		rep.BidSent(bad)
+		rep.CommitmentReceived(good, 50*time.Millisecond)
+	}
+	rep.OpenResult(bad, false)
+
+	newService := func(r bidderapi.ReputationStore) *bidderapi.Service {
+		return bidderapi.NewService(
+			common.HexToAddress("0x0000000000000000000000000000000000000b1d"),
+			nil, nil, nil,
+			validator,
+			nil,
+			nil,
+			nil,
+			15*time.Second,
+			util.NewTestLogger(io.Discard),
+			nil,
+			nil,
+			nil,
+			common.Address{},
+			r,
+		)
+	}
+
+	t.Run("not available", func(t *testing.T) {
+		_, err := newService(nil).GetProviderReputations(
+			context.Background(),
+			&bidderapiv1.GetProviderReputationsRequest{},
+		)
+		if status.Code(err) != codes.Unimplemented {
+			t.Fatalf("expected unimplemented error, got %v", err)
+		}
+	})
+
+	t.Run("all providers", func(t *testing.T) {
+		resp, err := newService(rep).GetProviderReputations(
+			context.Background(),
+			&bidderapiv1.GetProviderReputationsRequest{},
+		)
+		if err != nil {
+			t.Fatalf("GetProviderReputations error: %v", err)
+		}
+		if len(resp.Reputations) != 2 {
+			t.Fatalf("expected 2 reputations, got %d", len(resp.Reputations))
+		}
+		first := resp.Reputations[0]
+		if first.Provider != good.Hex() {
+			t.Fatalf("expected %s to be ranked first, got %s", good.Hex(), first.Provider)
+		}
+		if first.BidsSent != 3 || first.Commitments != 3 || first.AvgCommitLatencyMs != 50 {
+			t.Fatalf("unexpected reputation %v", first)
+		}
+		if resp.Reputations[1].OpensFailed != 1 {
+			t.Fatalf("expected 1 failed open, got %d", resp.Reputations[1].OpensFailed)
+		}
+		if first.Score <= resp.Reputations[1].Score {
+			t.Fatalf("expected reputations sorted by score")
+		}
+	})
+
+	t.Run("selected providers", func(t *testing.T) {
+		unknown := common.HexToAddress("0x0000000000000000000000000000000000000a03")
+		resp, err := newService(rep).GetProviderReputations(
+			context.Background(),
+			&bidderapiv1.GetProviderReputationsRequest{
+				Providers: []string{unknown.Hex()},
+			},
+		)
+		if err != nil {
+			t.Fatalf("GetProviderReputations error: %v", err)
+		}
+		if len(resp.Reputations) != 1 {
+			t.Fatalf("expected 1 reputation, got %d", len(resp.Reputations))
+		}
+		if resp.Reputations[0].BidsSent != 0 || resp.Reputations[0].Score != 0.5 {
+			t.Fatalf("unexpected reputation %v", resp.Reputations[0])
+		}
+	})
+
+	t.Run("invalid provider", func(t *testing.T) {
+		_, err := newService(rep).GetProviderReputations(
+			context.Background(),
+			&bidderapiv1.GetProviderReputationsRequest{
+				Providers: []string{"not-an-address"},
+			},
+		)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected invalid argument error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,292 @@
+package commitmentapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	commitmentapiv1 "github.com/primev/mev-commit/p2p/gen/go/commitmentapi/v1"
+	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+
+	// exportPageSize is the number of commitments read from the store at
+	// once during the export, so that the store is not locked for the whole
+	// duration of the stream.
+	exportPageSize = 1000
+)
+
+var (
+	txHashRegex = regexp.MustCompile(`^(0x)?[a-fA-F0-9]{64}$`)
+
+	validStatuses = []preconfstore.CommitmentStatus{
+		preconfstore.CommitmentStatusPending,
+		preconfstore.CommitmentStatusStored,
+		preconfstore.CommitmentStatusOpened,
+		preconfstore.CommitmentStatusSettled,
+		preconfstore.CommitmentStatusSlashed,
+		preconfstore.CommitmentStatusFailed,
+	}
+
+	csvHeader = []string{
+		"commitment_digest",
+		"commitment_index",
+		"bid_digest",
+		"bidder_address",
+		"provider_address",
+		"txn_hashes",
+		"revertable_txn_hashes",
+		"block_number",
+		"bid_amount",
+		"slash_amount",
+		"decay_start_timestamp",
+		"decay_end_timestamp",
+		"dispatch_timestamp",
+		"status",
+		"details",
+		"payment",
+		"refund",
+	}
+)
+
+type CommitmentStore interface {
+	QueryCommitments(
+		q *preconfstore.Query,
+		cursor string,
+		limit int,
+	) ([]*preconfstore.Commitment, string, error)
+}
+
+type Service struct {
+	commitmentapiv1.UnimplementedCommitmentsServer
+	store  CommitmentStore
+	logger *slog.Logger
+}
+
+func NewService(store CommitmentStore, logger *slog.Logger) *Service {
+	return &Service{
+		store:  store,
+		logger: logger,
+	}
+}
+
+func parseFilter(filter *commitmentapiv1.CommitmentFilter) (*preconfstore.Query, error) {
+	q := &preconfstore.Query{
+		FromBlock:     filter.GetFromBlock(),
+		ToBlock:       filter.GetToBlock(),
+		FromTimestamp: filter.GetFromTimestamp(),
+		ToTimestamp:   filter.GetToTimestamp(),
+	}
+
+	for _, s := range filter.GetStatuses() {
+		st := preconfstore.CommitmentStatus(strings.ToLower(s))
+		if !slices.Contains(validStatuses, st) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", s)
+		}
+		q.Statuses = append(q.Statuses, st)
+	}
+
+	if cp := filter.GetCounterparty(); cp != "" {
+		if !common.IsHexAddress(cp) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid counterparty: %s", cp)
+		}
+		addr := common.HexToAddress(cp)
+		q.Counterparty = &addr
+	}
+
+	if txHash := filter.GetTxHash(); txHash != "" {
+		if !txHashRegex.MatchString(txHash) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", txHash)
+		}
+		q.TxHash = txHash
+	}
+
+	switch {
+	case q.FromBlock < 0 || q.ToBlock < 0:
+		return nil, status.Error(codes.InvalidArgument, "block numbers must not be negative")
+	case q.ToBlock != 0 && q.FromBlock > q.ToBlock:
+		return nil, status.Error(codes.InvalidArgument, "from block is greater than to block")
+	case q.ToTimestamp != 0 && q.FromTimestamp > q.ToTimestamp:
+		return nil, status.Error(codes.InvalidArgument, "from timestamp is greater than to timestamp")
+	}
+
+	return q, nil
+}
+
+func toProto(c *preconfstore.Commitment) *commitmentapiv1.Commitment {
+	cmt := &commitmentapiv1.Commitment{
+		CommitmentDigest:    common.Bytes2Hex(c.Commitment),
+		CommitmentIndex:     common.Bytes2Hex(c.CommitmentIndex),
+		BidDigest:           common.Bytes2Hex(c.Bid.Digest),
+		ProviderAddress:     common.Bytes2Hex(c.ProviderAddress),
+		TxnHashes:           strings.Split(c.Bid.TxHash, ","),
+		BlockNumber:         c.Bid.BlockNumber,
+		BidAmount:           c.Bid.BidAmount,
+		SlashAmount:         c.Bid.SlashAmount,
+		DecayStartTimestamp: c.Bid.DecayStartTimestamp,
+		DecayEndTimestamp:   c.Bid.DecayEndTimestamp,
+		DispatchTimestamp:   preconfstore.DispatchTimestamp(c),
+		Status:              string(c.Status),
+		Details:             c.Details,
+		Payment:             c.Payment,
+		Refund:              c.Refund,
+	}
+	if c.BidderAddress != nil {
+		cmt.BidderAddress = common.Bytes2Hex(c.BidderAddress.Bytes())
+	}
+	if c.Bid.RevertingTxHashes != "" {
+		cmt.RevertableTxnHashes = strings.Split(c.Bid.RevertingTxHashes, ",")
+	}
+	return cmt
+}
+
+func (s *Service) QueryCommitments(
+	ctx context.Context,
+	req *commitmentapiv1.QueryCommitmentsRequest,
+) (*commitmentapiv1.QueryCommitmentsResponse, error) {
+	q, err := parseFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit: %d", req.Limit)
+	case limit == 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	cmts, next, err := s.store.QueryCommitments(q, req.Cursor, limit)
+	if err != nil {
+		if errors.Is(err, preconfstore.ErrInvalidCursor) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", req.Cursor)
+		}
+		s.logger.Error("querying commitments", "error", err)
+		return nil, status.Errorf(codes.Internal, "querying commitments: %v", err)
+	}
+
+	resp := &commitmentapiv1.QueryCommitmentsResponse{
+		Commitments: make([]*commitmentapiv1.Commitment, 0, len(cmts)),
+		NextCursor:  next,
+	}
+	for _, c := range cmts {
+		resp.Commitments = append(resp.Commitments, toProto(c))
+	}
+	return resp, nil
+}
+
+func (s *Service) ExportCommitments(
+	req *commitmentapiv1.ExportCommitmentsRequest,
+	stream commitmentapiv1.Commitments_ExportCommitmentsServer,
+) error {
+	q, err := parseFilter(req.Filter)
+	if err != nil {
+		return err
+	}
+
+	var (
+		contentType string
+		encode      func(*commitmentapiv1.Commitment) ([]byte, error)
+	)
+	switch req.Format {
+	case commitmentapiv1.ExportCommitmentsRequest_FORMAT_UNSPECIFIED,
+		commitmentapiv1.ExportCommitmentsRequest_FORMAT_CSV:
+		contentType = "text/csv"
+		encode = encodeCSV
+		header, err := csvLine(csvHeader)
+		if err != nil {
+			return status.Errorf(codes.Internal, "encoding header: %v", err)
+		}
+		if err := stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: header}); err != nil {
+			return err
+		}
+	case commitmentapiv1.ExportCommitmentsRequest_FORMAT_JSONL:
+		contentType = "application/x-ndjson"
+		encode = func(c *commitmentapiv1.Commitment) ([]byte, error) {
+			return protojson.Marshal(c)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid format: %v", req.Format)
+	}
+
+	cursor := ""
+	for {
+		cmts, next, err := s.store.QueryCommitments(q, cursor, exportPageSize)
+		if err != nil {
+			s.logger.Error("exporting commitments", "error", err)
+			return status.Errorf(codes.Internal, "exporting commitments: %v", err)
+		}
+		for _, c := range cmts {
+			data, err := encode(toProto(c))
+			if err != nil {
+				return status.Errorf(codes.Internal, "encoding commitment: %v", err)
+			}
+			if err := stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: data}); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		default:
+		}
+		cursor = next
+	}
+}
+
+func encodeCSV(c *commitmentapiv1.Commitment) ([]byte, error) {
+	return csvLine([]string{
+		c.CommitmentDigest,
+		c.CommitmentIndex,
+		c.BidDigest,
+		c.BidderAddress,
+		c.ProviderAddress,
+		strings.Join(c.TxnHashes, ","),
+		strings.Join(c.RevertableTxnHashes, ","),
+		strconv.FormatInt(c.BlockNumber, 10),
+		c.BidAmount,
+		c.SlashAmount,
+		strconv.FormatInt(c.DecayStartTimestamp, 10),
+		strconv.FormatInt(c.DecayEndTimestamp, 10),
+		strconv.FormatInt(c.DispatchTimestamp, 10),
+		c.Status,
+		c.Details,
+		c.Payment,
+		c.Refund,
+	})
+}
+
+// csvLine encodes a single CSV record without the line terminator, which is
+// added by the gateway after every message of the stream.
+func csvLine(record []string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,240 @@
+package commitmentapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	commitmentapiv1 "github.com/primev/mev-commit/p2p/gen/go/commitmentapi/v1"
+	preconfpb "github.com/primev/mev-commit/p2p/gen/go/preconfirmation/v1"
+	preconfstore "github.com/primev/mev-commit/p2p/pkg/preconfirmation/store"
+	commitmentapi "github.com/primev/mev-commit/p2p/pkg/rpc/commitments"
+	inmemstorage "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	"github.com/primev/mev-commit/x/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var (
+	bidder   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	provider = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func newStore(t *testing.T, count int) *preconfstore.Store {
+	t.Helper()
+
+	st := preconfstore.New(inmemstorage.New())
+	for i := range count {
+		cmt := &preconfstore.Commitment{
+			EncryptedPreConfirmation: &preconfpb.EncryptedPreConfirmation{
+				Commitment: common.BigToHash(big.NewInt(int64(i))).Bytes(),
+			},
+			PreConfirmation: &preconfpb.PreConfirmation{
+				Bid: &preconfpb.Bid{
+					TxHash:      fmt.Sprintf("%064x", i),
+					BidAmount:   "1000",
+					BlockNumber: int64(i + 1),
+				},
+				ProviderAddress:   provider.Bytes(),
+				DispatchTimestamp: int64(100 + i),
+			},
+			BidderAddress: &bidder,
+			Status:        preconfstore.CommitmentStatusStored,
+			Details:       "details, with comma",
+		}
+		if err := st.AddCommitment(cmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return st
+}
+
+func startServer(t *testing.T, st commitmentapi.CommitmentStore) commitmentapiv1.CommitmentsClient {
+	bufferSize := 1024 * 1024
+	lis := bufconn.Listen(bufferSize)
+
+	logger := util.NewTestLogger(os.Stdout)
+	srvImpl := commitmentapi.NewService(st, logger)
+
+	baseServer := grpc.NewServer()
+	commitmentapiv1.RegisterCommitmentsServer(baseServer, srvImpl)
+	srvStopped := make(chan struct{})
+	go func() {
+		defer close(srvStopped)
+
+		if err := baseServer.Serve(lis); err != nil {
+			// Ignore "use of closed network connection" error
+			if opErr, ok := err.(*net.OpError); !ok || !errors.Is(opErr.Err, net.ErrClosed) {
+				t.Logf("server stopped err: %v", err)
+			}
+		}
+	}()
+
+	// nolint:staticcheck
+	conn, err := grpc.DialContext(context.TODO(), "",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Errorf("error connecting to server: %v", err)
+	}
+
+	t.Cleanup(func() {
+		err := lis.Close()
+		if err != nil {
+			t.Errorf("error closing listener: %v", err)
+		}
+		baseServer.Stop()
+
+		<-srvStopped
+	})
+
+	return commitmentapiv1.NewCommitmentsClient(conn)
+}
+
+func TestQueryCommitments(t *testing.T) {
+	client := startServer(t, newStore(t, 5))
+
+	t.Run("pagination", func(t *testing.T) {
+		var (
+			blocks []int64
+			cursor string
+		)
+		for {
+			resp, err := client.QueryCommitments(context.Background(), &commitmentapiv1.QueryCommitmentsRequest{
+				Filter: &commitmentapiv1.CommitmentFilter{
+					Statuses:     []string{"stored"},
+					Counterparty: bidder.Hex(),
+					FromBlock:    2,
+				},
+				Limit:  2,
+				Cursor: cursor,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range resp.Commitments {
+				if c.BidderAddress != common.Bytes2Hex(bidder.Bytes()) {
+					t.Fatalf("unexpected bidder address: %s", c.BidderAddress)
+				}
+				if c.DispatchTimestamp != 100+c.BlockNumber-1 {
+					t.Fatalf("unexpected dispatch timestamp: %d", c.DispatchTimestamp)
+				}
+				blocks = append(blocks, c.BlockNumber)
+			}
+			if resp.NextCursor == "" {
+				break
+			}
+			cursor = resp.NextCursor
+		}
+		if fmt.Sprint(blocks) != "[2 3 4 5]" {
+			t.Fatalf("unexpected blocks: %v", blocks)
+		}
+	})
+
+	t.Run("tx hash", func(t *testing.T) {
+		resp, err := client.QueryCommitments(context.Background(), &commitmentapiv1.QueryCommitmentsRequest{
+			Filter: &commitmentapiv1.CommitmentFilter{
+				TxHash: fmt.Sprintf("0x%064x", 3),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Commitments) != 1 || resp.Commitments[0].BlockNumber != 4 {
+			t.Fatalf("unexpected commitments: %v", resp.Commitments)
+		}
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		for _, filter := range []*commitmentapiv1.CommitmentFilter{
+			{Statuses: []string{"unknown"}},
+			{Counterparty: "0x1234"},
+			{TxHash: "0x1234"},
+			{FromBlock: 10, ToBlock: 5},
+		} {
+			_, err := client.QueryCommitments(context.Background(), &commitmentapiv1.QueryCommitmentsRequest{
+				Filter: filter,
+			})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected invalid argument for %v, got %v", filter, err)
+			}
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := client.QueryCommitments(context.Background(), &commitmentapiv1.QueryCommitmentsRequest{
+			Cursor: "!!",
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected invalid argument, got %v", err)
+		}
+	})
+}
+
+func TestExportCommitments(t *testing.T) {
+	client := startServer(t, newStore(t, 3))
+
+	export := func(t *testing.T, format commitmentapiv1.ExportCommitmentsRequest_Format) []string {
+		t.Helper()
+
+		stream, err := client.ExportCommitments(context.Background(), &commitmentapiv1.ExportCommitmentsRequest{
+			Filter: &commitmentapiv1.CommitmentFilter{ToBlock: 2},
+			Format: format,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lines []string
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return lines
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, string(msg.Data))
+		}
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		lines := export(t, commitmentapiv1.ExportCommitmentsRequest_FORMAT_CSV)
+		if len(lines) != 3 {
+			t.Fatalf("expected 3 lines, got %d", len(lines))
+		}
+		if !strings.HasPrefix(lines[0], "commitment_digest,commitment_index,bid_digest") {
+			t.Fatalf("unexpected header: %s", lines[0])
+		}
+		if !strings.Contains(lines[1], `,stored,"details, with comma",`) {
+			t.Fatalf("unexpected row: %s", lines[1])
+		}
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		lines := export(t, commitmentapiv1.ExportCommitmentsRequest_FORMAT_JSONL)
+		if len(lines) != 2 {
+			t.Fatalf("expected 2 lines, got %d", len(lines))
+		}
+		for _, line := range lines {
+			var cmt map[string]any
+			if err := json.Unmarshal([]byte(line), &cmt); err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(line, "\n") || cmt["status"] != "stored" {
+				t.Fatalf("unexpected line: %s", line)
+			}
+		}
+	})
+}
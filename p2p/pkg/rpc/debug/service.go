@@ -2,11 +2,15 @@ package debugapi
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	debugapiv1 "github.com/primev/mev-commit/p2p/gen/go/debugapi/v1"
 	"github.com/primev/mev-commit/p2p/pkg/p2p"
+	preconftracker "github.com/primev/mev-commit/p2p/pkg/preconfirmation/tracker"
 	"github.com/primev/mev-commit/p2p/pkg/topology"
 	"github.com/primev/mev-commit/x/contracts/txmonitor"
 	"google.golang.org/grpc/codes"
@@ -20,6 +24,7 @@ type Service struct {
 	canceller Canceller
 	p2p       P2PService
 	topology  Topology
+	opener    CommitmentOpener
 }
 
 func NewService(
@@ -44,6 +49,18 @@ type Canceller interface {
 	CancelTx(ctx context.Context, txHash common.Hash) (common.Hash, error)
 }
 
+// CommitmentOpener opens the commitments whose open failed. It is only
+// available on the nodes tracking commitments.
+type CommitmentOpener interface {
+	ReopenCommitment(ctx context.Context, digest []byte) (common.Hash, error)
+}
+
+// SetCommitmentOpener sets the opener used by OpenCommitment. The tracker is
+// created after the debug service, so it is set once available.
+func (s *Service) SetCommitmentOpener(opener CommitmentOpener) {
+	s.opener = opener
+}
+
 type P2PService interface {
 	Self() map[string]interface{}
 	BlockedPeers() []p2p.BlockedPeerInfo
@@ -136,3 +153,28 @@ func (s *Service) CancelTransaction(
 
 	return &debugapiv1.CancelTransactionResponse{TxHash: cHash.Hex()}, nil
 }
+
+func (s *Service) OpenCommitment(
+	ctx context.Context,
+	req *debugapiv1.OpenCommitmentReq,
+) (*debugapiv1.OpenCommitmentResponse, error) {
+	if s.opener == nil {
+		return nil, status.Error(codes.Unimplemented, "commitments are not tracked by this node")
+	}
+	digest, err := hex.DecodeString(strings.TrimPrefix(req.CommitmentDigest, "0x"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "decoding commitment digest: %v", err)
+	}
+
+	txHash, err := s.opener.ReopenCommitment(ctx, digest)
+	switch {
+	case errors.Is(err, preconftracker.ErrCommitmentNotFound):
+		return nil, status.Errorf(codes.NotFound, "opening commitment: %v", err)
+	case errors.Is(err, preconftracker.ErrCommitmentNotOpenable):
+		return nil, status.Errorf(codes.FailedPrecondition, "opening commitment: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "opening commitment: %v", err)
+	}
+
+	return &debugapiv1.OpenCommitmentResponse{TxHash: txHash.Hex()}, nil
+}
@@ -1,17 +1,22 @@
 package debugapi_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	debugapiv1 "github.com/primev/mev-commit/p2p/gen/go/debugapi/v1"
 	"github.com/primev/mev-commit/p2p/pkg/p2p"
+	preconftracker "github.com/primev/mev-commit/p2p/pkg/preconfirmation/tracker"
 	debugapi "github.com/primev/mev-commit/p2p/pkg/rpc/debug"
 	"github.com/primev/mev-commit/p2p/pkg/topology"
 	"github.com/primev/mev-commit/x/contracts/txmonitor"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockStore struct{}
@@ -37,6 +42,21 @@ func (m *mockCanceller) CancelTx(ctx context.Context, txHash common.Hash) (commo
 	return txHash, nil
 }
 
+type mockOpener struct {
+	digest []byte
+}
+
+func (m *mockOpener) ReopenCommitment(ctx context.Context, digest []byte) (common.Hash, error) {
+	switch {
+	case bytes.Equal(digest, m.digest):
+		return common.HexToHash("0x54321"), nil
+	case digest[0] == 0xff:
+		return common.Hash{}, fmt.Errorf("%w: status opened", preconftracker.ErrCommitmentNotOpenable)
+	default:
+		return common.Hash{}, preconftracker.ErrCommitmentNotFound
+	}
+}
+
 type mockP2PService struct{}
 
 func (m *mockP2PService) Self() map[string]interface{} {
@@ -162,3 +182,32 @@ func TestService_CancelTransaction(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, common.HexToHash("0x12345").String(), resp.TxHash)
 }
+
+func TestService_OpenCommitment(t *testing.T) {
+	service := debugapi.NewService(&mockStore{}, &mockCanceller{}, &mockP2PService{}, &mockTopology{})
+
+	ctx := context.Background()
+	digest := common.HexToHash("0x0a0b")
+
+	_, err := service.OpenCommitment(ctx, &debugapiv1.OpenCommitmentReq{CommitmentDigest: digest.Hex()})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	service.SetCommitmentOpener(&mockOpener{digest: digest.Bytes()})
+
+	resp, err := service.OpenCommitment(ctx, &debugapiv1.OpenCommitmentReq{CommitmentDigest: digest.Hex()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("0x54321").String(), resp.TxHash)
+
+	_, err = service.OpenCommitment(ctx, &debugapiv1.OpenCommitmentReq{
+		CommitmentDigest: common.HexToHash("0x0c0d").Hex(),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.OpenCommitment(ctx, &debugapiv1.OpenCommitmentReq{
+		CommitmentDigest: common.HexToHash("0xff00000000000000000000000000000000000000000000000000000000000000").Hex(),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = service.OpenCommitment(ctx, &debugapiv1.OpenCommitmentReq{CommitmentDigest: "xyz"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -39,25 +39,59 @@ func (s *Service) Subscribe(
 	defer func() {
 		<-s.notifiee.Unsubscribe(notificationChan)
 	}()
+
+	// The subscription is created before the replay, so the notifications
+	// published in between are delivered from the channel and the ones
+	// already replayed are skipped using the sequence number.
+	lastSeq := req.GetResumeAfter()
+	if replayer, ok := s.notifiee.(notifications.Replayer); ok && req.ResumeAfter != nil {
+		err := replayer.Replay(lastSeq, topics, func(notification *notifications.Notification) error {
+			if err := s.send(stream, notification); err != nil {
+				return err
+			}
+			lastSeq = notification.Sequence()
+			return nil
+		})
+		if err != nil {
+			s.logger.Error("failed to replay notifications", "error", err, "resumeAfter", req.GetResumeAfter())
+			return status.Errorf(codes.Internal, "failed to replay notifications: %v", err)
+		}
+	}
+
 	for {
 		select {
 		case notification := <-notificationChan:
-			val, err := structpb.NewStruct(notification.Value())
-			if err != nil {
-				s.logger.Error("failed to convert notification value to structpb.Value", "error", err, "notification", notification)
+			// Only the replayable topics are sequenced.
+			if notification.Sequence() != 0 && notification.Sequence() <= lastSeq {
 				continue
 			}
-			err = stream.Send(&notificationsapiv1.Notification{
-				Topic: string(notification.Topic()),
-				Value: val,
-			})
-			if err != nil {
-				s.logger.Error("failed to send notification", "error", err, "notification", notification)
+			if err := s.send(stream, notification); err != nil {
 				return err
 			}
-			s.logger.Debug("sent notification", "notification", notification)
 		case <-stream.Context().Done():
 			return nil
 		}
 	}
 }
+
+func (s *Service) send(
+	stream notificationsapiv1.Notifications_SubscribeServer,
+	notification *notifications.Notification,
+) error {
+	val, err := structpb.NewStruct(notification.Value())
+	if err != nil {
+		s.logger.Error("failed to convert notification value to structpb.Value", "error", err, "notification", notification)
+		return nil
+	}
+	err = stream.Send(&notificationsapiv1.Notification{
+		Topic:    string(notification.Topic()),
+		Value:    val,
+		Sequence: notification.Sequence(),
+	})
+	if err != nil {
+		s.logger.Error("failed to send notification", "error", err, "notification", notification)
+		return err
+	}
+	s.logger.Debug("sent notification", "notification", notification)
+	return nil
+}
@@ -6,14 +6,17 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	notificationsapiv1 "github.com/primev/mev-commit/p2p/gen/go/notificationsapi/v1"
 	"github.com/primev/mev-commit/p2p/pkg/notifications"
 	notificationsapi "github.com/primev/mev-commit/p2p/pkg/rpc/notifications"
+	inmemstorage "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
 	"github.com/primev/mev-commit/x/util"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 )
 
 type testNotifiee struct {
@@ -31,7 +34,7 @@ func (n *testNotifiee) Unsubscribe(ch chan *notifications.Notification) <-chan s
 	return done
 }
 
-func startServer(t *testing.T, n *testNotifiee) notificationsapiv1.NotificationsClient {
+func startServer(t *testing.T, n notifications.Notifiee) notificationsapiv1.NotificationsClient {
 	bufferSize := 1024 * 1024
 	lis := bufconn.Listen(bufferSize)
 
@@ -133,3 +136,84 @@ func TestSubscribe(t *testing.T) {
 		t.Errorf("expected context canceled error, got %v", err)
 	}
 }
+
+func TestSubscribeResume(t *testing.T) {
+	t.Parallel()
+
+	n, err := notifications.NewWithStore(10, inmemstorage.New(), time.Hour, util.NewTestLogger(os.Stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(n.Shutdown)
+
+	client := startServer(t, n)
+
+	for i := range 4 {
+		n.Notify(notifications.NewNotification(
+			notifications.TopicProviderSlashed,
+			map[string]any{"index": float64(i)},
+		))
+	}
+	n.Notify(notifications.NewNotification(
+		notifications.TopicPeerConnected,
+		map[string]any{"peer_id": "1234"},
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &notificationsapiv1.SubscribeRequest{
+		Topics:      []string{string(notifications.TopicProviderSlashed)},
+		ResumeAfter: proto.Uint64(2),
+	})
+	if err != nil {
+		t.Fatalf("error subscribing: %v", err)
+	}
+
+	for _, want := range []uint64{3, 4} {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("error receiving notification: %v", err)
+		}
+		if resp.Sequence != want {
+			t.Fatalf("expected sequence %d, got %d", want, resp.Sequence)
+		}
+		if resp.Topic != string(notifications.TopicProviderSlashed) {
+			t.Errorf("unexpected topic %q", resp.Topic)
+		}
+		if got := resp.Value.Fields["index"].GetNumberValue(); got != float64(want-1) {
+			t.Errorf("expected index %d, got %v", want-1, got)
+		}
+	}
+
+	// The sequence numbers start at 1, so resuming after 0 replays everything.
+	all, err := client.Subscribe(ctx, &notificationsapiv1.SubscribeRequest{
+		Topics:      []string{string(notifications.TopicProviderSlashed)},
+		ResumeAfter: proto.Uint64(0),
+	})
+	if err != nil {
+		t.Fatalf("error subscribing: %v", err)
+	}
+	for _, want := range []uint64{1, 2, 3, 4} {
+		resp, err := all.Recv()
+		if err != nil {
+			t.Fatalf("error receiving notification: %v", err)
+		}
+		if resp.Sequence != want {
+			t.Fatalf("expected sequence %d, got %d", want, resp.Sequence)
+		}
+	}
+
+	n.Notify(notifications.NewNotification(
+		notifications.TopicProviderSlashed,
+		map[string]any{"index": float64(4)},
+	))
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("error receiving notification: %v", err)
+	}
+	if resp.Sequence != 5 {
+		t.Fatalf("expected sequence 5, got %d", resp.Sequence)
+	}
+}
@@ -9,10 +9,15 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/holiman/uint256"
 	"github.com/primev/mev-commit/x/keysigner"
 )
 
+// setCodeAuthMagic is the prefix of the EIP-7702 authorization signing hash.
+const setCodeAuthMagic = 0x05
+
 type SetCodeHelper struct {
 	logger  *slog.Logger
 	signer  keysigner.KeySigner
@@ -50,13 +55,6 @@ func (s *SetCodeHelper) SetCode(
 		return nil, fmt.Errorf("gas limit is required")
 	}
 
-	pk, err := s.signer.GetPrivateKey()
-	if err != nil {
-		s.logger.Error("error getting private key from keysigner", "error", err)
-		return nil, err
-	}
-	defer s.signer.ZeroPrivateKey(pk)
-
 	nonce, err := s.backend.PendingNonceAt(ctx, s.signer.GetAddress())
 	if err != nil {
 		s.logger.Error("error getting pending nonce", "error", err)
@@ -69,13 +67,13 @@ func (s *SetCodeHelper) SetCode(
 		ChainID: *uint256.MustFromBig(s.chainID),
 	}
 
-	signedAuth, err := types.SignSetCode(pk, auth)
+	signedAuth, err := s.signAuthorization(auth)
 	if err != nil {
 		s.logger.Error("error signing set code authorization", "error", err)
 		return nil, err
 	}
 
-	txdata := &types.SetCodeTx{
+	tx := types.NewTx(&types.SetCodeTx{
 		ChainID:    uint256.MustFromBig(s.chainID),
 		Nonce:      nonce,
 		GasTipCap:  uint256.MustFromBig(opts.GasTipCap),
@@ -86,10 +84,9 @@ func (s *SetCodeHelper) SetCode(
 		Data:       nil,
 		AccessList: nil,
 		AuthList:   []types.SetCodeAuthorization{signedAuth},
-	}
+	})
 
-	signer := types.NewPragueSigner(s.chainID)
-	signedTx, err := types.SignNewTx(pk, signer, txdata)
+	signedTx, err := s.signer.SignTx(tx, s.chainID)
 	if err != nil {
 		s.logger.Error("error signing transaction", "error", err)
 		return nil, err
@@ -102,3 +99,24 @@ func (s *SetCodeHelper) SetCode(
 
 	return signedTx, nil
 }
+
+// signAuthorization signs the authorization through the key signer so that
+// the private key is not required. The signing hash is computed as in
+// EIP-7702: keccak256(MAGIC || rlp([chain_id, address, nonce])).
+func (s *SetCodeHelper) signAuthorization(auth types.SetCodeAuthorization) (types.SetCodeAuthorization, error) {
+	enc, err := rlp.EncodeToBytes([]any{auth.ChainID, auth.Address, auth.Nonce})
+	if err != nil {
+		return types.SetCodeAuthorization{}, err
+	}
+	sig, err := keysigner.SignKeccak256(s.signer, append([]byte{setCodeAuthMagic}, enc...))
+	if err != nil {
+		return types.SetCodeAuthorization{}, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return types.SetCodeAuthorization{}, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	auth.R.SetBytes(sig[:32])
+	auth.S.SetBytes(sig[32:64])
+	auth.V = sig[crypto.RecoveryIDOffset]
+	return auth, nil
+}
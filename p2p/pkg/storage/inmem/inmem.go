@@ -48,3 +48,15 @@ func (s *inmemStorage) WalkPrefix(prefix string, fn func(key string, val []byte)
 	})
 	return nil
 }
+
+// WalkPrefixFrom walks the whole prefix as the radix tree cannot seek, the
+// keys before the start key are skipped.
+func (s *inmemStorage) WalkPrefixFrom(prefix, start string, fn func(key string, val []byte) bool) error {
+	s.Tree.WalkPrefix(prefix, func(k string, v interface{}) bool {
+		if k < start {
+			return false
+		}
+		return fn(k, v.([]byte))
+	})
+	return nil
+}
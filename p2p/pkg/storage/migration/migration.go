@@ -0,0 +1,108 @@
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+)
+
+// versionKey holds the schema version of the storage. A storage without the
+// key has version 0, which is the layout before the versioning was added.
+const versionKey = "schema/version"
+
+var (
+	ErrUnknownVersion = errors.New("storage schema version is newer than the supported version")
+	ErrInvalidOrder   = errors.New("migrations must have increasing versions starting at 1")
+)
+
+// Migration is a step changing the storage layout. Running it brings the
+// storage from the previous version to Version.
+type Migration struct {
+	Version uint64
+	Name    string
+	Migrate func(st storage.Storage) error
+}
+
+// Migrator runs the registered migrations against the storage and records
+// the schema version after each of them.
+type Migrator struct {
+	st         storage.Storage
+	migrations []Migration
+	logger     *slog.Logger
+}
+
+// New returns a migrator for the migrations, which must be ordered with the
+// versions 1, 2, 3 and so on.
+func New(st storage.Storage, migrations []Migration, logger *slog.Logger) (*Migrator, error) {
+	for i, m := range migrations {
+		if m.Version != uint64(i+1) {
+			return nil, fmt.Errorf("%w: migration %q has version %d", ErrInvalidOrder, m.Name, m.Version)
+		}
+	}
+	return &Migrator{
+		st:         st,
+		migrations: migrations,
+		logger:     logger,
+	}, nil
+}
+
+// LatestVersion returns the schema version after all the migrations run.
+func (m *Migrator) LatestVersion() uint64 {
+	return uint64(len(m.migrations))
+}
+
+// Version returns the current schema version of the storage.
+func (m *Migrator) Version() (uint64, error) {
+	buf, err := m.st.Get(versionKey)
+	switch {
+	case errors.Is(err, storage.ErrKeyNotFound):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	case len(buf) != 8:
+		return 0, fmt.Errorf("invalid %q length: got %d, want 8", versionKey, len(buf))
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+// Pending returns the migrations which have not run against the storage yet.
+func (m *Migrator) Pending() ([]Migration, error) {
+	version, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+	if version > m.LatestVersion() {
+		return nil, fmt.Errorf("%w: %d > %d", ErrUnknownVersion, version, m.LatestVersion())
+	}
+	return m.migrations[version:], nil
+}
+
+// Run runs the pending migrations in order. The version is recorded after
+// every migration, so a failed run is resumed from the failed migration.
+func (m *Migrator) Run() error {
+	pending, err := m.Pending()
+	if err != nil {
+		return err
+	}
+
+	for _, mig := range pending {
+		m.logger.Info("running storage migration", "version", mig.Version, "name", mig.Name)
+		if err := mig.Migrate(m.st); err != nil {
+			return fmt.Errorf("migration %d %q failed: %w", mig.Version, mig.Name, err)
+		}
+
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], mig.Version)
+		if err := m.st.Put(versionKey, b[:]); err != nil {
+			return fmt.Errorf("failed to record storage version %d: %w", mig.Version, err)
+		}
+	}
+
+	if len(pending) > 0 {
+		m.logger.Info("storage migrations completed", "version", m.LatestVersion())
+	}
+	return nil
+}
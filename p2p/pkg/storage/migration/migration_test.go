@@ -0,0 +1,99 @@
+package migration_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/primev/mev-commit/p2p/pkg/storage"
+	inmem "github.com/primev/mev-commit/p2p/pkg/storage/inmem"
+	"github.com/primev/mev-commit/p2p/pkg/storage/migration"
+	"github.com/primev/mev-commit/x/util"
+)
+
+func TestMigrator(t *testing.T) {
+	t.Parallel()
+
+	st := inmem.New()
+	logger := util.NewTestLogger(io.Discard)
+
+	var ran []uint64
+	step := func(version uint64, err error) migration.Migration {
+		return migration.Migration{
+			Version: version,
+			Name:    "step",
+			Migrate: func(st storage.Storage) error {
+				if err != nil {
+					return err
+				}
+				ran = append(ran, version)
+				return st.Put("key", []byte{byte(version)})
+			},
+		}
+	}
+
+	m, err := migration.New(st, []migration.Migration{step(1, nil)}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A later release adds two migrations, the second one fails.
+	errFailed := errors.New("failed")
+	m, err = migration.New(st, []migration.Migration{step(1, nil), step(2, nil), step(3, errFailed)}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, err := m.Pending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 || pending[0].Version != 2 {
+		t.Fatalf("unexpected pending migrations %v", pending)
+	}
+	if err := m.Run(); !errors.Is(err, errFailed) {
+		t.Fatalf("expected migration error, got %v", err)
+	}
+	if v, err := m.Version(); err != nil || v != 2 {
+		t.Fatalf("expected version 2, got %d, %v", v, err)
+	}
+
+	// The run resumes from the failed migration.
+	m, err = migration.New(st, []migration.Migration{step(1, nil), step(2, nil), step(3, nil)}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ran) != 3 || ran[0] != 1 || ran[1] != 2 || ran[2] != 3 {
+		t.Fatalf("unexpected migrations run %v", ran)
+	}
+	pending, err = m.Pending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending migrations, got %d", len(pending))
+	}
+
+	// An older release does not know the schema.
+	m, err = migration.New(st, []migration.Migration{step(1, nil)}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Run(); !errors.Is(err, migration.ErrUnknownVersion) {
+		t.Fatalf("expected unknown version error, got %v", err)
+	}
+}
+
+func TestMigratorInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	_, err := migration.New(inmem.New(), []migration.Migration{{Version: 2, Name: "step"}}, util.NewTestLogger(io.Discard))
+	if !errors.Is(err, migration.ErrInvalidOrder) {
+		t.Fatalf("expected invalid order error, got %v", err)
+	}
+}
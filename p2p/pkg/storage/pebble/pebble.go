@@ -8,7 +8,8 @@ import (
 )
 
 type pebbleStorage struct {
-	db *pebble.DB
+	db       *pebble.DB
+	readOnly bool
 }
 
 func New(path string) (*pebbleStorage, error) {
@@ -21,7 +22,23 @@ func New(path string) (*pebbleStorage, error) {
 	}, nil
 }
 
+// NewReadOnly opens an existing database without allowing any writes. It is
+// used by the tools inspecting the data directory of a node.
+func NewReadOnly(path string) (*pebbleStorage, error) {
+	db, err := pebble.Open(path, &pebble.Options{ReadOnly: true, ErrorIfNotExists: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleStorage{
+		db:       db,
+		readOnly: true,
+	}, nil
+}
+
 func (s *pebbleStorage) Close() error {
+	if s.readOnly {
+		return s.db.Close()
+	}
 	return errors.Join(s.db.Flush(), s.db.Close())
 }
 
@@ -61,6 +78,25 @@ func (s *pebbleStorage) WalkPrefix(prefix string, fn func(key string, val []byte
 	return nil
 }
 
+func (s *pebbleStorage) WalkPrefixFrom(prefix, start string, fn func(key string, val []byte) bool) error {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte(prefix),
+		UpperBound: upperBound([]byte(prefix)),
+	})
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer iter.Close()
+
+	for iter.SeekGE([]byte(start)); iter.Valid(); iter.Next() {
+		if fn(string(iter.Key()), iter.Value()) {
+			break
+		}
+	}
+	return nil
+}
+
 func (s *pebbleStorage) Put(key string, value []byte) error {
 	return s.db.Set([]byte(key), value, pebble.NoSync)
 }
@@ -15,6 +15,9 @@ type Reader interface {
 	Get(key string) ([]byte, error)
 	// WalkPrefix walks the values for the given prefix.
 	WalkPrefix(prefix string, fn func(key string, val []byte) bool) error
+	// WalkPrefixFrom walks the values for the given prefix starting from the
+	// first key which is greater than or equal to the start key.
+	WalkPrefixFrom(prefix, start string, fn func(key string, val []byte) bool) error
 }
 
 type Writer interface {
@@ -22,6 +22,9 @@ func RunStoreTests(t *testing.T, factory func() storage.Storage) {
 	t.Run("WalkPrefix", func(t *testing.T) {
 		TestWalkPrefix(t, factory())
 	})
+	t.Run("WalkPrefixFrom", func(t *testing.T) {
+		TestWalkPrefixFrom(t, factory())
+	})
 	t.Run("BatchOperations", func(t *testing.T) {
 		TestBatchOperations(t, factory())
 	})
@@ -127,6 +130,39 @@ func TestWalkPrefix(t *testing.T, s storage.Storage) {
 	}
 }
 
+func TestWalkPrefixFrom(t *testing.T, s storage.Storage) {
+	keys := []string{
+		"walkFrom/key1",
+		"walkFrom/key2",
+		"walkFrom/key3",
+		"walkFromOther/key4",
+	}
+
+	for _, key := range keys {
+		err := s.Put(key, []byte(key))
+		if err != nil {
+			t.Fatalf("Setup failed, Put returned error: %v", err)
+		}
+	}
+
+	// Walk the prefix from the second key.
+	var foundKeys []string
+	err := s.WalkPrefixFrom("walkFrom/", "walkFrom/key2", func(key string, val []byte) bool {
+		if string(val) != key {
+			t.Errorf("Expected value %s, got %s", key, val)
+		}
+		foundKeys = append(foundKeys, key)
+		return false
+	})
+	if err != nil {
+		t.Fatalf("WalkPrefixFrom failed: %v", err)
+	}
+
+	if len(foundKeys) != 2 || foundKeys[0] != "walkFrom/key2" || foundKeys[1] != "walkFrom/key3" {
+		t.Fatalf("WalkPrefixFrom found unexpected keys: %v", foundKeys)
+	}
+}
+
 func TestBatchOperations(t *testing.T, s storage.Storage) {
 	if batcher, ok := s.(storage.Batcher); ok {
 		batch := batcher.Batch()
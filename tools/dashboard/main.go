@@ -54,6 +54,13 @@ var (
 		Value:   0,
 	}
 
+	optionConfirmations = &cli.Uint64Flag{
+		Name:    "confirmations",
+		Usage:   "number of blocks the events must be deep in the chain before they are processed",
+		EnvVars: []string{"DASHBOARD_CONFIRMATIONS"},
+		Value:   0,
+	}
+
 	optionLogFmt = &cli.StringFlag{
 		Name:    "log-fmt",
 		Usage:   "log format to use, options are 'text' or 'json'",
@@ -151,6 +158,7 @@ func main() {
 			optionRPCURL,
 			optionHTTPPort,
 			optionStartBlock,
+			optionConfirmations,
 			optionLogFmt,
 			optionLogLevel,
 			optionLogTags,
@@ -191,6 +199,7 @@ func main() {
 				logger,
 				settlementClient,
 				evtMgr,
+				publisher.WithConfirmations(c.Uint64(optionConfirmations.Name)),
 			)
 
 			dynSub, err := evtMgr.Subscribe(
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -144,7 +145,38 @@ func newStatHandler(evtMgr events.EventManager) (*statHandler, error) {
 	return st, nil
 }
 
+// count increments the counter, or decrements it when the event is reverted.
+func count(c *uint64, revert bool) {
+	switch {
+	case !revert:
+		*c++
+	case *c > 0:
+		*c--
+	}
+}
+
+// addAmount adds the amount to the decimal string, or subtracts it when the
+// event is reverted.
+func addAmount(current string, amount *big.Int, revert bool) string {
+	total, ok := big.NewInt(0).SetString(current, 10)
+	if !ok {
+		total = big.NewInt(0)
+	}
+	if revert {
+		return total.Sub(total, amount).String()
+	}
+	return total.Add(total, amount).String()
+}
+
+// revertible creates the handler of an event whose stats are undone when the
+// event is removed from the chain by a reorg.
+func revertible[T any](name string, handler func(revert bool) func(*T)) events.EventHandler {
+	return events.NewRevertibleEventHandler(name, handler(false), handler(true))
+}
+
 func (s *statHandler) configureDashboard() error {
+	// The winner of a reorged NewL1Block is overwritten when the event is
+	// emitted again, so it is not reverted.
 	handlers := []events.EventHandler{
 		events.NewEventHandler(
 			"NewL1Block",
@@ -166,104 +198,126 @@ func (s *statHandler) configureDashboard() error {
 				}
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"UnopenedCommitmentStored",
-			func(upd *preconf.PreconfmanagerUnopenedCommitmentStored) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				s.totalEncryptedCommitments++
-				provider, ok := s.providerStakes.Get(upd.Committer.Hex())
-				if !ok {
-					return
+			func(revert bool) func(*preconf.PreconfmanagerUnopenedCommitmentStored) {
+				return func(upd *preconf.PreconfmanagerUnopenedCommitmentStored) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					count(&s.totalEncryptedCommitments, revert)
+					provider, ok := s.providerStakes.Get(upd.Committer.Hex())
+					if !ok {
+						return
+					}
+					count(&provider.EncryptedCommitmentsCount, revert)
+					_ = s.providerStakes.Add(upd.Committer.Hex(), provider)
 				}
-				provider.EncryptedCommitmentsCount++
-				_ = s.providerStakes.Add(upd.Committer.Hex(), provider)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"OpenedCommitmentStored",
-			func(upd *preconf.PreconfmanagerOpenedCommitmentStored) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.blockStats.Get(upd.BlockNumber)
-				if !ok {
-					existing = &BlockStats{
-						Number: upd.BlockNumber,
+			func(revert bool) func(*preconf.PreconfmanagerOpenedCommitmentStored) {
+				return func(upd *preconf.PreconfmanagerOpenedCommitmentStored) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.blockStats.Get(upd.BlockNumber)
+					if !ok {
+						existing = &BlockStats{
+							Number: upd.BlockNumber,
+						}
 					}
-				}
-
-				existing.TotalOpenedCommitments++
-				s.totalOpenedCommitments++
-				_ = s.blockStats.Add(upd.BlockNumber, existing)
-				_ = s.commitments.Add(upd.CommitmentIndex, upd)
 
-				blockCommitments, ok := s.commitmentsByBlock.Get(upd.BlockNumber)
-				if !ok {
-					blockCommitments = make([]*preconf.PreconfmanagerOpenedCommitmentStored, 0)
-				}
-				blockCommitments = append(blockCommitments, upd)
-				_ = s.commitmentsByBlock.Add(upd.BlockNumber, blockCommitments)
+					count(&s.totalOpenedCommitments, revert)
+					blockCommitments, _ := s.commitmentsByBlock.Get(upd.BlockNumber)
+					if revert {
+						if existing.TotalOpenedCommitments > 0 {
+							existing.TotalOpenedCommitments--
+						}
+						_ = s.commitments.Remove(upd.CommitmentIndex)
+						blockCommitments = slices.DeleteFunc(
+							blockCommitments,
+							func(c *preconf.PreconfmanagerOpenedCommitmentStored) bool {
+								return c.CommitmentIndex == upd.CommitmentIndex
+							},
+						)
+					} else {
+						existing.TotalOpenedCommitments++
+						_ = s.commitments.Add(upd.CommitmentIndex, upd)
+						blockCommitments = append(blockCommitments, upd)
+					}
+					_ = s.blockStats.Add(upd.BlockNumber, existing)
+					_ = s.commitmentsByBlock.Add(upd.BlockNumber, blockCommitments)
 
-				p, ok := s.providerStakes.Get(upd.Committer.Hex())
-				if !ok {
-					return
-				}
-				p.OpenedCommitmentsCount++
-				_ = s.providerStakes.Add(upd.Committer.Hex(), p)
-				b, ok := s.bidderDeposits.Get(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Committer.Hex(),
-				})
-				if !ok {
-					return
-				}
-				for _, bidder := range b {
-					if bidder.Bidder == upd.Bidder.Hex() {
-						bidder.OpenCommitmentsCount++
-						break
+					p, ok := s.providerStakes.Get(upd.Committer.Hex())
+					if !ok {
+						return
+					}
+					count(&p.OpenedCommitmentsCount, revert)
+					_ = s.providerStakes.Add(upd.Committer.Hex(), p)
+					b, ok := s.bidderDeposits.Get(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Committer.Hex(),
+					})
+					if !ok {
+						return
 					}
+					for _, bidder := range b {
+						if bidder.Bidder == upd.Bidder.Hex() {
+							count(&bidder.OpenCommitmentsCount, revert)
+							break
+						}
+					}
+					_ = s.bidderDeposits.Add(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Committer.Hex(),
+					}, b)
 				}
-				_ = s.bidderDeposits.Add(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Committer.Hex(),
-				}, b)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"CommitmentProcessed",
-			func(upd *oracle.OracleCommitmentProcessed) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				cmt, ok := s.commitments.Get(upd.CommitmentIndex)
-				if !ok {
-					return
-				}
+			func(revert bool) func(*oracle.OracleCommitmentProcessed) {
+				return func(upd *oracle.OracleCommitmentProcessed) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					cmt, ok := s.commitments.Get(upd.CommitmentIndex)
+					if !ok {
+						return
+					}
 
-				existing, ok := s.blockStats.Get(cmt.BlockNumber)
-				if !ok {
-					existing = &BlockStats{
-						Number: cmt.BlockNumber,
+					existing, ok := s.blockStats.Get(cmt.BlockNumber)
+					if !ok {
+						existing = &BlockStats{
+							Number: cmt.BlockNumber,
+						}
 					}
-				}
 
-				if upd.IsSlash {
-					existing.TotalSlashes++
-					s.totalSlashes++
-				} else {
-					existing.TotalRewards++
-					s.totalRewards++
-				}
+					if upd.IsSlash {
+						if revert {
+							if existing.TotalSlashes > 0 {
+								existing.TotalSlashes--
+							}
+						} else {
+							existing.TotalSlashes++
+						}
+						count(&s.totalSlashes, revert)
+					} else {
+						if revert {
+							if existing.TotalRewards > 0 {
+								existing.TotalRewards--
+							}
+						} else {
+							existing.TotalRewards++
+						}
+						count(&s.totalRewards, revert)
+					}
 
-				currentAmount, ok := big.NewInt(0).SetString(existing.TotalAmount, 10)
-				if !ok {
-					currentAmount = big.NewInt(0)
+					existing.TotalAmount = addAmount(existing.TotalAmount, cmt.BidAmt, revert)
+					_ = s.blockStats.Add(cmt.BlockNumber, existing)
 				}
-				currentAmount = big.NewInt(0).Add(currentAmount, cmt.BidAmt)
-				existing.TotalAmount = currentAmount.String()
-				_ = s.blockStats.Add(cmt.BlockNumber, existing)
 			},
 		),
 		events.NewEventHandler(
@@ -283,283 +337,254 @@ func (s *statHandler) configureDashboard() error {
 				fmt.Println("ProviderRegistered", existing)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"FundsDeposited",
-			func(upd *providerregistry.ProviderregistryFundsDeposited) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.providerStakes.Get(upd.Provider.Hex())
-				if !ok {
-					return
-				}
-				currentStake, ok := big.NewInt(0).SetString(existing.Stake, 10)
-				if !ok {
-					return
+			func(revert bool) func(*providerregistry.ProviderregistryFundsDeposited) {
+				return func(upd *providerregistry.ProviderregistryFundsDeposited) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.providerStakes.Get(upd.Provider.Hex())
+					if !ok {
+						return
+					}
+					existing.Stake = addAmount(existing.Stake, upd.Amount, revert)
+					_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
 				}
-				currentStake = big.NewInt(0).Add(currentStake, upd.Amount)
-				existing.Stake = currentStake.String()
-				_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"FundsSlashed",
-			func(upd *providerregistry.ProviderregistryFundsSlashed) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.providerStakes.Get(upd.Provider.Hex())
-				if !ok {
-					return
-				}
-				currentStake, ok := big.NewInt(0).SetString(existing.Stake, 10)
-				if !ok {
-					return
+			func(revert bool) func(*providerregistry.ProviderregistryFundsSlashed) {
+				return func(upd *providerregistry.ProviderregistryFundsSlashed) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.providerStakes.Get(upd.Provider.Hex())
+					if !ok {
+						return
+					}
+					existing.Stake = addAmount(existing.Stake, upd.Amount, !revert)
+					count(&existing.SlashesCount, revert)
+					_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
 				}
-				currentStake = big.NewInt(0).Sub(currentStake, upd.Amount)
-				existing.Stake = currentStake.String()
-				existing.SlashesCount++
-				_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"FundsRewarded",
-			func(upd *bidderregistry.BidderregistryFundsRewarded) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.providerStakes.Get(upd.Provider.Hex())
-				if !ok {
-					return
-				}
-				currentRewards, ok := big.NewInt(0).SetString(existing.Rewards, 10)
-				if !ok {
-					currentRewards = big.NewInt(0)
-				}
-				currentRewards = big.NewInt(0).Add(currentRewards, upd.Amount)
-				existing.Rewards = currentRewards.String()
-				existing.RewardsCount++
-				_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
+			func(revert bool) func(*bidderregistry.BidderregistryFundsRewarded) {
+				return func(upd *bidderregistry.BidderregistryFundsRewarded) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.providerStakes.Get(upd.Provider.Hex())
+					if !ok {
+						return
+					}
+					existing.Rewards = addAmount(existing.Rewards, upd.Amount, revert)
+					count(&existing.RewardsCount, revert)
+					_ = s.providerStakes.Add(upd.Provider.Hex(), existing)
 
-				existingBidders, ok := s.bidderDeposits.Get(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				})
-				if !ok {
-					return
-				}
-				for _, b := range existingBidders {
-					if b.Bidder == upd.Bidder.Hex() {
-						currentSettled, ok := big.NewInt(0).SetString(b.Settled, 10)
-						if !ok {
-							currentSettled = big.NewInt(0)
+					existingBidders, ok := s.bidderDeposits.Get(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					})
+					if !ok {
+						return
+					}
+					for _, b := range existingBidders {
+						if b.Bidder == upd.Bidder.Hex() {
+							b.Settled = addAmount(b.Settled, upd.Amount, revert)
+							count(&b.SettledCount, revert)
+							break
 						}
-						currentSettled = big.NewInt(0).Add(currentSettled, upd.Amount)
-						b.Settled = currentSettled.String()
-						b.SettledCount++
-						break
 					}
+					_ = s.bidderDeposits.Add(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					}, existingBidders)
 				}
-				_ = s.bidderDeposits.Add(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				}, existingBidders)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"BidderDeposited",
-			func(upd *bidderregistry.BidderregistryBidderDeposited) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.bidderDeposits.Get(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				})
-				if !ok {
-					existing = make([]*BidderDeposit, 0)
-				}
+			func(revert bool) func(*bidderregistry.BidderregistryBidderDeposited) {
+				return func(upd *bidderregistry.BidderregistryBidderDeposited) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.bidderDeposits.Get(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					})
+					if !ok {
+						if revert {
+							return
+						}
+						existing = make([]*BidderDeposit, 0)
+					}
 
-				updated := false
-				for _, b := range existing {
-					if b.Bidder == upd.Bidder.Hex() {
-						b.AvailableAmount = upd.NewAvailableAmount.String()
-						b.DepositedCount++
-						updated = true
-						break
+					updated := false
+					for _, b := range existing {
+						if b.Bidder == upd.Bidder.Hex() {
+							if revert {
+								b.AvailableAmount = addAmount(upd.NewAvailableAmount.String(), upd.DepositedAmount, true)
+							} else {
+								b.AvailableAmount = upd.NewAvailableAmount.String()
+							}
+							count(&b.DepositedCount, revert)
+							updated = true
+							break
+						}
 					}
+					if !updated && !revert {
+						existing = append(existing, &BidderDeposit{
+							Bidder:          upd.Bidder.Hex(),
+							Provider:        upd.Provider.Hex(),
+							AvailableAmount: upd.NewAvailableAmount.String(),
+							DepositedCount:  1,
+						})
+					}
+					_ = s.bidderDeposits.Add(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					}, existing)
 				}
-				if !updated {
-					existing = append(existing, &BidderDeposit{
-						Bidder:          upd.Bidder.Hex(),
-						Provider:        upd.Provider.Hex(),
-						AvailableAmount: upd.NewAvailableAmount.String(),
-						DepositedCount:  1,
-					})
-				}
-				_ = s.bidderDeposits.Add(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				}, existing)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"FundsUnlocked",
-			func(upd *bidderregistry.BidderregistryFundsUnlocked) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.bidderDeposits.Get(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				})
-				if !ok {
-					return
-				}
+			func(revert bool) func(*bidderregistry.BidderregistryFundsUnlocked) {
+				return func(upd *bidderregistry.BidderregistryFundsUnlocked) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.bidderDeposits.Get(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					})
+					if !ok {
+						return
+					}
 
-				for _, b := range existing {
-					if b.Bidder == upd.Bidder.Hex() {
-						currentReturned, ok := big.NewInt(0).SetString(b.Refunds, 10)
-						if !ok {
-							currentReturned = big.NewInt(0)
+					for _, b := range existing {
+						if b.Bidder == upd.Bidder.Hex() {
+							b.Refunds = addAmount(b.Refunds, upd.Amount, revert)
+							count(&b.ReturnsCount, revert)
+							break
 						}
-						currentReturned = big.NewInt(0).Add(currentReturned, upd.Amount)
-						b.Refunds = currentReturned.String()
-						b.ReturnsCount++
-						break
 					}
+					_ = s.bidderDeposits.Add(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					}, existing)
 				}
-				_ = s.bidderDeposits.Add(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				}, existing)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"BidderWithdrawal",
-			func(upd *bidderregistry.BidderregistryBidderWithdrawal) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-
-				existing, ok := s.bidderDeposits.Get(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				})
-				if !ok {
-					return
-				}
+			func(revert bool) func(*bidderregistry.BidderregistryBidderWithdrawal) {
+				return func(upd *bidderregistry.BidderregistryBidderWithdrawal) {
+					s.statMu.Lock()
+					defer s.statMu.Unlock()
+
+					existing, ok := s.bidderDeposits.Get(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					})
+					if !ok {
+						return
+					}
 
-				for idx, b := range existing {
-					if b.Bidder == upd.Bidder.Hex() {
-						existing[idx].Withdrawn = upd.AmountWithdrawn.String()
-						existing[idx].AvailableAmount = "0"
-						break
+					for idx, b := range existing {
+						if b.Bidder == upd.Bidder.Hex() {
+							if revert {
+								// The whole available amount is withdrawn.
+								existing[idx].Withdrawn = "0"
+								existing[idx].AvailableAmount = upd.AmountWithdrawn.String()
+							} else {
+								existing[idx].Withdrawn = upd.AmountWithdrawn.String()
+								existing[idx].AvailableAmount = "0"
+							}
+							break
+						}
 					}
-				}
 
-				_ = s.bidderDeposits.Add(depositKey{
-					bidder:   upd.Bidder.Hex(),
-					provider: upd.Provider.Hex(),
-				}, existing)
+					_ = s.bidderDeposits.Add(depositKey{
+						bidder:   upd.Bidder.Hex(),
+						provider: upd.Provider.Hex(),
+					}, existing)
+				}
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"DepositToppedUp",
-			func(upd *depositmanager.DepositmanagerDepositToppedUp) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerDepositToppedUp) {
+				return func(upd *depositmanager.DepositmanagerDepositToppedUp) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.DepositToppedUp
+					}, revert)
 				}
-				c.DepositToppedUp++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"TopUpReduced",
-			func(upd *depositmanager.DepositmanagerTopUpReduced) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerTopUpReduced) {
+				return func(upd *depositmanager.DepositmanagerTopUpReduced) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.TopUpReduced
+					}, revert)
 				}
-				c.TopUpReduced++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"CurrentBalanceAtOrBelowMin",
-			func(upd *depositmanager.DepositmanagerCurrentBalanceAtOrBelowMin) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerCurrentBalanceAtOrBelowMin) {
+				return func(upd *depositmanager.DepositmanagerCurrentBalanceAtOrBelowMin) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.CurrentBalanceAtOrBelowMin
+					}, revert)
 				}
-				c.CurrentBalanceAtOrBelowMin++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"CurrentDepositIsSufficient",
-			func(upd *depositmanager.DepositmanagerCurrentDepositIsSufficient) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerCurrentDepositIsSufficient) {
+				return func(upd *depositmanager.DepositmanagerCurrentDepositIsSufficient) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.CurrentDepositIsSufficient
+					}, revert)
 				}
-				c.CurrentDepositIsSufficient++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"TargetDepositDoesNotExist",
-			func(upd *depositmanager.DepositmanagerTargetDepositDoesNotExist) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerTargetDepositDoesNotExist) {
+				return func(upd *depositmanager.DepositmanagerTargetDepositDoesNotExist) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.TargetDepositDoesNotExist
+					}, revert)
 				}
-				c.TargetDepositDoesNotExist++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-
-		events.NewEventHandler(
+		revertible(
 			"WithdrawalRequestExists",
-			func(upd *depositmanager.DepositmanagerWithdrawalRequestExists) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerWithdrawalRequestExists) {
+				return func(upd *depositmanager.DepositmanagerWithdrawalRequestExists) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.WithdrawalRequestExists
+					}, revert)
 				}
-				c.WithdrawalRequestExists++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
-		events.NewEventHandler(
+		revertible(
 			"TargetDepositSet",
-			func(upd *depositmanager.DepositmanagerTargetDepositSet) {
-				s.statMu.Lock()
-				defer s.statMu.Unlock()
-				b := upd.Raw.Address.Hex()
-				c, ok := s.dmEventCounts.Get(b)
-				if !ok {
-					c = &DepositManagerEventCounts{Bidder: b}
+			func(revert bool) func(*depositmanager.DepositmanagerTargetDepositSet) {
+				return func(upd *depositmanager.DepositmanagerTargetDepositSet) {
+					s.countDMEvent(upd.Raw.Address.Hex(), func(c *DepositManagerEventCounts) *uint64 {
+						return &c.TargetDepositSet
+					}, revert)
 				}
-				c.TargetDepositSet++
-				_ = s.dmEventCounts.Add(b, c)
 			},
 		),
 	}
@@ -575,6 +600,22 @@ func (s *statHandler) configureDashboard() error {
 	return nil
 }
 
+func (s *statHandler) countDMEvent(
+	bidder string,
+	field func(*DepositManagerEventCounts) *uint64,
+	revert bool,
+) {
+	s.statMu.Lock()
+	defer s.statMu.Unlock()
+
+	c, ok := s.dmEventCounts.Get(bidder)
+	if !ok {
+		c = &DepositManagerEventCounts{Bidder: bidder}
+	}
+	count(field(c), revert)
+	_ = s.dmEventCounts.Add(bidder, c)
+}
+
 func (s *statHandler) healthy() bool {
 	select {
 	case <-s.sub.Err():
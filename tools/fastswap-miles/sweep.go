@@ -510,13 +510,9 @@ func signPermit2Witness(
 		witnessHash,
 	)
 
-	digest := crypto.Keccak256(
-		[]byte{0x19, 0x01},
-		domainSep,
-		structHash,
-	)
+	typedData := append(append([]byte{0x19, 0x01}, domainSep...), structHash...)
 
-	sig, err := signer.SignHash(digest)
+	sig, err := keysigner.SignKeccak256(signer, typedData)
 	if err != nil {
 		return nil, fmt.Errorf("sign hash: %w", err)
 	}
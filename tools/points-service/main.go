@@ -105,6 +105,13 @@ var (
 		Value:   21344601, // earliest relevant contract block
 	}
 
+	optionConfirmations = &cli.Uint64Flag{
+		Name:    "confirmations",
+		Usage:   "Number of blocks the events must be deep in the chain before they are processed",
+		EnvVars: []string{"POINTS_CONFIRMATIONS"},
+		Value:   0,
+	}
+
 	optionLogFmt = &cli.StringFlag{
 		Name:    "log-fmt",
 		Usage:   "log format to use, options are 'text' or 'json'",
@@ -610,6 +617,7 @@ func main() {
 			optionDBPath,
 			optionMainnet,
 			optionStartBlock,
+			optionConfirmations,
 			optionLogFmt,
 			optionLogLevel,
 			optionLogTags,
@@ -673,7 +681,13 @@ func main() {
 				ethClient: ethClient,
 			}
 
-			pub := publisher.NewHTTPPublisher(ps, logger, ethClient, listener)
+			pub := publisher.NewHTTPPublisher(
+				ps,
+				logger,
+				ethClient,
+				listener,
+				publisher.WithConfirmations(c.Uint64(optionConfirmations.Name)),
+			)
 			done := pub.Start(ctx)
 
 			// Choose contracts from mainnet vs Hoodi
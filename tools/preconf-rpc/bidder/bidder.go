@@ -203,7 +203,7 @@ func (b *BidderClient) Bid(
 	ctx context.Context,
 	bidAmount *big.Int,
 	slashAmount *big.Int,
-	rawTx string,
+	rawTxs []string,
 	opts *BidOpts,
 ) (chan BidStatus, error) {
 	if opts == nil {
@@ -264,7 +264,7 @@ func (b *BidderClient) Bid(
 		bidReq := &bidderapiv1.Bid{
 			Amount:              bidAmount.String(),
 			BlockNumber:         int64(blkNumber),
-			RawTransactions:     []string{rawTx},
+			RawTransactions:     rawTxs,
 			DecayStartTimestamp: nowFunc().Add(200 * time.Millisecond).UnixMilli(),
 			SlashAmount:         slashAmount.String(),
 			RevertingTxHashes:   opts.RevertingTxHashes,
@@ -207,7 +207,7 @@ func TestBidderClient(t *testing.T) {
 		t.Fatalf("expected 2 providers, got %d", len(providers))
 	}
 
-	statusC, err := bidderClient.Bid(ctx, big.NewInt(1), big.NewInt(1), txString, nil)
+	statusC, err := bidderClient.Bid(ctx, big.NewInt(1), big.NewInt(1), []string{txString}, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -49,6 +49,8 @@ type blockTracker struct {
 	txnToCheckMu    sync.Mutex
 	txnsToCheck     map[common.Hash]chan uint64
 	newBlockChan    chan uint64
+	headSubsMu      sync.Mutex
+	headSubs        map[chan *types.Header]struct{}
 }
 
 func NewBlockTracker(client EthClient, receiptGetter BatchReceiptGetter, receiptStore ReceiptStore, log *slog.Logger) (*blockTracker, error) {
@@ -65,6 +67,7 @@ func NewBlockTracker(client EthClient, receiptGetter BatchReceiptGetter, receipt
 		log:           log,
 		txnsToCheck:   make(map[common.Hash]chan uint64),
 		newBlockChan:  make(chan uint64, 1),
+		headSubs:      make(map[chan *types.Header]struct{}),
 	}, nil
 }
 
@@ -119,6 +122,7 @@ func (b *blockTracker) Start(ctx context.Context) <-chan struct{} {
 						BaseFee:     copyBigInt(block.BaseFee()),
 						NextBaseFee: computeNextBaseFee(block.Header()),
 					})
+					b.publishHead(block.Header())
 					select {
 					case b.newBlockChan <- blockNo:
 					case <-egCtx.Done():
@@ -236,6 +240,39 @@ func (b *blockTracker) WaitForTxnInclusion(
 	return resultCh
 }
 
+// SubscribeNewHeads returns a channel on which every new L1 header is
+// delivered. The channel is closed once the context is done. Headers are
+// dropped for subscribers which do not keep up.
+func (b *blockTracker) SubscribeNewHeads(ctx context.Context) <-chan *types.Header {
+	ch := make(chan *types.Header, 8)
+	b.headSubsMu.Lock()
+	b.headSubs[ch] = struct{}{}
+	b.headSubsMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.headSubsMu.Lock()
+		delete(b.headSubs, ch)
+		close(ch)
+		b.headSubsMu.Unlock()
+	}()
+
+	return ch
+}
+
+func (b *blockTracker) publishHead(header *types.Header) {
+	b.headSubsMu.Lock()
+	defer b.headSubsMu.Unlock()
+
+	for ch := range b.headSubs {
+		select {
+		case ch <- header:
+		default:
+			b.log.Warn("Dropping header for slow subscriber", "number", header.Number)
+		}
+	}
+}
+
 func copyBigInt(v *big.Int) *big.Int {
 	if v == nil {
 		return nil
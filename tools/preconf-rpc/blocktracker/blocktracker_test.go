@@ -202,8 +202,19 @@ func TestBlockTracker(t *testing.T) {
 	included3 := tracker.WaitForTxnInclusion(tx3.Hash())
 	included4 := tracker.WaitForTxnInclusion(tx4.Hash())
 
+	heads := tracker.SubscribeNewHeads(ctx)
+
 	client.blockNumber <- 100
 
+	select {
+	case head := <-heads:
+		if head.Number.Uint64() != 100 {
+			t.Fatalf("Expected new head 100, got %d", head.Number.Uint64())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for new head")
+	}
+
 	start := time.Now()
 	for {
 		bidBlockNo, duration, err := tracker.NextBlockNumber()
@@ -19,6 +19,7 @@ import (
 const (
 	bridgeLimitWei = 1000000000000000000 // 1 ETH
 	defaultSubsidy = 10000000000000000   // 0.01 ETH
+	maxBundleSize  = 16
 )
 
 type positionConstraintKey struct{}
@@ -33,6 +34,7 @@ type Pricer interface {
 
 type Store interface {
 	GetTransactionByHash(ctx context.Context, txnHash common.Hash) (*sender.Transaction, error)
+	GetBundle(ctx context.Context, bundleHash common.Hash) (*sender.Transaction, error)
 	GetTransactionsForBlock(ctx context.Context, blockNumber int64) ([]*sender.Transaction, error)
 	GetTransactionCommitments(ctx context.Context, txnHash common.Hash) ([]*bidderapiv1.Commitment, error)
 	GetTransactionLogs(ctx context.Context, txnHash common.Hash) ([]*types.Log, error)
@@ -48,12 +50,14 @@ type Store interface {
 type BlockTracker interface {
 	LatestBlockNumber() uint64
 	AccountNonce(ctx context.Context, account common.Address) (uint64, error)
+	SubscribeNewHeads(ctx context.Context) <-chan *types.Header
 }
 
 type Sender interface {
 	Enqueue(ctx context.Context, txn *sender.Transaction) error
 	CancelTransaction(ctx context.Context, txHash common.Hash) (bool, error)
 	WaitForReceiptAvailable(ctx context.Context, txHash common.Hash) <-chan struct{}
+	SubscribeCommitments(ctx context.Context, txHash common.Hash) <-chan *bidderapiv1.Commitment
 }
 
 func SetPositionConstraint(ctx context.Context, constraint *bidderapiv1.PositionConstraint) context.Context {
@@ -146,13 +150,7 @@ func (h *rpcMethodHandler) RegisterMethods(server *rpcserver.JSONRPCServer) {
 	server.RegisterHandler("eth_getTransactionReceipt", h.handleGetTxReceipt)
 	server.RegisterHandler("eth_getTransactionCount", h.handleGetTxCount)
 	server.RegisterHandler("eth_getBlockByHash", h.handleGetBlockByHash)
-	// Prevent spam
-	server.RegisterHandler("eth_sendBundle", func(ctx context.Context, params ...any) (json.RawMessage, bool, error) {
-		return nil, false, rpcserver.NewJSONErr(
-			rpcserver.CodeInvalidRequest,
-			"eth_sendBundle is not supported",
-		)
-	})
+	server.RegisterHandler("eth_sendBundle", h.handleSendBundle)
 	// Custom methods for MEV Commit
 	server.RegisterHandler("mevcommit_optInBlock", func(ctx context.Context, params ...any) (json.RawMessage, bool, error) {
 		timeToOptIn, err := h.bidder.Estimate()
@@ -226,6 +224,11 @@ func (h *rpcMethodHandler) RegisterMethods(server *rpcserver.JSONRPCServer) {
 	server.RegisterHandler("mevcommit_cancelTransaction", h.handleCancelTransaction)
 	server.RegisterHandler("mevcommit_getTransactionCommitments", h.handleGetTxCommitments)
 	server.RegisterHandler("mevcommit_getBalance", h.handleMevCommitGetBalance)
+	server.RegisterHandler("mevcommit_getBundleStatus", h.handleGetBundleStatus)
+
+	// Subscriptions available over WebSocket
+	server.RegisterSubscription("newHeads", h.handleNewHeadsSubscription)
+	server.RegisterSubscription("mevcommit_preconfirmations", h.handlePreconfirmationsSubscription)
 }
 
 func getNextBlockPrice(blockPrices map[int64]float64) *big.Int {
@@ -457,6 +460,244 @@ func (h *rpcMethodHandler) handleSendRawTx(
 	return txHashJSON, false, nil
 }
 
+// handleSendBundle accepts bundles in the Flashbots eth_sendBundle format. The
+// bundle is bid for atomically, either all its transactions are committed by
+// the providers in the given order or none.
+func (h *rpcMethodHandler) handleSendBundle(
+	ctx context.Context,
+	params ...any,
+) (json.RawMessage, bool, error) {
+	if len(params) != 1 {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeInvalidRequest,
+			"sendBundle requires exactly one parameter",
+		)
+	}
+
+	args, ok := params[0].(map[string]any)
+	if !ok {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeParseError,
+			"sendBundle parameter must be an object",
+		)
+	}
+
+	rawTxs, ok := args["txs"].([]any)
+	if !ok || len(rawTxs) == 0 {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeInvalidRequest,
+			"sendBundle requires a non-empty list of transactions",
+		)
+	}
+	if len(rawTxs) > maxBundleSize {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeInvalidRequest,
+			fmt.Sprintf("bundle exceeds maximum size of %d transactions", maxBundleSize),
+		)
+	}
+
+	bundle := make([]*types.Transaction, 0, len(rawTxs))
+	for _, raw := range rawTxs {
+		rawTxHex, ok := raw.(string)
+		if !ok || len(rawTxHex) < 2 || rawTxHex[:2] != "0x" {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeParseError,
+				"bundle transactions must be hex strings starting with '0x'",
+			)
+		}
+		decodedTxn, err := hex.DecodeString(rawTxHex[2:])
+		if err != nil {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeParseError,
+				"bundle transactions must be valid hex strings",
+			)
+		}
+		txn := new(types.Transaction)
+		if err := txn.UnmarshalBinary(decodedTxn); err != nil {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeParseError,
+				"bundle transactions must be valid transactions",
+			)
+		}
+		// Deposits and bridge transfers are accounted for by the sender and
+		// cannot be part of a bundle.
+		if to := txn.To(); to != nil && (*to == h.depositAddress || *to == h.bridgeAddress) {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeInvalidRequest,
+				"bundle cannot contain deposit or bridge transactions",
+			)
+		}
+		bundle = append(bundle, txn)
+	}
+
+	var targetBlock uint64
+	if blockNumber, ok := args["blockNumber"]; ok && blockNumber != nil {
+		blockNumberStr, ok := blockNumber.(string)
+		if !ok {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeParseError,
+				"blockNumber must be a hex string",
+			)
+		}
+		bn, err := hexutil.DecodeUint64(blockNumberStr)
+		if err != nil {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeParseError,
+				"blockNumber must be a hex string",
+			)
+		}
+		if bn <= h.blockTracker.LatestBlockNumber() {
+			return nil, false, rpcserver.NewJSONErr(
+				rpcserver.CodeInvalidRequest,
+				"blockNumber must be in the future",
+			)
+		}
+		targetBlock = bn
+	}
+
+	var revertingTxHashes []string
+	if reverting, ok := args["revertingTxHashes"].([]any); ok {
+		for _, r := range reverting {
+			hashStr, ok := r.(string)
+			if !ok || len(hashStr) < 2 || hashStr[:2] != "0x" {
+				return nil, false, rpcserver.NewJSONErr(
+					rpcserver.CodeParseError,
+					"revertingTxHashes must be hex strings starting with '0x'",
+				)
+			}
+			revertingTxHashes = append(revertingTxHashes, common.HexToHash(hashStr).Hex())
+		}
+	}
+
+	txSender, err := types.Sender(types.LatestSignerForChainID(bundle[0].ChainId()), bundle[0])
+	if err != nil {
+		h.logger.Error("Failed to get bundle sender", "error", err)
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			"failed to get bundle sender",
+		)
+	}
+
+	txnToEnqueue := &sender.Transaction{
+		Transaction:       bundle[0],
+		Raw:               rawTxs[0].(string),
+		Sender:            txSender,
+		Type:              sender.TxTypeBundle,
+		Bundle:            bundle,
+		RevertingTxHashes: revertingTxHashes,
+		TargetBlock:       targetBlock,
+	}
+	constraint, ok := getPositionConstraint(ctx)
+	if ok {
+		txnToEnqueue.Constraint = constraint
+	}
+
+	senders, err := txnToEnqueue.Senders()
+	if err != nil {
+		h.logger.Error("Failed to get bundle senders", "error", err)
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			"failed to get bundle sender",
+		)
+	}
+	// Every sender is charged its share of the bundle bid.
+	subsidized := make(map[common.Address]struct{}, len(senders))
+	for _, s := range senders {
+		if _, ok := subsidized[s]; ok {
+			continue
+		}
+		subsidized[s] = struct{}{}
+		if err := h.subsidizeOnce(ctx, s); err != nil {
+			h.logger.Warn("Failed to subsidize user", "error", err, "sender", s.Hex())
+		}
+	}
+
+	if err := h.sndr.Enqueue(ctx, txnToEnqueue); err != nil {
+		h.logger.Error("Failed to enqueue bundle for sending", "error", err, "sender", txSender.Hex())
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			err.Error(),
+		)
+	}
+
+	resultJSON, err := json.Marshal(map[string]string{
+		"bundleHash": txnToEnqueue.BundleHash().Hex(),
+	})
+	if err != nil {
+		h.logger.Error("Failed to marshal bundle hash to JSON", "error", err)
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			"failed to marshal bundle hash",
+		)
+	}
+
+	return resultJSON, false, nil
+}
+
+func (h *rpcMethodHandler) handleGetBundleStatus(
+	ctx context.Context,
+	params ...any,
+) (json.RawMessage, bool, error) {
+	if len(params) != 1 {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeInvalidRequest,
+			"getBundleStatus requires exactly one parameter",
+		)
+	}
+
+	bundleHashStr, ok := params[0].(string)
+	if !ok || len(bundleHashStr) < 2 || bundleHashStr[:2] != "0x" {
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeParseError,
+			"getBundleStatus parameter must be a hex string starting with '0x'",
+		)
+	}
+
+	bundleHash := common.HexToHash(bundleHashStr)
+
+	txn, err := h.store.GetBundle(ctx, bundleHash)
+	if err != nil {
+		h.logger.Info("Bundle not found", "error", err, "bundleHash", bundleHash)
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			"bundle not found",
+		)
+	}
+
+	commitments, err := h.store.GetTransactionCommitments(ctx, txn.Hash())
+	if err != nil {
+		h.logger.Debug("No commitments for bundle", "error", err, "bundleHash", bundleHash)
+		commitments = []*bidderapiv1.Commitment{}
+	}
+
+	txHashes := make([]string, 0, len(txn.Bundle))
+	for _, btx := range txn.Bundle {
+		txHashes = append(txHashes, btx.Hash().Hex())
+	}
+
+	result := map[string]any{
+		"bundleHash":   bundleHash.Hex(),
+		"txHashes":     txHashes,
+		"status":       string(txn.Status),
+		"details":      txn.Details,
+		"blockNumber":  hexutil.Uint64(txn.BlockNumber),
+		"targetBlock":  hexutil.Uint64(txn.TargetBlock),
+		"commitments":  commitments,
+		"maxBidAmount": getFinalBidAmount(commitments).String(),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		h.logger.Error("Failed to marshal bundle status to JSON", "error", err, "bundleHash", bundleHash)
+		return nil, false, rpcserver.NewJSONErr(
+			rpcserver.CodeCustomError,
+			"failed to marshal bundle status",
+		)
+	}
+
+	return resultJSON, false, nil
+}
+
 func (h *rpcMethodHandler) handleSendRawTxSync(
 	ctx context.Context,
 	params ...any,
@@ -855,6 +1096,105 @@ func (r *rpcMethodHandler) handleCancelTransaction(ctx context.Context, params .
 	return json.RawMessage(fmt.Sprintf(`{"cancelled": true, "txHash": "%s"}`, txHash.Hex())), false, nil
 }
 
+func (h *rpcMethodHandler) handleNewHeadsSubscription(
+	ctx context.Context,
+	params ...any,
+) (<-chan json.RawMessage, error) {
+	heads := h.blockTracker.SubscribeNewHeads(ctx)
+
+	out := make(chan json.RawMessage, 8)
+	go func() {
+		defer close(out)
+
+		for head := range heads {
+			headJSON, err := json.Marshal(head)
+			if err != nil {
+				h.logger.Error("Failed to marshal header to JSON", "error", err, "blockNumber", head.Number)
+				continue
+			}
+			select {
+			case out <- headJSON:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// handlePreconfirmationsSubscription pushes every commitment received for the
+// transaction. Commitments already stored when the subscription is created
+// are sent first, so that a client subscribing right after submitting the
+// transaction does not miss any.
+func (h *rpcMethodHandler) handlePreconfirmationsSubscription(
+	ctx context.Context,
+	params ...any,
+) (<-chan json.RawMessage, error) {
+	if len(params) != 1 {
+		return nil, rpcserver.NewJSONErr(
+			rpcserver.CodeInvalidRequest,
+			"mevcommit_preconfirmations requires exactly one parameter",
+		)
+	}
+
+	txHashStr, ok := params[0].(string)
+	if !ok || len(txHashStr) < 2 || txHashStr[:2] != "0x" {
+		return nil, rpcserver.NewJSONErr(
+			rpcserver.CodeParseError,
+			"mevcommit_preconfirmations parameter must be a hex string starting with '0x'",
+		)
+	}
+
+	txHash := common.HexToHash(txHashStr)
+
+	// Subscribe before reading the store so that no commitment falls in
+	// between the two.
+	live := h.sndr.SubscribeCommitments(ctx, txHash)
+	stored, err := h.store.GetTransactionCommitments(ctx, txHash)
+	if err != nil {
+		h.logger.Debug("No stored commitments for transaction", "error", err, "txHash", txHash)
+	}
+
+	out := make(chan json.RawMessage, 16)
+	go func() {
+		defer close(out)
+
+		seen := make(map[string]struct{})
+		send := func(cmt *bidderapiv1.Commitment) bool {
+			if _, ok := seen[cmt.CommitmentDigest]; ok {
+				return true
+			}
+			seen[cmt.CommitmentDigest] = struct{}{}
+
+			cmtJSON, err := json.Marshal(cmt)
+			if err != nil {
+				h.logger.Error("Failed to marshal commitment to JSON", "error", err, "txHash", txHash)
+				return true
+			}
+			select {
+			case out <- cmtJSON:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, cmt := range stored {
+			if !send(cmt) {
+				return
+			}
+		}
+		for cmt := range live {
+			if !send(cmt) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 func (r *rpcMethodHandler) subsidizeOnce(ctx context.Context, account common.Address) error {
 	if r.store.HasBalance(ctx, account, big.NewInt(1)) {
 		return nil
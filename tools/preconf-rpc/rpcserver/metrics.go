@@ -11,6 +11,11 @@ type metrics struct {
 	proxyMethodSuccessDurations *prometheus.HistogramVec
 	proxyMethodFailureCounts    *prometheus.CounterVec
 	proxyMethodFailureDurations *prometheus.HistogramVec
+	batchRequestCounts          prometheus.Counter
+	batchSizes                  prometheus.Histogram
+	batchDurations              prometheus.Histogram
+	wsConnections               prometheus.Gauge
+	activeSubscriptions         *prometheus.GaugeVec
 }
 
 func newMetrics() *metrics {
@@ -91,5 +96,48 @@ func newMetrics() *metrics {
 			},
 			[]string{"method"},
 		),
+		batchRequestCounts: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: "rpc",
+				Subsystem: "server",
+				Name:      "batch_request_counts",
+				Help:      "Count of JSON-RPC batch requests",
+			},
+		),
+		batchSizes: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "rpc",
+				Subsystem: "server",
+				Name:      "batch_sizes",
+				Help:      "Number of elements in JSON-RPC batch requests",
+				Buckets:   prometheus.ExponentialBuckets(1, 2, 8),
+			},
+		),
+		batchDurations: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "rpc",
+				Subsystem: "server",
+				Name:      "batch_durations_ms",
+				Help:      "Duration of JSON-RPC batch requests",
+				Buckets:   prometheus.ExponentialBuckets(5, 2, 12),
+			},
+		),
+		wsConnections: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: "rpc",
+				Subsystem: "server",
+				Name:      "ws_connections",
+				Help:      "Number of open WebSocket connections",
+			},
+		),
+		activeSubscriptions: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "rpc",
+				Subsystem: "server",
+				Name:      "active_subscriptions",
+				Help:      "Number of active eth_subscribe subscriptions",
+			},
+			[]string{"subscription"},
+		),
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gorilla/websocket"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -23,9 +24,11 @@ const (
 	defaultTimeout     = 5 * time.Second
 	defaultMaxBodySize = 30 * 1024 * 1024 // 30 MB
 	cacheSize          = 10000
+	maxBatchSize       = 100
 
 	CodeParseError     = -32700
 	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
 	CodeCustomError    = -32000
 )
 
@@ -89,6 +92,7 @@ func cacheKey(method string, params []any) string {
 type JSONRPCServer struct {
 	rwLock     sync.RWMutex
 	methods    map[string]methodHandler
+	subs       map[string]subscriptionHandler
 	proxyURL   string
 	httpClient *http.Client
 	cache      *lru.Cache[string, cacheEntry]
@@ -104,6 +108,7 @@ func NewJSONRPCServer(proxyURL string, logger *slog.Logger) (*JSONRPCServer, err
 	return &JSONRPCServer{
 		proxyURL: proxyURL,
 		methods:  make(map[string]methodHandler),
+		subs:     make(map[string]subscriptionHandler),
 		httpClient: &http.Client{
 			Transport: &http.Transport{
 				Proxy:               http.ProxyFromEnvironment,
@@ -135,6 +140,11 @@ func (s *JSONRPCServer) Metrics() []prometheus.Collector {
 		s.metrics.proxyMethodFailureCounts,
 		s.metrics.proxyMethodSuccessDurations,
 		s.metrics.proxyMethodFailureDurations,
+		s.metrics.batchRequestCounts,
+		s.metrics.batchSizes,
+		s.metrics.batchDurations,
+		s.metrics.wsConnections,
+		s.metrics.activeSubscriptions,
 	}
 }
 
@@ -145,6 +155,11 @@ func (s *JSONRPCServer) RegisterHandler(method string, handler methodHandler) {
 }
 
 func (s *JSONRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		s.serveWS(w, r)
+		return
+	}
+
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
@@ -166,6 +181,11 @@ func (s *JSONRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isBatch(body) {
+		s.serveBatch(r.Context(), w, body)
+		return
+	}
+
 	var req jsonRPCRequest
 	err = json.Unmarshal(body, &req)
 	if err != nil {
@@ -179,39 +199,71 @@ func (s *JSONRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	resp, pErr := s.handleRequest(r.Context(), &req, body)
+	if pErr != nil {
+		http.Error(w, pErr.Error(), pErr.statusCode)
+		return
+	}
+	s.writeJSON(w, resp)
+}
+
+// proxyError is returned when the upstream RPC could not be reached or
+// returned an invalid response. For single requests it is surfaced as an
+// HTTP error, for batches it is converted into a per element JSON-RPC error.
+type proxyError struct {
+	statusCode int
+	err        error
+}
+
+func (e *proxyError) Error() string {
+	return e.err.Error()
+}
+
+// handleRequest dispatches a single JSON-RPC request to the cache, the
+// registered handler or the proxy. The raw request body is used when the
+// request is proxied.
+func (s *JSONRPCServer) handleRequest(
+	ctx context.Context,
+	req *jsonRPCRequest,
+	raw []byte,
+) (*jsonRPCResponse, *proxyError) {
 	start := time.Now()
 
+	if req.Method == methodSubscribe || req.Method == methodUnsubscribe {
+		return s.newError(req.ID, CodeMethodNotFound, "notifications not supported"), nil
+	}
+
 	if cacheMethods[req.Method] {
 		key := cacheKey(req.Method, req.Params)
 		if entry, ok := s.cache.Get(key); ok && time.Now().Before(entry.until) {
 			s.logger.Debug("Cache hit", "method", req.Method, "id", req.ID)
-			s.writeResponse(w, req.ID, &entry.data)
 			s.metrics.methodSuccessCounts.WithLabelValues(req.Method).Inc()
 			s.metrics.methodSuccessDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-			return
+			return s.newResponse(req.ID, &entry.data), nil
 		}
 	}
 
-	handleProxy := func() {
-		out, statusCode, err := s.proxyRequest(r.Context(), body)
-		if err != nil {
-			http.Error(w, err.Error(), statusCode)
+	handleProxy := func() (*jsonRPCResponse, *proxyError) {
+		proxyFailed := func() {
 			s.metrics.proxyMethodFailureCounts.WithLabelValues(req.Method).Inc()
 			s.metrics.proxyMethodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-			return
+		}
+		out, statusCode, err := s.proxyRequest(ctx, raw)
+		if err != nil {
+			proxyFailed()
+			return nil, &proxyError{statusCode: statusCode, err: err}
 		}
 		var resp jsonRPCResponse
 		if err := json.Unmarshal(out, &resp); err != nil {
-			http.Error(w, "Failed to parse proxy response", http.StatusInternalServerError)
-			s.metrics.proxyMethodFailureCounts.WithLabelValues(req.Method).Inc()
-			s.metrics.proxyMethodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-			return
+			proxyFailed()
+			return nil, &proxyError{
+				statusCode: http.StatusInternalServerError,
+				err:        errors.New("failed to parse proxy response"),
+			}
 		}
 		if resp.Error != nil {
-			s.writeError(w, req.ID, resp.Error.Code, resp.Error.Message)
-			s.metrics.proxyMethodFailureCounts.WithLabelValues(req.Method).Inc()
-			s.metrics.proxyMethodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-			return
+			proxyFailed()
+			return s.newError(req.ID, resp.Error.Code, resp.Error.Message), nil
 		}
 		if cacheMethods[req.Method] && resp.Result != nil {
 			key := cacheKey(req.Method, req.Params)
@@ -221,68 +273,119 @@ func (s *JSONRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			})
 			s.logger.Debug("Cache store", "method", req.Method, "id", req.ID)
 		}
-		s.writeResponse(w, req.ID, resp.Result)
 		s.metrics.proxyMethodSuccessCounts.WithLabelValues(req.Method).Inc()
 		s.metrics.proxyMethodSuccessDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
+		return s.newResponse(req.ID, resp.Result), nil
 	}
 
 	s.rwLock.RLock()
 	handler, ok := s.methods[req.Method]
 	s.rwLock.RUnlock()
 	if !ok {
-		handleProxy()
-		return
+		return handleProxy()
 	}
 
-	resp, proxy, err := handler(r.Context(), req.Params...)
+	resp, proxy, err := handler(ctx, req.Params...)
 	switch {
 	case err != nil:
-		defer func() {
-			s.metrics.methodFailureCounts.WithLabelValues(req.Method).Inc()
-			s.metrics.methodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-		}()
+		s.metrics.methodFailureCounts.WithLabelValues(req.Method).Inc()
+		s.metrics.methodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
 		var jsonErr *JSONErr
 		if ok := errors.As(err, &jsonErr); ok {
 			// If the error is a JSONErr, we can use it directly.
-			s.writeError(w, req.ID, jsonErr.Code, jsonErr.Message)
-			return
+			return s.newError(req.ID, jsonErr.Code, jsonErr.Message), nil
 		}
-		s.writeError(w, req.ID, CodeCustomError, err.Error())
-		return
+		return s.newError(req.ID, CodeCustomError, err.Error()), nil
 	case proxy:
-		handleProxy()
-		return
+		return handleProxy()
 	case resp == nil:
-		s.writeError(w, req.ID, CodeCustomError, "No response")
 		s.metrics.methodFailureCounts.WithLabelValues(req.Method).Inc()
 		s.metrics.methodFailureDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
-		return
+		return s.newError(req.ID, CodeCustomError, "No response"), nil
 	}
 
-	s.writeResponse(w, req.ID, &resp)
 	s.metrics.methodSuccessCounts.WithLabelValues(req.Method).Inc()
 	s.metrics.methodSuccessDurations.WithLabelValues(req.Method).Observe(float64(time.Since(start).Milliseconds()))
+	return s.newResponse(req.ID, &resp), nil
+}
+
+// serveBatch handles a JSON-RPC 2.0 batch request over HTTP.
+func (s *JSONRPCServer) serveBatch(ctx context.Context, w http.ResponseWriter, body []byte) {
+	responses, errResp := s.handleBatch(ctx, body)
+	switch {
+	case errResp != nil:
+		s.writeJSON(w, errResp)
+	case len(responses) == 0:
+		// A batch of notifications does not produce any response.
+		setCorsHeaders(w)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeJSON(w, responses)
+	}
 }
 
-func (s *JSONRPCServer) writeResponse(w http.ResponseWriter, id any, result *json.RawMessage) {
+// handleBatch dispatches every element of a batch in order, so that
+// transactions from the same sender are submitted in the order given by the
+// client. Notifications are processed but do not produce a response element.
+// If the batch itself is invalid, a single error response is returned instead.
+func (s *JSONRPCServer) handleBatch(ctx context.Context, body []byte) ([]*jsonRPCResponse, *jsonRPCResponse) {
+	start := time.Now()
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(body, &elems); err != nil {
+		s.logger.Error("Failed to parse JSON-RPC batch request", "error", err, "body", string(body))
+		return nil, s.newError(nil, CodeParseError, "Failed to parse request")
+	}
+
+	switch {
+	case len(elems) == 0:
+		return nil, s.newError(nil, CodeInvalidRequest, "Empty batch")
+	case len(elems) > maxBatchSize:
+		return nil, s.newError(nil, CodeInvalidRequest, fmt.Sprintf("Batch too large, maximum is %d", maxBatchSize))
+	}
+
+	s.metrics.batchRequestCounts.Inc()
+	s.metrics.batchSizes.Observe(float64(len(elems)))
+
+	responses := make([]*jsonRPCResponse, 0, len(elems))
+	for _, elem := range elems {
+		var req jsonRPCRequest
+		if err := json.Unmarshal(elem, &req); err != nil {
+			responses = append(responses, s.newError(nil, CodeInvalidRequest, "Invalid request"))
+			continue
+		}
+		if req.JSONRPC != "2.0" {
+			responses = append(responses, s.newError(req.ID, CodeInvalidRequest, "Invalid JSON-RPC version"))
+			continue
+		}
+
+		resp, pErr := s.handleRequest(ctx, &req, elem)
+		if pErr != nil {
+			resp = s.newError(req.ID, CodeCustomError, pErr.Error())
+		}
+		if isNotification(elem) {
+			continue
+		}
+		responses = append(responses, resp)
+	}
+
+	s.metrics.batchDurations.Observe(float64(time.Since(start).Milliseconds()))
+	return responses, nil
+}
+
+func (s *JSONRPCServer) newResponse(id any, result *json.RawMessage) *jsonRPCResponse {
 	s.logger.Debug("Writing JSON-RPC response", "id", id, "result", result)
-	response := jsonRPCResponse{
+	return &jsonRPCResponse{
 		JSONRPC: "2.0",
 		ID:      id,
 		Result:  result,
 		Error:   nil,
 	}
-	setCorsHeaders(w)
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "Failed to write response", http.StatusInternalServerError)
-		return
-	}
 }
 
-func (s *JSONRPCServer) writeError(w http.ResponseWriter, id any, code int, message string) {
+func (s *JSONRPCServer) newError(id any, code int, message string) *jsonRPCResponse {
 	s.logger.Error("JSON-RPC error", "id", id, "code", code, "message", message)
-	response := jsonRPCResponse{
+	return &jsonRPCResponse{
 		JSONRPC: "2.0",
 		ID:      id,
 		Result:  nil,
@@ -292,14 +395,38 @@ func (s *JSONRPCServer) writeError(w http.ResponseWriter, id any, code int, mess
 			Data:    nil,
 		},
 	}
+}
+
+func (s *JSONRPCServer) writeError(w http.ResponseWriter, id any, code int, message string) {
+	s.writeJSON(w, s.newError(id, code, message))
+}
+
+func (s *JSONRPCServer) writeJSON(w http.ResponseWriter, response any) {
 	setCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "Failed to write error response", http.StatusInternalServerError)
+		http.Error(w, "Failed to write response", http.StatusInternalServerError)
 		return
 	}
 }
 
+// isBatch reports if the body is a JSON array, ignoring leading whitespace.
+func isBatch(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// isNotification reports if the request has no id member. A request with a
+// null id is not a notification and still gets a response.
+func isNotification(raw json.RawMessage) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["id"]
+	return !ok
+}
+
 func (s *JSONRPCServer) proxyRequest(ctx context.Context, body []byte) ([]byte, int, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.proxyURL, bytes.NewReader(body))
 	if err != nil {
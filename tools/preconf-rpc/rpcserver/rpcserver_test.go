@@ -0,0 +1,276 @@
+package rpcserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/primev/mev-commit/tools/preconf-rpc/rpcserver"
+)
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      any             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("proxy received invalid request: %v", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req["id"],
+			"result":  "proxied_" + req["method"].(string),
+		})
+	}))
+	t.Cleanup(proxy.Close)
+
+	srv, err := rpcserver.NewJSONRPCServer(proxy.URL, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.RegisterHandler("test_echo", func(_ context.Context, params ...any) (json.RawMessage, bool, error) {
+		out, err := json.Marshal(params[0])
+		return out, false, err
+	})
+	srv.RegisterHandler("test_fail", func(_ context.Context, _ ...any) (json.RawMessage, bool, error) {
+		return nil, false, errors.New("failed")
+	})
+	srv.RegisterSubscription("test_counter", func(ctx context.Context, _ ...any) (<-chan json.RawMessage, error) {
+		out := make(chan json.RawMessage)
+		go func() {
+			defer close(out)
+			for i := 0; ; i++ {
+				select {
+				case out <- json.RawMessage(strconv.Itoa(i)):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return out, nil
+	})
+
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func post(t *testing.T, url, body string) *http.Response {
+	t.Helper()
+
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestSingleRequest(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	resp := post(t, s.URL, `{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`)
+	var out response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Error != nil {
+		t.Fatalf("unexpected error: %v", out.Error.Message)
+	}
+	if string(out.Result) != `"hello"` {
+		t.Fatalf("unexpected result: %s", out.Result)
+	}
+}
+
+func TestBatchRequest(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	resp := post(t, s.URL, `[
+		{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]},
+		{"jsonrpc":"2.0","id":2,"method":"test_fail","params":[]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_chainId","params":[]},
+		{"jsonrpc":"2.0","method":"test_echo","params":["notification"]},
+		{"jsonrpc":"1.0","id":4,"method":"test_echo","params":["hello"]},
+		42
+	]`)
+
+	var out []response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 5 {
+		t.Fatalf("expected 5 responses, got %d", len(out))
+	}
+
+	if out[0].Error != nil || string(out[0].Result) != `"hello"` {
+		t.Fatalf("unexpected response for handler: %+v", out[0])
+	}
+	if out[1].Error == nil || out[1].Error.Message != "failed" {
+		t.Fatalf("expected handler error, got %+v", out[1])
+	}
+	if out[2].Error != nil || string(out[2].Result) != `"proxied_eth_chainId"` {
+		t.Fatalf("unexpected response for proxy: %+v", out[2])
+	}
+	if out[3].Error == nil || out[3].Error.Code != rpcserver.CodeInvalidRequest {
+		t.Fatalf("expected invalid version error, got %+v", out[3])
+	}
+	if out[4].Error == nil || out[4].Error.Code != rpcserver.CodeInvalidRequest || out[4].ID != nil {
+		t.Fatalf("expected invalid request error, got %+v", out[4])
+	}
+}
+
+func TestBatchRequestErrors(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	t.Run("empty batch", func(t *testing.T) {
+		resp := post(t, s.URL, `[]`)
+		var out response
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Error == nil || out.Error.Code != rpcserver.CodeInvalidRequest {
+			t.Fatalf("expected invalid request error, got %+v", out)
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		resp := post(t, s.URL, `[{"jsonrpc":"2.0"`)
+		var out response
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Error == nil || out.Error.Code != rpcserver.CodeParseError {
+			t.Fatalf("expected parse error, got %+v", out)
+		}
+	})
+
+	t.Run("only notifications", func(t *testing.T) {
+		resp := post(t, s.URL, `[{"jsonrpc":"2.0","method":"test_echo","params":["a"]}]`)
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected no content, got %d", resp.StatusCode)
+		}
+	})
+}
+
+func TestSubscribeOverHTTP(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	resp := post(t, s.URL, `{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["test_counter"]}`)
+	var out response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Error == nil || out.Error.Code != rpcserver.CodeMethodNotFound {
+		t.Fatalf("expected method not found error, got %+v", out)
+	}
+}
+
+func TestWebSocket(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(s.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	call := func(req string) response {
+		t.Helper()
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(req)); err != nil {
+			t.Fatal(err)
+		}
+		var out response
+		if err := conn.ReadJSON(&out); err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	out := call(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`)
+	if out.Error != nil || string(out.Result) != `"hello"` {
+		t.Fatalf("unexpected response: %+v", out)
+	}
+
+	out = call(`{"jsonrpc":"2.0","id":2,"method":"eth_subscribe","params":["unknown"]}`)
+	if out.Error == nil || out.Error.Code != rpcserver.CodeInvalidRequest {
+		t.Fatalf("expected invalid request error, got %+v", out)
+	}
+
+	out = call(`{"jsonrpc":"2.0","id":3,"method":"eth_subscribe","params":["test_counter"]}`)
+	if out.Error != nil {
+		t.Fatalf("unexpected error: %v", out.Error.Message)
+	}
+	var subID string
+	if err := json.Unmarshal(out.Result, &subID); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		var notif struct {
+			Method string `json:"method"`
+			Params struct {
+				Subscription string `json:"subscription"`
+				Result       int    `json:"result"`
+			} `json:"params"`
+		}
+		if err := conn.ReadJSON(&notif); err != nil {
+			t.Fatal(err)
+		}
+		if notif.Method != "eth_subscription" || notif.Params.Subscription != subID {
+			t.Fatalf("unexpected notification: %+v", notif)
+		}
+		if notif.Params.Result != i {
+			t.Fatalf("expected result %d, got %d", i, notif.Params.Result)
+		}
+	}
+
+	req := `{"jsonrpc":"2.0","id":4,"method":"eth_unsubscribe","params":["` + subID + `"]}`
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(req)); err != nil {
+		t.Fatal(err)
+	}
+	// Notifications sent before the unsubscribe was processed may still
+	// arrive ahead of the response.
+	for {
+		var out response
+		if err := conn.ReadJSON(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out.ID == nil {
+			continue
+		}
+		if out.Error != nil || string(out.Result) != "true" {
+			t.Fatalf("unexpected unsubscribe response: %+v", out)
+		}
+		break
+	}
+}
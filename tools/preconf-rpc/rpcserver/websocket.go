@@ -0,0 +1,339 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	methodSubscribe    = "eth_subscribe"
+	methodUnsubscribe  = "eth_unsubscribe"
+	methodSubscription = "eth_subscription"
+
+	wsWriteTimeout          = 10 * time.Second
+	wsPongTimeout           = 60 * time.Second
+	wsPingInterval          = 30 * time.Second
+	maxSubscriptionsPerConn = 100
+)
+
+// subscriptionHandler creates a new subscription. Every value sent on the
+// returned channel is pushed to the client as an eth_subscription
+// notification. The channel must be closed once the context is done.
+type subscriptionHandler func(ctx context.Context, params ...any) (<-chan json.RawMessage, error)
+
+type subscriptionNotification struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  subscriptionResult `json:"params"`
+}
+
+type subscriptionResult struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	// The HTTP endpoint allows any origin, keep the same behaviour here.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// RegisterSubscription registers a subscription which clients can create
+// with eth_subscribe over a WebSocket connection.
+func (s *JSONRPCServer) RegisterSubscription(name string, handler subscriptionHandler) {
+	s.rwLock.Lock()
+	s.subs[name] = handler
+	s.rwLock.Unlock()
+}
+
+type wsConn struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	subsMu  sync.Mutex
+	subs    map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func (c *wsConn) writeJSON(v any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(v)
+}
+
+func (c *wsConn) ping() error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	return c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+}
+
+func (c *wsConn) addSub(cancel context.CancelFunc) (string, error) {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	if len(c.subs) >= maxSubscriptionsPerConn {
+		return "", fmt.Errorf("too many subscriptions, maximum is %d", maxSubscriptionsPerConn)
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	id := hexutil.Encode(buf)
+	c.subs[id] = cancel
+	return id, nil
+}
+
+func (c *wsConn) removeSub(id string) bool {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	cancel, ok := c.subs[id]
+	if !ok {
+		return false
+	}
+	delete(c.subs, id)
+	cancel()
+	return true
+}
+
+// serveWS upgrades the connection and serves JSON-RPC requests over it until
+// the client disconnects. Requests are handled concurrently, clients which
+// depend on ordering should use a batch request.
+func (s *JSONRPCServer) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied with an HTTP error.
+		s.logger.Error("Failed to upgrade WebSocket connection", "error", err)
+		return
+	}
+
+	s.metrics.wsConnections.Inc()
+	defer s.metrics.wsConnections.Dec()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	c := &wsConn{
+		conn: conn,
+		subs: make(map[string]context.CancelFunc),
+	}
+	defer func() {
+		cancel()
+		c.wg.Wait()
+		_ = conn.Close()
+	}()
+
+	conn.SetReadLimit(defaultMaxBodySize)
+	_ = conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(wsPingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.ping(); err != nil {
+					s.logger.Debug("Failed to ping WebSocket client", "error", err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		_, body, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				s.logger.Warn("WebSocket connection closed unexpectedly", "error", err)
+			}
+			return
+		}
+		// Any received message proves the client is alive.
+		_ = conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			s.handleWSMessage(ctx, c, body)
+		}()
+	}
+}
+
+func (s *JSONRPCServer) handleWSMessage(ctx context.Context, c *wsConn, body []byte) {
+	var out any
+	switch {
+	case isBatch(body):
+		responses, errResp := s.handleBatch(ctx, body)
+		switch {
+		case errResp != nil:
+			out = errResp
+		case len(responses) > 0:
+			out = responses
+		}
+	default:
+		var req jsonRPCRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			s.logger.Error("Failed to parse JSON-RPC request", "error", err, "body", string(body))
+			out = s.newError(nil, CodeParseError, "Failed to parse request")
+			break
+		}
+		if req.JSONRPC != "2.0" {
+			out = s.newError(nil, CodeInvalidRequest, "Invalid JSON-RPC version")
+			break
+		}
+
+		switch req.Method {
+		case methodSubscribe:
+			// The response has to be written before the first notification,
+			// so subscribe replies on its own.
+			s.subscribe(ctx, c, &req)
+			return
+		case methodUnsubscribe:
+			out = s.unsubscribe(c, &req)
+		default:
+			resp, pErr := s.handleRequest(ctx, &req, body)
+			if pErr != nil {
+				resp = s.newError(req.ID, CodeCustomError, pErr.Error())
+			}
+			if isNotification(body) {
+				return
+			}
+			out = resp
+		}
+	}
+
+	if out == nil {
+		return
+	}
+	if err := c.writeJSON(out); err != nil {
+		s.logger.Debug("Failed to write WebSocket response", "error", err)
+	}
+}
+
+func (s *JSONRPCServer) subscribe(ctx context.Context, c *wsConn, req *jsonRPCRequest) {
+	reply := func(resp *jsonRPCResponse) bool {
+		if err := c.writeJSON(resp); err != nil {
+			s.logger.Debug("Failed to write WebSocket response", "error", err)
+			return false
+		}
+		return true
+	}
+
+	if len(req.Params) == 0 {
+		reply(s.newError(req.ID, CodeInvalidRequest, "eth_subscribe requires a subscription name"))
+		return
+	}
+	name, ok := req.Params[0].(string)
+	if !ok {
+		reply(s.newError(req.ID, CodeInvalidRequest, "subscription name must be a string"))
+		return
+	}
+
+	s.rwLock.RLock()
+	handler, found := s.subs[name]
+	s.rwLock.RUnlock()
+	if !found {
+		reply(s.newError(req.ID, CodeInvalidRequest, fmt.Sprintf("unsupported subscription %q", name)))
+		return
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	id, err := c.addSub(cancel)
+	if err != nil {
+		cancel()
+		reply(s.newError(req.ID, CodeCustomError, err.Error()))
+		return
+	}
+
+	notifC, err := handler(subCtx, req.Params[1:]...)
+	if err != nil {
+		c.removeSub(id)
+		var jsonErr *JSONErr
+		if errors.As(err, &jsonErr) {
+			reply(s.newError(req.ID, jsonErr.Code, jsonErr.Message))
+			return
+		}
+		reply(s.newError(req.ID, CodeCustomError, err.Error()))
+		return
+	}
+
+	idJSON := json.RawMessage(fmt.Sprintf("%q", id))
+	if !reply(s.newResponse(req.ID, &idJSON)) {
+		c.removeSub(id)
+		// Drain so that the producer is not left behind on a full channel.
+		for range notifC {
+		}
+		return
+	}
+
+	s.logger.Debug("Subscription created", "name", name, "id", id)
+	s.metrics.activeSubscriptions.WithLabelValues(name).Inc()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer s.metrics.activeSubscriptions.WithLabelValues(name).Dec()
+		defer c.removeSub(id)
+
+		failed := false
+		for result := range notifC {
+			if failed {
+				// Keep draining until the producer observes the cancellation.
+				continue
+			}
+			err := c.writeJSON(&subscriptionNotification{
+				JSONRPC: "2.0",
+				Method:  methodSubscription,
+				Params: subscriptionResult{
+					Subscription: id,
+					Result:       result,
+				},
+			})
+			if err != nil {
+				s.logger.Debug("Failed to write subscription notification", "id", id, "error", err)
+				failed = true
+				c.removeSub(id)
+			}
+		}
+	}()
+}
+
+func (s *JSONRPCServer) unsubscribe(c *wsConn, req *jsonRPCRequest) *jsonRPCResponse {
+	if len(req.Params) != 1 {
+		return s.newError(req.ID, CodeInvalidRequest, "eth_unsubscribe requires exactly one parameter")
+	}
+	id, ok := req.Params[0].(string)
+	if !ok {
+		return s.newError(req.ID, CodeInvalidRequest, "subscription id must be a string")
+	}
+
+	result := json.RawMessage("false")
+	if c.removeSub(id) {
+		s.logger.Debug("Subscription removed", "id", id)
+		result = json.RawMessage("true")
+	}
+	return s.newResponse(req.ID, &result)
+}
@@ -13,12 +13,14 @@ import (
 	"log/slog"
 	"math"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	lru "github.com/hashicorp/golang-lru/v2"
 	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
 	"github.com/primev/mev-commit/tools/preconf-rpc/bidder"
@@ -34,6 +36,7 @@ const (
 	TxTypeDeposit
 	TxTypeInstantBridge
 	TxTypeFastSwap // Executor-submitted fastswap transactions (skip balance check)
+	TxTypeBundle   // Atomic multi-transaction bundles submitted with eth_sendBundle
 )
 
 type TxStatus string
@@ -71,6 +74,9 @@ var (
 	ErrMaxAttemptsPerBlockExceeded = errors.New("maximum attempts exceeded for transaction in the current block")
 	ErrNonceTooHigh                = errors.New("nonce too high")
 	ErrNonceTooLow                 = errors.New("nonce too low")
+	ErrEmptyBundle                 = errors.New("empty bundle")
+	ErrBundleNotSupported          = errors.New("bundles are not supported by the configured simulator")
+	ErrTargetBlockPassed           = errors.New("bundle target block has passed")
 )
 
 type Transaction struct {
@@ -82,6 +88,11 @@ type Transaction struct {
 	Details     string
 	BlockNumber int64
 	Constraint  *bidderapiv1.PositionConstraint
+	// Bundle fields, only set for TxTypeBundle. The embedded Transaction is
+	// the first transaction of the bundle and identifies it in the store.
+	Bundle            []*types.Transaction
+	RevertingTxHashes []string
+	TargetBlock       uint64
 	// local fields not stored in DB
 	noOfProviders int
 	commitments   []*bidderapiv1.Commitment
@@ -90,6 +101,116 @@ type Transaction struct {
 	simFailed     bool // tracks if the last simulation failed, to force re-sim on retry
 }
 
+// BundleHash returns the hash identifying a bundle, computed as the keccak256
+// of the concatenated transaction hashes like the Flashbots relay does.
+func (t *Transaction) BundleHash() common.Hash {
+	buf := make([]byte, 0, len(t.Bundle)*common.HashLength)
+	for _, tx := range t.Bundle {
+		buf = append(buf, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(buf)
+}
+
+// rawTransactions returns the hex encoded transactions to be sent in a bid
+// without the 0x prefix.
+func (t *Transaction) rawTransactions() ([]string, error) {
+	if t.Type != TxTypeBundle {
+		return []string{strings.TrimPrefix(t.Raw, "0x")}, nil
+	}
+	raws := make([]string, 0, len(t.Bundle))
+	for _, tx := range t.Bundle {
+		buf, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		raws = append(raws, hex.EncodeToString(buf))
+	}
+	return raws, nil
+}
+
+// gasLimit returns the total gas limit of the transaction or bundle.
+func (t *Transaction) gasLimit() uint64 {
+	if t.Type != TxTypeBundle {
+		return t.Gas()
+	}
+	var gas uint64
+	for _, tx := range t.Bundle {
+		gas += tx.Gas()
+	}
+	return gas
+}
+
+// Senders returns the sender of every transaction of a bundle in order, or
+// the sender of the transaction otherwise.
+func (t *Transaction) Senders() ([]common.Address, error) {
+	if t.Type != TxTypeBundle {
+		return []common.Address{t.Sender}, nil
+	}
+	senders := make([]common.Address, 0, len(t.Bundle))
+	for _, tx := range t.Bundle {
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sender of bundle transaction %s: %w", tx.Hash().Hex(), err)
+		}
+		senders = append(senders, from)
+	}
+	return senders, nil
+}
+
+type senderCost struct {
+	sender common.Address
+	amount *big.Int
+}
+
+// senderCosts splits the cost of a bid between the senders of the
+// transaction. For bundles every sender pays in proportion to the gas limit
+// of its transactions, the remainder of the division is charged to the
+// sender of the first transaction.
+func (t *Transaction) senderCosts(cost *big.Int) ([]senderCost, error) {
+	if t.Type != TxTypeBundle {
+		return []senderCost{{sender: t.Sender, amount: cost}}, nil
+	}
+	senders, err := t.Senders()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		costs     []senderCost
+		idx       = make(map[common.Address]int)
+		totalGas  = new(big.Int).SetUint64(t.gasLimit())
+		remainder = new(big.Int).Set(cost)
+	)
+	for i, tx := range t.Bundle {
+		share := new(big.Int).Mul(cost, new(big.Int).SetUint64(tx.Gas()))
+		share.Div(share, totalGas)
+		remainder.Sub(remainder, share)
+		if j, ok := idx[senders[i]]; ok {
+			costs[j].amount.Add(costs[j].amount, share)
+			continue
+		}
+		idx[senders[i]] = len(costs)
+		costs = append(costs, senderCost{sender: senders[i], amount: share})
+	}
+	costs[0].amount.Add(costs[0].amount, remainder)
+	return costs, nil
+}
+
+// feePerGas returns the effective fee per gas of the transaction. For
+// bundles it is the lowest one, as every transaction must cover the base fee.
+func (t *Transaction) feePerGas() *big.Int {
+	if t.Type != TxTypeBundle {
+		return effectiveFeePerGas(t.Transaction)
+	}
+	var fee *big.Int
+	for _, tx := range t.Bundle {
+		if f := effectiveFeePerGas(tx); fee == nil || f.Cmp(fee) < 0 {
+			fee = f
+		}
+	}
+	return fee
+}
+
 // encryptForLog encrypts plaintext using AES-256-GCM and returns a base64-encoded
 // ciphertext string suitable for logging. Returns empty string if key is nil.
 func encryptForLog(key []byte, plaintext string) string {
@@ -172,7 +293,7 @@ type Bidder interface {
 		ctx context.Context,
 		bidAmount *big.Int,
 		slashAmount *big.Int,
-		rawTx string,
+		rawTxs []string,
 		opts *bidder.BidOpts,
 	) (chan bidder.BidStatus, error)
 	ConnectedProviders(ctx context.Context) ([]string, error)
@@ -199,6 +320,12 @@ type Simulator interface {
 	Simulate(ctx context.Context, txRaw string, state sim.SimState) ([]*types.Log, bool, error)
 }
 
+// BundleSimulator is implemented by simulators which are able to execute
+// the transactions of a bundle on top of each other.
+type BundleSimulator interface {
+	SimulateBundle(ctx context.Context, rawTxs []string, revertingTxHashes []string, state sim.SimState) ([]*types.Log, error)
+}
+
 type blockAttempt struct {
 	blockNumber uint64
 	attempts    int
@@ -248,6 +375,8 @@ type TxSender struct {
 	timeoutMtx        sync.RWMutex
 	receiptSignal     map[common.Hash][]chan struct{}
 	receiptMtx        sync.Mutex
+	commitmentSubs    map[common.Hash][]chan *bidderapiv1.Commitment
+	commitmentSubsMtx sync.Mutex
 	metrics           *metrics
 	explorerSubmitter ExplorerSubmitter
 	logEncryptionKey  []byte
@@ -303,6 +432,7 @@ func NewTxSender(
 		fastTrack:         noOpFastTrack,
 		bidTimeout:        bidTimeout,
 		receiptSignal:     make(map[common.Hash][]chan struct{}),
+		commitmentSubs:    make(map[common.Hash][]chan *bidderapiv1.Commitment),
 		metrics:           newMetrics(),
 		explorerSubmitter: explorerSubmitter,
 		logEncryptionKey:  logEncryptionKey,
@@ -328,9 +458,12 @@ func validateTransaction(tx *Transaction) error {
 	if tx == nil || tx.Transaction == nil {
 		return ErrInvalidTransaction
 	}
-	if tx.Type < TxTypeRegular || tx.Type > TxTypeFastSwap {
+	if tx.Type < TxTypeRegular || tx.Type > TxTypeBundle {
 		return ErrUnsupportedTxType
 	}
+	if tx.Type == TxTypeBundle && len(tx.Bundle) == 0 {
+		return ErrEmptyBundle
+	}
 	if tx.Raw == "" {
 		return ErrEmptyRawTransaction
 	}
@@ -403,7 +536,13 @@ func (t *TxSender) Enqueue(ctx context.Context, tx *Transaction) error {
 		return nil
 	}
 
-	if err := t.hasCorrectNonce(ctx, tx); err != nil {
+	if tx.Type == TxTypeBundle {
+		// Bundles may carry transactions from other accounts, so the nonces
+		// are only verified by the simulation.
+		if _, ok := t.simulator.(BundleSimulator); !ok {
+			return ErrBundleNotSupported
+		}
+	} else if err := t.hasCorrectNonce(ctx, tx); err != nil {
 		return err
 	}
 
@@ -444,6 +583,50 @@ func (t *TxSender) signalReceiptAvailable(txnHash common.Hash) {
 	delete(t.receiptSignal, txnHash)
 }
 
+// SubscribeCommitments returns a channel on which every commitment received
+// for the transaction is delivered. The channel is closed once the context
+// is done. Commitments are dropped for subscribers which do not keep up.
+func (t *TxSender) SubscribeCommitments(
+	ctx context.Context,
+	txnHash common.Hash,
+) <-chan *bidderapiv1.Commitment {
+	ch := make(chan *bidderapiv1.Commitment, 16)
+	t.commitmentSubsMtx.Lock()
+	t.commitmentSubs[txnHash] = append(t.commitmentSubs[txnHash], ch)
+	t.commitmentSubsMtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		t.commitmentSubsMtx.Lock()
+		defer t.commitmentSubsMtx.Unlock()
+
+		subs := slices.DeleteFunc(t.commitmentSubs[txnHash], func(c chan *bidderapiv1.Commitment) bool {
+			return c == ch
+		})
+		if len(subs) == 0 {
+			delete(t.commitmentSubs, txnHash)
+		} else {
+			t.commitmentSubs[txnHash] = subs
+		}
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (t *TxSender) publishCommitment(txnHash common.Hash, cmt *bidderapiv1.Commitment) {
+	t.commitmentSubsMtx.Lock()
+	defer t.commitmentSubsMtx.Unlock()
+
+	for _, ch := range t.commitmentSubs[txnHash] {
+		select {
+		case ch <- cmt:
+		default:
+			t.logger.Warn("Dropping commitment for slow subscriber", "txnHash", txnHash.Hex())
+		}
+	}
+}
+
 func (t *TxSender) CancelTransaction(ctx context.Context, txnHash common.Hash) (bool, error) {
 	t.inflightMu.RLock()
 	cancel, found := t.inflightTxns[txnHash]
@@ -656,7 +839,9 @@ func (t *TxSender) processTransaction(ctx context.Context, txn *Transaction, can
 
 	retryTicker := time.NewTicker(defaultRetryDelay)
 	defer retryTicker.Stop()
-	inclusion := t.blockTracker.WaitForTxnInclusion(txn.Hash())
+	inclusionCtx, cancelInclusion := context.WithCancel(ctx)
+	defer cancelInclusion()
+	inclusion := t.waitForInclusion(inclusionCtx, txn)
 
 BID_LOOP:
 	for {
@@ -776,6 +961,52 @@ BID_LOOP:
 	return nil
 }
 
+// waitForInclusion returns a channel which receives the block number once the
+// transaction is included. For bundles every transaction is tracked and the
+// block number is sent once all of them are included.
+func (t *TxSender) waitForInclusion(ctx context.Context, txn *Transaction) <-chan uint64 {
+	if txn.Type != TxTypeBundle {
+		return t.blockTracker.WaitForTxnInclusion(txn.Hash())
+	}
+
+	inclusions := make([]chan uint64, 0, len(txn.Bundle))
+	for _, tx := range txn.Bundle {
+		inclusions = append(inclusions, t.blockTracker.WaitForTxnInclusion(tx.Hash()))
+	}
+
+	included := make(chan uint64, 1)
+	go func() {
+		var blockNumber uint64
+		for _, inclusion := range inclusions {
+			select {
+			case <-ctx.Done():
+				return
+			case bNo := <-inclusion:
+				blockNumber = max(blockNumber, bNo)
+			}
+		}
+		included <- blockNumber
+	}()
+	return included
+}
+
+func (t *TxSender) simulate(ctx context.Context, txn *Transaction, state sim.SimState) ([]*types.Log, bool, error) {
+	if txn.Type != TxTypeBundle {
+		return t.simulator.Simulate(ctx, txn.Raw, state)
+	}
+
+	bundleSim, ok := t.simulator.(BundleSimulator)
+	if !ok {
+		return nil, false, ErrBundleNotSupported
+	}
+	rawTxs, err := txn.rawTransactions()
+	if err != nil {
+		return nil, false, err
+	}
+	logs, err := bundleSim.SimulateBundle(ctx, rawTxs, txn.RevertingTxHashes, state)
+	return logs, false, err
+}
+
 type errRetry struct {
 	err        error
 	retryAfter time.Duration
@@ -823,6 +1054,19 @@ func (t *TxSender) sendBid(
 	}
 	logger.Debug("Next block info", "bidBlockNo", bidBlockNo, "timeUntilNextBlock", timeUntilNextBlock)
 
+	if txn.TargetBlock > 0 {
+		switch {
+		case bidBlockNo > txn.TargetBlock:
+			return bidResult{}, fmt.Errorf("%w: target %d, next %d", ErrTargetBlockPassed, txn.TargetBlock, bidBlockNo)
+		case bidBlockNo < txn.TargetBlock:
+			logger.Debug("Waiting for bundle target block", "targetBlock", txn.TargetBlock, "bidBlockNo", bidBlockNo)
+			return bidResult{}, &errRetry{
+				err:        fmt.Errorf("waiting for target block %d", txn.TargetBlock),
+				retryAfter: timeUntilNextBlock,
+			}
+		}
+	}
+
 	if timeUntilNextBlock <= 500*time.Millisecond {
 		logger.Warn("Next block time is too short, skipping bid", "timeUntilNextBlock", timeUntilNextBlock)
 		return bidResult{}, &errRetry{
@@ -852,7 +1096,7 @@ func (t *TxSender) sendBid(
 		}
 	}
 
-	feePerGas := effectiveFeePerGas(txn.Transaction)
+	feePerGas := txn.feePerGas()
 	nextBaseFee := t.blockTracker.NextBaseFee()
 	latestBaseFee := t.blockTracker.LatestBaseFee()
 	if nextBaseFee.Sign() == 0 {
@@ -893,6 +1137,18 @@ func (t *TxSender) sendBid(
 			logger.Error("Insufficient balance for sender")
 			return bidResult{}, fmt.Errorf("insufficient balance for sender: %s", txn.Sender.Hex())
 		}
+	case TxTypeBundle:
+		costs, err := txn.senderCosts(cost)
+		if err != nil {
+			logger.Error("Failed to split bundle cost between senders", "error", err)
+			return bidResult{}, err
+		}
+		for _, c := range costs {
+			if !t.store.HasBalance(ctx, c.sender, c.amount) {
+				logger.Error("Insufficient balance for bundle sender", "bundleSender", c.sender.Hex(), "cost", c.amount.String())
+				return bidResult{}, fmt.Errorf("insufficient balance for sender: %s", c.sender.Hex())
+			}
+		}
 	case TxTypeDeposit:
 		if txn.Value().Cmp(cost) < 0 {
 			logger.Error(
@@ -936,7 +1192,7 @@ func (t *TxSender) sendBid(
 	// This prevents bidding with stale data after a sim failure while
 	// avoiding redundant sim calls when sim already passed within the same block.
 	if !isRetry || txn.simFailed {
-		logs, isSwap, err := t.simulator.Simulate(ctx, txn.Raw, state)
+		logs, isSwap, err := t.simulate(ctx, txn, state)
 		if err != nil {
 			txn.simFailed = true
 			logger.Error("Failed to simulate transaction", "error", err, "blockNumber", bidBlockNo)
@@ -969,16 +1225,27 @@ func (t *TxSender) sendBid(
 		}
 	}
 
+	rawTxs, err := txn.rawTransactions()
+	if err != nil {
+		logger.Error("Failed to encode raw transactions", "error", err)
+		return bidResult{}, fmt.Errorf("failed to encode raw transactions: %w", err)
+	}
+
+	revertingTxHashes := []string{txn.Hash().Hex()}
+	if txn.Type == TxTypeBundle {
+		revertingTxHashes = txn.RevertingTxHashes
+	}
+
 	bidStart := time.Now()
 	bidC, err := t.bidder.Bid(
 		cctx,
 		cost,
 		slashAmount,
-		strings.TrimPrefix(txn.Raw, "0x"),
+		rawTxs,
 		&bidder.BidOpts{
 			WaitForOptIn:      false,
 			BlockNumber:       uint64(bidBlockNo),
-			RevertingTxHashes: []string{txn.Hash().Hex()},
+			RevertingTxHashes: revertingTxHashes,
 			DecayDuration:     t.getBidTimeout() * 2,
 			Constraint:        txn.Constraint,
 			IgnoreProviders:   ignoreProviders,
@@ -1015,6 +1282,7 @@ BID_LOOP:
 				}
 				cmt := bidStatus.Arg.(*bidderapiv1.Commitment)
 				txn.commitments = append(txn.commitments, cmt)
+				t.publishCommitment(txn.Hash(), cmt)
 				if t.fastTrack(txn.commitments, optedInSlot) && txn.Status != TxStatusPreConfirmed {
 					txn.Status = TxStatusPreConfirmed
 					txn.BlockNumber = int64(bidBlockNo)
@@ -1137,7 +1405,7 @@ func (t *TxSender) calculatePriceForNextBlock(
 			// the gwei value is in float, so we need to convert it to wei before multiplying with gas limit
 			priceInWei := price * 1e9 // Convert Gwei to Wei
 			t.metrics.bidPriorityFee.Set(price)
-			return new(big.Int).Mul(big.NewInt(int64(priceInWei)), big.NewInt(int64(txn.Gas()))), isRetry, nil
+			return new(big.Int).Mul(big.NewInt(int64(priceInWei)), new(big.Int).SetUint64(txn.gasLimit())), isRetry, nil
 		}
 	}
 
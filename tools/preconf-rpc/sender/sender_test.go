@@ -2,16 +2,19 @@ package sender_test
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"io"
 	"math/big"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	bidderapiv1 "github.com/primev/mev-commit/p2p/gen/go/bidderapi/v1"
 	"github.com/primev/mev-commit/tools/preconf-rpc/bidder"
 	"github.com/primev/mev-commit/tools/preconf-rpc/sender"
@@ -172,7 +175,7 @@ func (m *mockStore) StoreReceipt(
 type bidOp struct {
 	bidAmount   *big.Int
 	slashAmount *big.Int
-	rawTx       string
+	rawTxs      []string
 	opts        *bidder.BidOpts
 }
 
@@ -191,13 +194,13 @@ func (m *mockBidder) Bid(
 	ctx context.Context,
 	bidAmount *big.Int,
 	slashAmount *big.Int,
-	rawTx string,
+	rawTxs []string,
 	opts *bidder.BidOpts,
 ) (chan bidder.BidStatus, error) {
 	m.in <- bidOp{
 		bidAmount:   bidAmount,
 		slashAmount: slashAmount,
-		rawTx:       rawTx,
+		rawTxs:      rawTxs,
 		opts:        opts,
 	}
 	res := <-m.out
@@ -378,6 +381,7 @@ func TestSender(t *testing.T) {
 	}
 
 	waitCh := sndr.WaitForReceiptAvailable(ctx, tx1.Hash())
+	cmtCh := sndr.SubscribeCommitments(ctx, tx1.Hash())
 
 	// Simulate opted in block
 	bidderImpl.optinEstimate <- 2
@@ -407,8 +411,8 @@ func TestSender(t *testing.T) {
 
 	// Simulate a bid response
 	bidOp := <-bidderImpl.in
-	if bidOp.rawTx != tx1.Raw[2:] {
-		t.Fatalf("expected raw transaction %s, got %s", tx1.Raw, bidOp.rawTx)
+	if len(bidOp.rawTxs) != 1 || bidOp.rawTxs[0] != tx1.Raw[2:] {
+		t.Fatalf("expected raw transaction %s, got %v", tx1.Raw, bidOp.rawTxs)
 	}
 	resC := make(chan bidder.BidStatus, 3)
 	resC <- bidder.BidStatus{
@@ -457,6 +461,12 @@ func TestSender(t *testing.T) {
 	if len(res.commitments) != 2 {
 		t.Fatalf("expected 2 commitments, got %d", len(res.commitments))
 	}
+	for _, provider := range []string{"provider1", "provider2"} {
+		cmt := <-cmtCh
+		if cmt.ProviderAddress != provider {
+			t.Fatalf("expected commitment from %s, got %s", provider, cmt.ProviderAddress)
+		}
+	}
 
 	tx2 := &sender.Transaction{
 		Transaction: types.NewTransaction(
@@ -495,8 +505,8 @@ func TestSender(t *testing.T) {
 
 	// Simulate a bid response
 	bidOp = <-bidderImpl.in
-	if bidOp.rawTx != tx2.Raw[2:] {
-		t.Fatalf("expected raw transaction %s, got %s", tx1.Raw, bidOp.rawTx)
+	if len(bidOp.rawTxs) != 1 || bidOp.rawTxs[0] != tx2.Raw[2:] {
+		t.Fatalf("expected raw transaction %s, got %v", tx1.Raw, bidOp.rawTxs)
 	}
 	resC = make(chan bidder.BidStatus, 3)
 	// Simulate retry due to incomplete commitments
@@ -525,8 +535,8 @@ func TestSender(t *testing.T) {
 
 	// Simulate a bid response
 	bidOp = <-bidderImpl.in
-	if bidOp.rawTx != tx2.Raw[2:] {
-		t.Fatalf("expected raw transaction %s, got %s", tx1.Raw, bidOp.rawTx)
+	if len(bidOp.rawTxs) != 1 || bidOp.rawTxs[0] != tx2.Raw[2:] {
+		t.Fatalf("expected raw transaction %s, got %v", tx1.Raw, bidOp.rawTxs)
 	}
 	resC = make(chan bidder.BidStatus, 3)
 	resC <- bidder.BidStatus{
@@ -746,8 +756,8 @@ func TestIgnoreProvidersOnRetry(t *testing.T) {
 
 	// Simulate a bid response
 	bidOp := <-bidderImpl.in
-	if bidOp.rawTx != tx1.Raw[2:] {
-		t.Fatalf("expected raw transaction %s, got %s", tx1.Raw, bidOp.rawTx)
+	if len(bidOp.rawTxs) != 1 || bidOp.rawTxs[0] != tx1.Raw[2:] {
+		t.Fatalf("expected raw transaction %s, got %v", tx1.Raw, bidOp.rawTxs)
 	}
 	resC := make(chan bidder.BidStatus, 3)
 	resC <- bidder.BidStatus{
@@ -804,3 +814,244 @@ func TestIgnoreProvidersOnRetry(t *testing.T) {
 	cancel()
 	<-done
 }
+
+type mockBundleSimulator struct {
+	mockSimulator
+	bundles chan []string
+}
+
+func (m *mockBundleSimulator) SimulateBundle(
+	ctx context.Context,
+	rawTxs []string,
+	revertingTxHashes []string,
+	_ sim.SimState,
+) ([]*types.Log, error) {
+	m.bundles <- rawTxs
+	return []*types.Log{}, nil
+}
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	searcherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	searcher := crypto.PubkeyToAddress(searcherKey.PublicKey)
+	user := crypto.PubkeyToAddress(userKey.PublicKey)
+
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	newBundle := func(searcherKey, userKey *ecdsa.PrivateKey, targetBlock uint64) *sender.Transaction {
+		bundle := []*types.Transaction{
+			types.MustSignNewTx(searcherKey, signer, &types.LegacyTx{
+				To:       &common.Address{0x01},
+				Value:    big.NewInt(100),
+				Gas:      21000,
+				GasPrice: big.NewInt(1),
+			}),
+			types.MustSignNewTx(userKey, signer, &types.LegacyTx{
+				To:       &common.Address{0x02},
+				Value:    big.NewInt(100),
+				Gas:      50000,
+				GasPrice: big.NewInt(1),
+			}),
+		}
+		return &sender.Transaction{
+			Transaction:       bundle[0],
+			Sender:            crypto.PubkeyToAddress(searcherKey.PublicKey),
+			Type:              sender.TxTypeBundle,
+			Raw:               "0x1234567890123456789012345678901234567890",
+			Bundle:            bundle,
+			RevertingTxHashes: []string{bundle[1].Hash().Hex()},
+			TargetBlock:       targetBlock,
+		}
+	}
+
+	t.Run("unsupported simulator", func(t *testing.T) {
+		sndr, err := sender.NewTxSender(
+			newMockStore(),
+			&mockBidder{},
+			&mockPricer{},
+			&mockBlockTracker{},
+			&mockTransferer{},
+			&mockNotifier{},
+			&mockSimulator{},
+			&mockBackrunner{},
+			big.NewInt(1), // Settlement chain ID
+			&MockExplorerSubmitter{},
+			nil, // no log encryption key in tests
+			util.NewTestLogger(io.Discard),
+		)
+		if err != nil {
+			t.Fatalf("failed to create sender: %v", err)
+		}
+
+		err = sndr.Enqueue(context.Background(), newBundle(searcherKey, userKey, 0))
+		if !errors.Is(err, sender.ErrBundleNotSupported) {
+			t.Fatalf("expected error %v, got %v", sender.ErrBundleNotSupported, err)
+		}
+	})
+
+	st := newMockStore()
+	testPricer := &mockPricer{
+		out: make(chan map[int64]float64, 10),
+	}
+	bidderImpl := &mockBidder{
+		optinEstimate: make(chan int64, 10),
+		in:            make(chan bidOp, 10),
+		out:           make(chan chan bidder.BidStatus, 10),
+	}
+	blockTracker := &mockBlockTracker{
+		out:   make(chan uint64, 10),
+		bnIn:  make(chan struct{}, 10),
+		bnOut: make(chan blockNoOp, 10),
+		bnErr: make(chan error, 1),
+	}
+	simulator := &mockBundleSimulator{
+		bundles: make(chan []string, 10),
+	}
+
+	sndr, err := sender.NewTxSender(
+		st,
+		bidderImpl,
+		testPricer,
+		blockTracker,
+		&mockTransferer{},
+		&mockNotifier{},
+		simulator,
+		&mockBackrunner{},
+		big.NewInt(1), // Settlement chain ID
+		&MockExplorerSubmitter{},
+		nil, // no log encryption key in tests
+		util.NewTestLogger(io.Discard),
+	)
+	if err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := sndr.Start(ctx)
+
+	bundle := newBundle(searcherKey, userKey, 2)
+	// Both senders pay their share of the bid
+	for _, account := range []common.Address{searcher, user} {
+		if err := st.AddBalance(ctx, account, big.NewInt(5e18)); err != nil {
+			t.Fatalf("failed to add balance: %v", err)
+		}
+	}
+	if err := sndr.Enqueue(ctx, bundle); err != nil {
+		t.Fatalf("failed to enqueue bundle: %v", err)
+	}
+
+	// The bundle targets block 2, no bid should be placed for block 1
+	bidderImpl.optinEstimate <- 2
+	<-blockTracker.bnIn
+	blockTracker.bnOut <- blockNoOp{
+		block:             1,
+		timeTillNextBlock: 100 * time.Millisecond,
+	}
+
+	bidderImpl.optinEstimate <- 2
+	<-blockTracker.bnIn
+	blockTracker.bnOut <- blockNoOp{
+		block:             2,
+		timeTillNextBlock: 2 * time.Second,
+	}
+
+	testPricer.out <- map[int64]float64{
+		70: 0.8,
+		75: 1.0,
+		80: 1.5,
+		85: 2.0,
+	}
+
+	simulated := <-simulator.bundles
+	if len(simulated) != 2 {
+		t.Fatalf("expected 2 simulated transactions, got %d", len(simulated))
+	}
+
+	bidOp := <-bidderImpl.in
+	if bidOp.opts.BlockNumber != 2 {
+		t.Fatalf("expected bid for block 2, got %d", bidOp.opts.BlockNumber)
+	}
+	if len(bidOp.rawTxs) != 2 {
+		t.Fatalf("expected 2 raw transactions, got %d", len(bidOp.rawTxs))
+	}
+	for i, btx := range bundle.Bundle {
+		buf, err := btx.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bidOp.rawTxs[i] != common.Bytes2Hex(buf) {
+			t.Fatalf("unexpected raw transaction at index %d", i)
+		}
+	}
+	if len(bidOp.opts.RevertingTxHashes) != 1 || bidOp.opts.RevertingTxHashes[0] != bundle.Bundle[1].Hash().Hex() {
+		t.Fatalf("unexpected reverting tx hashes %v", bidOp.opts.RevertingTxHashes)
+	}
+
+	resC := make(chan bidder.BidStatus, 2)
+	for _, provider := range []string{"provider1", "provider2"} {
+		resC <- bidder.BidStatus{
+			Type: bidder.BidStatusCommitment,
+			Arg: &bidderapiv1.Commitment{
+				TxHashes:        []string{bundle.Bundle[0].Hash().Hex(), bundle.Bundle[1].Hash().Hex()},
+				BidAmount:       big.NewInt(100).String(),
+				BlockNumber:     2,
+				ProviderAddress: provider,
+			},
+		}
+	}
+	close(resC)
+	bidderImpl.out <- resC
+
+	res := <-st.preconfirmedTxns
+	if res.txn.Type != sender.TxTypeBundle {
+		t.Fatalf("expected bundle, got type %d", res.txn.Type)
+	}
+	if res.txn.Status != sender.TxStatusPreConfirmed {
+		t.Fatalf("expected status %s, got %s", sender.TxStatusPreConfirmed, res.txn.Status)
+	}
+	if res.blockNumber != 2 {
+		t.Fatalf("expected block number 2, got %d", res.blockNumber)
+	}
+	if len(res.commitments) != 2 {
+		t.Fatalf("expected 2 commitments, got %d", len(res.commitments))
+	}
+
+	// Every transaction of the bundle is tracked for inclusion
+	blockTracker.out <- 2
+	blockTracker.out <- 2
+
+	// A bundle whose target block has passed fails without bidding
+	lateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	late := newBundle(lateKey, userKey, 1)
+	if err := sndr.Enqueue(ctx, late); err != nil {
+		t.Fatalf("failed to enqueue bundle: %v", err)
+	}
+
+	bidderImpl.optinEstimate <- 2
+	<-blockTracker.bnIn
+	blockTracker.bnOut <- blockNoOp{
+		block:             3,
+		timeTillNextBlock: 2 * time.Second,
+	}
+
+	res = <-st.preconfirmedTxns
+	if res.txn.Status != sender.TxStatusFailed {
+		t.Fatalf("expected status %s, got %s", sender.TxStatusFailed, res.txn.Status)
+	}
+	if !strings.Contains(res.txn.Details, sender.ErrTargetBlockPassed.Error()) {
+		t.Fatalf("unexpected details %q", res.txn.Details)
+	}
+
+	cancel()
+	<-done
+}
@@ -128,25 +128,154 @@ func (s *InlineSimulator) Simulate(ctx context.Context, txRaw string, state SimS
 
 	s.metrics.attempts.Inc()
 
-	rawBytes, err := hex.DecodeString(strings.TrimPrefix(txRaw, "0x"))
+	callObj, _, err := buildCallObject(txRaw)
+	if err != nil {
+		s.metrics.fail.Inc()
+		return nil, false, err
+	}
+
+	logs, isSwap, err := s.simulateWithFallback(ctx, callObj, state)
 	if err != nil {
 		s.metrics.fail.Inc()
-		return nil, false, fmt.Errorf("invalid hex: %w", err)
+		return nil, false, err
+	}
+
+	s.metrics.success.Inc()
+	return logs, isSwap, nil
+}
+
+// SimulateBundle runs the transactions of a bundle one after the other in a
+// single simulated block and returns the logs of the bundle. Transactions
+// listed in revertingTxHashes are allowed to revert, any other revert fails
+// the bundle. Bundles require eth_simulateV1 as debug_traceCall cannot carry
+// state across calls.
+func (s *InlineSimulator) SimulateBundle(
+	ctx context.Context,
+	rawTxs []string,
+	revertingTxHashes []string,
+	state SimState,
+) ([]*types.Log, error) {
+	start := time.Now()
+	defer func() {
+		s.metrics.latency.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	s.metrics.attempts.Inc()
+
+	canRevert := make(map[common.Hash]struct{}, len(revertingTxHashes))
+	for _, h := range revertingTxHashes {
+		canRevert[common.HexToHash(h)] = struct{}{}
+	}
+
+	calls := make([]map[string]interface{}, 0, len(rawTxs))
+	hashes := make([]common.Hash, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		callObj, txHash, err := buildCallObject(rawTx)
+		if err != nil {
+			s.metrics.fail.Inc()
+			return nil, err
+		}
+		calls = append(calls, callObj)
+		hashes = append(hashes, txHash)
+	}
+
+	simRequest := map[string]interface{}{
+		"blockStateCalls": []map[string]interface{}{
+			{"calls": calls},
+		},
+		"validation": true,
+	}
+
+	var lastErr error
+	for i, endpoint := range s.endpoints {
+		var result []SimulateV1Block
+		err := endpoint.client.CallContext(ctx, &result, "eth_simulateV1", simRequest, string(state))
+		if err == nil {
+			logs, err := bundleLogs(result, hashes, canRevert)
+			if err != nil {
+				s.metrics.fail.Inc()
+				return nil, err
+			}
+			s.metrics.success.Inc()
+			return logs, nil
+		}
+
+		if isMethodNotSupported(err) {
+			err = &NonRetryableError{Err: fmt.Errorf("eth_simulateV1 is required for bundle simulation: %w", err)}
+		}
+
+		lastErr = err
+		if !shouldFallback(err) {
+			s.metrics.fail.Inc()
+			return nil, err
+		}
+
+		s.logger.Warn("endpoint failed, trying next",
+			"endpointIndex", i,
+			"error", err,
+			"remainingEndpoints", len(s.endpoints)-i-1,
+		)
+	}
+
+	s.metrics.fail.Inc()
+	return nil, fmt.Errorf("all endpoints failed: %w", lastErr)
+}
+
+func bundleLogs(
+	result []SimulateV1Block,
+	hashes []common.Hash,
+	canRevert map[common.Hash]struct{},
+) ([]*types.Log, error) {
+	if len(result) == 0 {
+		return nil, &NonRetryableError{Err: errors.New("empty response from eth_simulateV1")}
+	}
+	block := result[0]
+	if len(block.Calls) != len(hashes) {
+		return nil, &NonRetryableError{Err: fmt.Errorf(
+			"expected %d calls in eth_simulateV1 response, got %d",
+			len(hashes),
+			len(block.Calls),
+		)}
+	}
+
+	var traceLogs []TraceLog
+	for i, call := range block.Calls {
+		if call.Status == 0 {
+			if _, ok := canRevert[hashes[i]]; ok {
+				continue
+			}
+			reason := "execution reverted"
+			if call.Error != nil && call.Error.Message != "" {
+				reason = call.Error.Message
+			} else if len(call.ReturnData) > 0 {
+				reason = decodeRevert(hexutil.Encode(call.ReturnData), reason)
+			}
+			return nil, &NonRetryableError{Err: fmt.Errorf("reverted: %s (tx=%s)", reason, hashes[i].Hex())}
+		}
+		traceLogs = append(traceLogs, call.Logs...)
+	}
+
+	return convertTraceLogs(traceLogs), nil
+}
+
+// buildCallObject decodes a raw transaction into a call object. We use
+// "input" here; debug_traceCall expects "data" so we convert later.
+func buildCallObject(txRaw string) (map[string]interface{}, common.Hash, error) {
+	rawBytes, err := hex.DecodeString(strings.TrimPrefix(txRaw, "0x"))
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("invalid hex: %w", err)
 	}
 
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(rawBytes); err != nil {
-		s.metrics.fail.Inc()
-		return nil, false, fmt.Errorf("invalid transaction: %w", err)
+		return nil, common.Hash{}, fmt.Errorf("invalid transaction: %w", err)
 	}
 
 	sender, err := recoverSender(tx)
 	if err != nil {
-		s.metrics.fail.Inc()
-		return nil, false, fmt.Errorf("failed to recover sender: %w", err)
+		return nil, common.Hash{}, fmt.Errorf("failed to recover sender: %w", err)
 	}
 
-	// Build call object. We use "input" here; debug_traceCall expects "data" so we convert later.
 	callObj := map[string]interface{}{
 		"from":  sender.Hex(),
 		"gas":   hexutil.Uint64(tx.Gas()),
@@ -165,14 +294,7 @@ func (s *InlineSimulator) Simulate(ctx context.Context, txRaw string, state SimS
 		callObj["gasPrice"] = hexutil.EncodeBig(tx.GasPrice())
 	}
 
-	logs, isSwap, err := s.simulateWithFallback(ctx, callObj, state)
-	if err != nil {
-		s.metrics.fail.Inc()
-		return nil, false, err
-	}
-
-	s.metrics.success.Inc()
-	return logs, isSwap, nil
+	return callObj, tx.Hash(), nil
 }
 
 // simulateWithFallback tries endpoints in order, using eth_simulateV1 first then debug_traceCall.
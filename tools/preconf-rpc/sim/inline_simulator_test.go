@@ -851,3 +851,93 @@ func TestSwapSignatures(t *testing.T) {
 		}
 	})
 }
+
+func TestSimulateBundle(t *testing.T) {
+	// Second call reverts, first one emits a log
+	bundleResponse := `[{
+		"number": "0x1",
+		"gasUsed": "0x15208",
+		"calls": [{
+			"status": "0x1",
+			"gasUsed": "0x5208",
+			"returnData": "0x",
+			"logs": [{
+				"address": "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+				"topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+				"data": "0x"
+			}]
+		}, {
+			"status": "0x0",
+			"gasUsed": "0x10000",
+			"returnData": "0x",
+			"logs": [],
+			"error": {"code": 3, "message": "execution reverted"}
+		}]
+	}]`
+
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+			ID     int               `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if req.Method != "eth_simulateV1" {
+			t.Errorf("unexpected method %s", req.Method)
+		}
+		var simReq struct {
+			BlockStateCalls []struct {
+				Calls []json.RawMessage `json:"calls"`
+			} `json:"blockStateCalls"`
+		}
+		if err := json.Unmarshal(req.Params[0], &simReq); err != nil {
+			t.Errorf("invalid simulation request: %v", err)
+		}
+		calls = len(simReq.BlockStateCalls[0].Calls)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  json.RawMessage(bundleResponse),
+		})
+	}))
+	defer srv.Close()
+
+	simulator, err := sim.NewInlineSimulator([]string{srv.URL}, nil)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	defer func() { _ = simulator.Close() }()
+
+	rawTxs := []string{realTxVectors[0].rawHex, realTxVectors[1].rawHex}
+
+	_, err = simulator.SimulateBundle(context.Background(), rawTxs, nil, sim.Latest)
+	if err == nil {
+		t.Fatal("expected error for reverted bundle transaction")
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls in a single block, got %d", calls)
+	}
+
+	rawBytes, err := hex.DecodeString(realTxVectors[1].rawHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(rawBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	logs, err := simulator.SimulateBundle(context.Background(), rawTxs, []string{tx.Hash().Hex()}, sim.Latest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("expected 1 log, got %d", len(logs))
+	}
+}
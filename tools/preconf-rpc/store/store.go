@@ -94,6 +94,16 @@ CREATE TABLE IF NOT EXISTS receipts (
 	FOREIGN KEY (transaction_hash) REFERENCES mcTransactions (hash) ON DELETE CASCADE
 );`
 
+var bundlesTable = `
+CREATE TABLE IF NOT EXISTS bundles (
+	transaction_hash TEXT PRIMARY KEY,
+	bundle_hash TEXT UNIQUE,
+	raw_transactions TEXT[],
+	reverting_tx_hashes TEXT[],
+	target_block BIGINT,
+	FOREIGN KEY (transaction_hash) REFERENCES mcTransactions (hash) ON DELETE CASCADE
+);`
+
 type rpcstore struct {
 	db *sql.DB
 }
@@ -108,6 +118,7 @@ func New(db *sql.DB) (*rpcstore, error) {
 		swapInfo,
 		settlementInfo,
 		receipts,
+		bundlesTable,
 	} {
 		_, err := db.Exec(table)
 		if err != nil {
@@ -148,7 +159,12 @@ func (s *rpcstore) AddQueuedTransaction(ctx context.Context, tx *sender.Transact
 		raw_transaction = EXCLUDED.raw_transaction
 	WHERE mcTransactions.status != 'confirmed' AND mcTransactions.status != 'pre-confirmed';
 	`
-	_, err = s.db.ExecContext(
+	dbTxn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	_, err = dbTxn.ExecContext(
 		ctx,
 		insertQuery,
 		tx.Hash().Hex(),
@@ -160,9 +176,70 @@ func (s *rpcstore) AddQueuedTransaction(ctx context.Context, tx *sender.Transact
 		cBuf,
 	)
 	if err != nil {
+		_ = dbTxn.Rollback()
 		return fmt.Errorf("failed to add queued transaction: %w", err)
 	}
 
+	if tx.Type == sender.TxTypeBundle {
+		rawTxs := make([]string, 0, len(tx.Bundle))
+		for _, btx := range tx.Bundle {
+			buf, err := btx.MarshalBinary()
+			if err != nil {
+				_ = dbTxn.Rollback()
+				return fmt.Errorf("failed to marshal bundle transaction: %w", err)
+			}
+			rawTxs = append(rawTxs, hex.EncodeToString(buf))
+		}
+		insertBundle := `
+		INSERT INTO bundles (transaction_hash, bundle_hash, raw_transactions, reverting_tx_hashes, target_block)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (transaction_hash) DO UPDATE
+		SET bundle_hash = EXCLUDED.bundle_hash,
+		    raw_transactions = EXCLUDED.raw_transactions,
+		    reverting_tx_hashes = EXCLUDED.reverting_tx_hashes,
+		    target_block = EXCLUDED.target_block;
+		`
+		_, err = dbTxn.ExecContext(
+			ctx,
+			insertBundle,
+			tx.Hash().Hex(),
+			tx.BundleHash().Hex(),
+			pq.Array(rawTxs),
+			pq.Array(tx.RevertingTxHashes),
+			int64(tx.TargetBlock),
+		)
+		if err != nil {
+			_ = dbTxn.Rollback()
+			return fmt.Errorf("failed to add bundle: %w", err)
+		}
+	}
+
+	if err := dbTxn.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// setBundle populates the bundle fields of a transaction read from the
+// bundles table.
+func setBundle(txn *sender.Transaction, rawTxs, revertingTxHashes []string, targetBlock sql.NullInt64) error {
+	if txn.Type != sender.TxTypeBundle {
+		return nil
+	}
+	for _, raw := range rawTxs {
+		buf, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+		if err != nil {
+			return fmt.Errorf("failed to decode bundle transaction: %w", err)
+		}
+		btx := new(types.Transaction)
+		if err := btx.UnmarshalBinary(buf); err != nil {
+			return fmt.Errorf("failed to unmarshal bundle transaction: %w", err)
+		}
+		txn.Bundle = append(txn.Bundle, btx)
+	}
+	txn.RevertingTxHashes = revertingTxHashes
+	txn.TargetBlock = uint64(targetBlock.Int64)
 	return nil
 }
 
@@ -178,8 +255,22 @@ func parseTransactionsFromRows(rows *sql.Rows) ([]*sender.Transaction, error) {
 			details        sql.NullString
 			options        []byte
 			pbOption       *bidderapiv1.PositionConstraint
+			bundleTxs      []string
+			revertingTxs   []string
+			targetBlock    sql.NullInt64
+		)
+		err := rows.Scan(
+			&rawTransaction,
+			&blockNum,
+			&senderAddress,
+			&txType,
+			&status,
+			&details,
+			&options,
+			pq.Array(&bundleTxs),
+			pq.Array(&revertingTxs),
+			&targetBlock,
 		)
-		err := rows.Scan(&rawTransaction, &blockNum, &senderAddress, &txType, &status, &details, &options)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
@@ -207,6 +298,9 @@ func parseTransactionsFromRows(rows *sql.Rows) ([]*sender.Transaction, error) {
 			Details:     details.String,
 			Constraint:  pbOption,
 		}
+		if err := setBundle(txn, bundleTxs, revertingTxs, targetBlock); err != nil {
+			return nil, err
+		}
 		transactions = append(transactions, txn)
 	}
 	if err := rows.Err(); err != nil {
@@ -219,8 +313,10 @@ func parseTransactionsFromRows(rows *sql.Rows) ([]*sender.Transaction, error) {
 // GetQueuedTransactions retrieves the next pending transaction for each sender.
 func (s *rpcstore) GetQueuedTransactions(ctx context.Context) ([]*sender.Transaction, error) {
 	query := `
-	SELECT t1.raw_transaction, t1.block_number, t1.sender, t1.tx_type, t1.status, t1.details, t1.options
+	SELECT t1.raw_transaction, t1.block_number, t1.sender, t1.tx_type, t1.status, t1.details, t1.options,
+		b.raw_transactions, b.reverting_tx_hashes, b.target_block
 	FROM mcTransactions t1
+	LEFT JOIN bundles b ON b.transaction_hash = t1.hash
 	INNER JOIN (
 		SELECT sender, MIN(nonce) AS min_nonce
 		FROM mcTransactions
@@ -253,11 +349,45 @@ func (s *rpcstore) GetQueuedTransactions(ctx context.Context) ([]*sender.Transac
 
 func (s *rpcstore) GetTransactionByHash(ctx context.Context, txnHash common.Hash) (*sender.Transaction, error) {
 	query := `
-	SELECT raw_transaction, block_number, sender, tx_type, status, details, options
-	FROM mcTransactions
-	WHERE hash = $1;
+	SELECT t.raw_transaction, t.block_number, t.sender, t.tx_type, t.status, t.details, t.options,
+		b.raw_transactions, b.reverting_tx_hashes, b.target_block
+	FROM mcTransactions t
+	LEFT JOIN bundles b ON b.transaction_hash = t.hash
+	WHERE t.hash = $1;
 	`
 	row := s.db.QueryRowContext(ctx, query, txnHash.Hex())
+	txn, err := scanTransaction(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("transaction %s not found: %w", txnHash.Hex(), ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get transaction by hash: %w", err)
+	}
+	return txn, nil
+}
+
+// GetBundle retrieves a bundle by its bundle hash. The returned transaction
+// is the first transaction of the bundle.
+func (s *rpcstore) GetBundle(ctx context.Context, bundleHash common.Hash) (*sender.Transaction, error) {
+	query := `
+	SELECT t.raw_transaction, t.block_number, t.sender, t.tx_type, t.status, t.details, t.options,
+		b.raw_transactions, b.reverting_tx_hashes, b.target_block
+	FROM bundles b
+	INNER JOIN mcTransactions t ON t.hash = b.transaction_hash
+	WHERE b.bundle_hash = $1;
+	`
+	row := s.db.QueryRowContext(ctx, query, bundleHash.Hex())
+	txn, err := scanTransaction(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("bundle %s not found: %w", bundleHash.Hex(), ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get bundle: %w", err)
+	}
+	return txn, nil
+}
+
+func scanTransaction(row *sql.Row) (*sender.Transaction, error) {
 	var (
 		rawTransaction string
 		senderAddress  string
@@ -267,13 +397,24 @@ func (s *rpcstore) GetTransactionByHash(ctx context.Context, txnHash common.Hash
 		details        sql.NullString
 		options        []byte
 		pbOption       *bidderapiv1.PositionConstraint
+		bundleTxs      []string
+		revertingTxs   []string
+		targetBlock    sql.NullInt64
+	)
+	err := row.Scan(
+		&rawTransaction,
+		&blockNum,
+		&senderAddress,
+		&txType,
+		&status,
+		&details,
+		&options,
+		pq.Array(&bundleTxs),
+		pq.Array(&revertingTxs),
+		&targetBlock,
 	)
-	err := row.Scan(&rawTransaction, &blockNum, &senderAddress, &txType, &status, &details, &options)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("transaction %s not found: %w", txnHash.Hex(), ErrNotFound)
-		}
-		return nil, fmt.Errorf("failed to get transaction by hash: %w", err)
+		return nil, err
 	}
 	txStr, err := hex.DecodeString(strings.TrimPrefix(rawTransaction, "0x"))
 	if err != nil {
@@ -299,15 +440,20 @@ func (s *rpcstore) GetTransactionByHash(ctx context.Context, txnHash common.Hash
 		Details:     details.String,
 		Constraint:  pbOption,
 	}
+	if err := setBundle(txn, bundleTxs, revertingTxs, targetBlock); err != nil {
+		return nil, err
+	}
 
 	return txn, nil
 }
 
 func (s *rpcstore) GetTransactionsForBlock(ctx context.Context, blockNumber int64) ([]*sender.Transaction, error) {
 	query := `
-	SELECT raw_transaction, block_number, sender, tx_type, status, details, options
-	FROM mcTransactions
-	WHERE block_number = $1 AND status = 'pre-confirmed';
+	SELECT t.raw_transaction, t.block_number, t.sender, t.tx_type, t.status, t.details, t.options,
+		b.raw_transactions, b.reverting_tx_hashes, b.target_block
+	FROM mcTransactions t
+	LEFT JOIN bundles b ON b.transaction_hash = t.hash
+	WHERE t.block_number = $1 AND t.status = 'pre-confirmed';
 	`
 	rows, err := s.db.QueryContext(ctx, query, blockNumber)
 	if err != nil {
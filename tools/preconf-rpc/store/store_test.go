@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -534,4 +535,66 @@ func TestStore(t *testing.T) {
 			t.Fatalf("receipt mismatch (-want +got):\n%s", diff)
 		}
 	})
+	t.Run("Bundle", func(t *testing.T) {
+		bundle := []*types.Transaction{
+			types.NewTransaction(
+				5,
+				common.HexToAddress("0x1234567890123456789012345678901234567890"),
+				big.NewInt(0),
+				21000,
+				big.NewInt(1000000000),
+				nil,
+			),
+			types.NewTransaction(
+				6,
+				common.HexToAddress("0x0987654321098765432109876543210987654321"),
+				big.NewInt(0),
+				50000,
+				big.NewInt(1000000000),
+				nil,
+			),
+		}
+		rawBundleTxn, err := bundle[0].MarshalBinary()
+		if err != nil {
+			t.Fatalf("failed to marshal transaction: %v", err)
+		}
+		wrappedBundle := &sender.Transaction{
+			Transaction:       bundle[0],
+			Raw:               hex.EncodeToString(rawBundleTxn),
+			Sender:            common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			Type:              sender.TxTypeBundle,
+			Status:            sender.TxStatusPending,
+			Bundle:            bundle,
+			RevertingTxHashes: []string{bundle[1].Hash().Hex()},
+			TargetBlock:       10,
+		}
+
+		if err := st.AddQueuedTransaction(context.Background(), wrappedBundle); err != nil {
+			t.Fatalf("failed to add bundle: %v", err)
+		}
+
+		retrieved, err := st.GetBundle(context.Background(), wrappedBundle.BundleHash())
+		if err != nil {
+			t.Fatalf("failed to get bundle: %v", err)
+		}
+		if retrieved.Hash() != bundle[0].Hash() {
+			t.Fatalf("expected transaction hash %s, got %s", bundle[0].Hash().Hex(), retrieved.Hash().Hex())
+		}
+		if len(retrieved.Bundle) != 2 || retrieved.Bundle[1].Hash() != bundle[1].Hash() {
+			t.Fatalf("unexpected bundle transactions %v", retrieved.Bundle)
+		}
+		if retrieved.BundleHash() != wrappedBundle.BundleHash() {
+			t.Fatalf("expected bundle hash %s, got %s", wrappedBundle.BundleHash().Hex(), retrieved.BundleHash().Hex())
+		}
+		if diff := cmp.Diff(wrappedBundle.RevertingTxHashes, retrieved.RevertingTxHashes); diff != "" {
+			t.Fatalf("reverting tx hashes mismatch (-want +got):\n%s", diff)
+		}
+		if retrieved.TargetBlock != 10 {
+			t.Fatalf("expected target block 10, got %d", retrieved.TargetBlock)
+		}
+
+		if _, err := st.GetBundle(context.Background(), common.HexToHash("0x01")); !errors.Is(err, store.ErrNotFound) {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
 }
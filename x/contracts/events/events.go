@@ -28,6 +28,7 @@ type EventHandler interface {
 // eventHandler is a generic implementation of EventHandler for type-safe event handling.
 type eventHandler[T any] struct {
 	handler  func(*T)
+	revert   func(*T)
 	name     string
 	topicID  common.Hash
 	contract *abi.ABI
@@ -43,6 +44,18 @@ func NewEventHandler[T any](name string, handler func(*T)) EventHandler {
 	}
 }
 
+// NewRevertibleEventHandler creates a new EventHandler which also receives the
+// events removed from the chain by a reorg. The revert function is called with
+// the removed event, so that the state derived from it can be undone. Handlers
+// created with NewEventHandler ignore the removed events.
+func NewRevertibleEventHandler[T any](name string, handler func(*T), revert func(*T)) EventHandler {
+	return &eventHandler[T]{
+		handler: handler,
+		revert:  revert,
+		name:    name,
+	}
+}
+
 func (h *eventHandler[T]) eventName() string {
 	return h.name
 }
@@ -62,6 +75,10 @@ func (h *eventHandler[T]) handle(log types.Log) error {
 		return nil
 	}
 
+	if log.Removed && h.revert == nil {
+		return nil
+	}
+
 	// Create a new instance of T (your event struct)
 	obj := new(T)
 
@@ -94,7 +111,11 @@ func (h *eventHandler[T]) handle(log types.Log) error {
 	}
 
 	// Finally, run the user-provided handler logic
-	h.handler(obj)
+	if log.Removed {
+		h.revert(obj)
+	} else {
+		h.handler(obj)
+	}
 
 	return nil
 }
@@ -112,6 +133,25 @@ func NewChannelEventHandler[T any](ctx context.Context, name string, ch chan<- *
 	})
 }
 
+// NewRevertibleChannelEventHandler creates a new EventHandler which sends the
+// events to ch and the events removed from the chain by a reorg to revertCh.
+func NewRevertibleChannelEventHandler[T any](
+	ctx context.Context,
+	name string,
+	ch chan<- *T,
+	revertCh chan<- *T,
+) EventHandler {
+	send := func(c chan<- *T) func(*T) {
+		return func(obj *T) {
+			select {
+			case <-ctx.Done():
+			case c <- obj:
+			}
+		}
+	}
+	return NewRevertibleEventHandler(name, send(ch), send(revertCh))
+}
+
 // EventManager is an interface for subscribing to contract events. The EventHandler callback
 // is called when an event is received. The Subscription returned by the Subscribe
 // method can be used to unsubscribe from the event and also to receive any errors
@@ -242,6 +282,9 @@ func (l *Listener) PublishLogEvent(ctx context.Context, log types.Log) {
 	defer l.subMu.RUnlock()
 
 	l.metrics.totalLogs.Inc()
+	if log.Removed {
+		l.metrics.removedLogs.Inc()
+	}
 
 	wg := sync.WaitGroup{}
 	events := l.subscribers[log.Topics[0]]
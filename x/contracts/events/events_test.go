@@ -95,6 +95,78 @@ func TestEventHandler(t *testing.T) {
 	}
 }
 
+func TestRevertibleEventHandler(t *testing.T) {
+	t.Parallel()
+
+	bidderABI, err := abi.JSON(strings.NewReader(bidderregistry.BidderregistryABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := bidderABI.Events["BidderDeposited"]
+
+	buf, err := event.Inputs.NonIndexed().Pack(big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testLog := types.Log{
+		Topics: []common.Hash{
+			event.ID,
+			common.HexToHash("0xabcd"),
+			common.HexToHash("0x1234"),
+			common.BigToHash(big.NewInt(1000)),
+		},
+		Data: buf,
+	}
+	removedLog := testLog
+	removedLog.Removed = true
+
+	var handled, reverted int
+	revertible := NewRevertibleEventHandler(
+		"BidderDeposited",
+		func(ev *bidderregistry.BidderregistryBidderDeposited) {
+			handled++
+		},
+		func(ev *bidderregistry.BidderregistryBidderDeposited) {
+			if !ev.Raw.Removed {
+				t.Error("expected removed log")
+			}
+			reverted++
+		},
+	)
+	revertible.setTopicAndContract(event.ID, &bidderABI)
+
+	plain := NewEventHandler(
+		"BidderDeposited",
+		func(ev *bidderregistry.BidderregistryBidderDeposited) {
+			if ev.Raw.Removed {
+				t.Error("unexpected removed log")
+			}
+		},
+	)
+	plain.setTopicAndContract(event.ID, &bidderABI)
+
+	handledCh := make(chan *bidderregistry.BidderregistryBidderDeposited, 2)
+	revertedCh := make(chan *bidderregistry.BidderregistryBidderDeposited, 2)
+	channel := NewRevertibleChannelEventHandler(context.Background(), "BidderDeposited", handledCh, revertedCh)
+	channel.setTopicAndContract(event.ID, &bidderABI)
+
+	for _, h := range []EventHandler{revertible, plain, channel} {
+		if err := h.handle(testLog); err != nil {
+			t.Fatal(err)
+		}
+		if err := h.handle(removedLog); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if handled != 1 || reverted != 1 {
+		t.Fatalf("expected 1 handled and 1 reverted event, got %d and %d", handled, reverted)
+	}
+	if len(handledCh) != 1 || len(revertedCh) != 1 {
+		t.Fatalf("expected 1 handled and 1 reverted event on the channels, got %d and %d", len(handledCh), len(revertedCh))
+	}
+}
+
 func TestEventManager(t *testing.T) {
 	t.Parallel()
 
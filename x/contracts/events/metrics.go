@@ -6,6 +6,7 @@ var Namespace = "mev_commit"
 
 type metrics struct {
 	totalLogs             prometheus.Counter
+	removedLogs           prometheus.Counter
 	totalEvents           prometheus.Counter
 	eventHandlerDurations *prometheus.GaugeVec
 	eventCounts           *prometheus.CounterVec
@@ -19,6 +20,12 @@ func newMetrics() *metrics {
 			Name:      "total_logs",
 			Help:      "Total number of logs",
 		}),
+		removedLogs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "events",
+			Name:      "removed_logs",
+			Help:      "Total number of logs removed by reorgs",
+		}),
 		totalEvents: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: Namespace,
 			Subsystem: "events",
@@ -43,6 +50,7 @@ func newMetrics() *metrics {
 func (m *metrics) Metrics() []prometheus.Collector {
 	return []prometheus.Collector{
 		m.totalLogs,
+		m.removedLogs,
 		m.totalEvents,
 		m.eventHandlerDurations,
 		m.eventCounts,
@@ -2,6 +2,7 @@ package publisher
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"math/big"
 	"sync"
@@ -14,6 +15,7 @@ import (
 
 type EVMClient interface {
 	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
 }
 
@@ -31,6 +33,8 @@ type httpPublisher struct {
 	logger        *slog.Logger
 	evmClient     EVMClient
 	subscriber    Subscriber
+	confirmations uint64
+	recent        *recentBlocks
 
 	mu        sync.RWMutex
 	contracts []common.Address
@@ -41,12 +45,16 @@ func NewHTTPPublisher(
 	logger *slog.Logger,
 	evmClient EVMClient,
 	subscriber Subscriber,
+	opts ...Option,
 ) *httpPublisher {
+	o := newOptions(opts...)
 	return &httpPublisher{
 		progressStore: progressStore,
 		logger:        logger,
 		evmClient:     evmClient,
 		subscriber:    subscriber,
+		confirmations: o.confirmations,
+		recent:        newRecentBlocks(),
 		// contracts can be empty initially
 		contracts: make([]common.Address, 0),
 	}
@@ -107,13 +115,32 @@ func (h *httpPublisher) Start(ctx context.Context, contractAddr ...common.Addres
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				blockNumber, err := h.evmClient.BlockNumber(ctx)
+				head, err := h.evmClient.BlockNumber(ctx)
 				if err != nil {
 					h.logger.Warn("failed to get block number", "error", err)
 					continue
 				}
 
+				lastBlock, err = h.handleReorg(ctx, lastBlock)
+				if err != nil {
+					h.logger.Warn("failed to check for reorg", "error", err)
+					continue
+				}
+
+				if head < h.confirmations {
+					continue
+				}
+				blockNumber := head - h.confirmations
+
 				if blockNumber > lastBlock {
+					// The hash is read before the logs, so that a reorg of
+					// the block while filtering is detected on the next tick.
+					header, err := h.evmClient.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+					if err != nil {
+						h.logger.Warn("failed to get block header", "block", blockNumber, "error", err)
+						continue
+					}
+
 					startBlock := lastBlock + 1
 					success := true
 
@@ -125,16 +152,32 @@ func (h *httpPublisher) Start(ctx context.Context, contractAddr ...common.Addres
 							h.logger.Error("failed to set last block", "error", err)
 							return
 						}
+						h.recent.addBlock(blockNumber, header.Hash())
+						h.recent.prune(blockNumber)
 						lastBlock = blockNumber
 						continue
 					}
 
+					// filteredHash is the hash of the last block of the ranges
+					// filtered so far, read before its logs like the header.
+					var filteredHash common.Hash
 					for startBlock <= blockNumber {
 						endBlock := startBlock + 5000 - 1
 						if endBlock > blockNumber {
 							endBlock = blockNumber
 						}
 
+						endHash := header.Hash()
+						if endBlock != blockNumber {
+							endHeader, err := h.evmClient.HeaderByNumber(ctx, new(big.Int).SetUint64(endBlock))
+							if err != nil {
+								h.logger.Warn("failed to get block header", "block", endBlock, "error", err)
+								success = false
+								break
+							}
+							endHash = endHeader.Hash()
+						}
+
 						q := ethereum.FilterQuery{
 							FromBlock: big.NewInt(int64(startBlock)),
 							ToBlock:   big.NewInt(int64(endBlock)),
@@ -150,6 +193,7 @@ func (h *httpPublisher) Start(ctx context.Context, contractAddr ...common.Addres
 
 						for _, logMsg := range logs {
 							h.subscriber.PublishLogEvent(ctx, logMsg)
+							h.recent.addLog(logMsg)
 						}
 
 						h.logger.Debug("processed logs",
@@ -158,15 +202,33 @@ func (h *httpPublisher) Start(ctx context.Context, contractAddr ...common.Addres
 							"count", len(logs),
 							"addresses", len(addresses))
 						startBlock = endBlock + 1
+						filteredHash = endHash
 					}
 
-					if success {
-						if err := h.progressStore.SetLastBlock(blockNumber); err != nil {
-							h.logger.Error("failed to set last block", "error", err)
-							return
+					if !success {
+						// The logs published so far are not processed again,
+						// so the progress is kept at the last filtered block.
+						// Its hash is remembered to check it for a reorg on
+						// the next tick.
+						if startBlock > lastBlock+1 {
+							lastBlock = startBlock - 1
+							if err := h.progressStore.SetLastBlock(lastBlock); err != nil {
+								h.logger.Error("failed to set last block", "error", err)
+								return
+							}
+							h.recent.addBlock(lastBlock, filteredHash)
+							h.recent.prune(lastBlock)
 						}
-						lastBlock = blockNumber
+						continue
+					}
+
+					if err := h.progressStore.SetLastBlock(blockNumber); err != nil {
+						h.logger.Error("failed to set last block", "error", err)
+						return
 					}
+					h.recent.addBlock(blockNumber, header.Hash())
+					h.recent.prune(blockNumber)
+					lastBlock = blockNumber
 				}
 			}
 		}
@@ -174,3 +236,70 @@ func (h *httpPublisher) Start(ctx context.Context, contractAddr ...common.Addres
 
 	return doneChan
 }
+
+// handleReorg compares the hash of the last processed block with the canonical
+// chain. If the block was reorged, the logs published from the reverted blocks
+// are published again as removed and the block of the common ancestor is
+// returned as the last processed block.
+func (h *httpPublisher) handleReorg(ctx context.Context, lastBlock uint64) (uint64, error) {
+	hash, ok := h.recent.hash(lastBlock)
+	if !ok {
+		return lastBlock, nil
+	}
+	canonical, err := h.isCanonical(ctx, lastBlock, hash)
+	if err != nil || canonical {
+		return lastBlock, err
+	}
+
+	ancestor, found := uint64(0), false
+	for _, number := range h.recent.numbers() {
+		if number >= lastBlock {
+			continue
+		}
+		hash, _ := h.recent.hash(number)
+		canonical, err := h.isCanonical(ctx, number, hash)
+		if err != nil {
+			return lastBlock, err
+		}
+		if canonical {
+			ancestor, found = number, true
+			break
+		}
+	}
+	if !found {
+		ancestor = lastBlock - min(lastBlock, reorgWindow)
+		h.logger.Error(
+			"reorg deeper than the remembered blocks, removing all remembered logs",
+			"lastBlock", lastBlock,
+			"rewindTo", ancestor,
+		)
+	}
+
+	removed := h.recent.revert(ancestor)
+	h.logger.Warn(
+		"reorg detected",
+		"lastBlock", lastBlock,
+		"ancestor", ancestor,
+		"removedLogs", len(removed),
+	)
+	for _, logMsg := range removed {
+		h.subscriber.PublishLogEvent(ctx, logMsg)
+	}
+
+	if err := h.progressStore.SetLastBlock(ancestor); err != nil {
+		return lastBlock, err
+	}
+	return ancestor, nil
+}
+
+func (h *httpPublisher) isCanonical(ctx context.Context, number uint64, hash common.Hash) (bool, error) {
+	header, err := h.evmClient.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		// the chain is shorter after the reorg
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return header.Hash() == hash, nil
+}
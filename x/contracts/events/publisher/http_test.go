@@ -2,7 +2,9 @@ package publisher_test
 
 import (
 	"context"
+	"errors"
 	"io"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -81,6 +83,173 @@ func TestHTTPPublisher(t *testing.T) {
 	}
 }
 
+func TestHTTPPublisherReorg(t *testing.T) {
+	t.Parallel()
+
+	logger := util.NewTestLogger(io.Discard)
+
+	evmClient := &testEVMClient{}
+	newLog := func(blockNum uint64, data string) types.Log {
+		return types.Log{
+			BlockNumber: blockNum,
+			BlockHash:   evmClient.header(blockNum).Hash(),
+			Address:     common.HexToAddress("0x1"),
+			Topics:      []common.Hash{common.HexToHash("0x1")},
+			Data:        []byte(data),
+		}
+	}
+	logs := []types.Log{newLog(1, "abcd"), newLog(2, "efgh")}
+	evmClient.Reorg(4, "", 0, logs)
+
+	progressStore := &testStore{}
+	subscriber := &testSubscriber{
+		logs: make(chan types.Log),
+	}
+
+	p := publisher.NewHTTPPublisher(
+		progressStore,
+		logger,
+		evmClient,
+		subscriber,
+		publisher.WithConfirmations(1),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneChan := p.Start(ctx, common.HexToAddress("0x1"))
+
+	expectLog := func(want types.Log) {
+		t.Helper()
+		select {
+		case log := <-subscriber.logs:
+			if diff := cmp.Diff(log, want); diff != "" {
+				t.Fatalf("unexpected log (-got +want):\n%s", diff)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for log")
+		}
+	}
+
+	expectLog(logs[0])
+	expectLog(logs[1])
+
+	// blocks 2 to 5 are replaced and the log of block 2 changes
+	evmClient.mu.Lock()
+	evmClient.forks = map[uint64]string{2: "b", 3: "b", 4: "b", 5: "b"}
+	reorgedLog := newLog(2, "ijkl")
+	evmClient.mu.Unlock()
+	evmClient.Reorg(5, "b", 2, []types.Log{logs[0], reorgedLog})
+
+	removed := logs[1]
+	removed.Removed = true
+	expectLog(removed)
+	expectLog(reorgedLog)
+
+	select {
+	case log := <-subscriber.logs:
+		t.Fatalf("unexpected log %v", log)
+	case <-time.After(1 * time.Second):
+	}
+
+	cancel()
+	select {
+	case <-doneChan:
+	case <-time.After(1 * time.Second):
+		t.Error("timed out waiting for doneChan")
+	}
+
+	if bn, _ := progressStore.LastBlock(); bn != 4 {
+		t.Errorf("expected block number 4, got %d", bn)
+	}
+}
+
+func TestHTTPPublisherReorgAfterFailedRange(t *testing.T) {
+	t.Parallel()
+
+	logger := util.NewTestLogger(io.Discard)
+
+	evmClient := &testEVMClient{}
+	newLog := func(blockNum uint64, data string) types.Log {
+		return types.Log{
+			BlockNumber: blockNum,
+			BlockHash:   evmClient.header(blockNum).Hash(),
+			Address:     common.HexToAddress("0x1"),
+			Topics:      []common.Hash{common.HexToHash("0x1")},
+			Data:        []byte(data),
+		}
+	}
+	logs := []types.Log{newLog(4990, "abcd"), newLog(4998, "efgh")}
+	// the first range of 5000 blocks is filtered, the second one fails
+	evmClient.failFrom = 5001
+	evmClient.Reorg(6000, "", 0, logs)
+
+	progressStore := &testStore{}
+	subscriber := &testSubscriber{
+		logs: make(chan types.Log),
+	}
+
+	p := publisher.NewHTTPPublisher(progressStore, logger, evmClient, subscriber)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneChan := p.Start(ctx, common.HexToAddress("0x1"))
+
+	expectLog := func(want types.Log) {
+		t.Helper()
+		select {
+		case log := <-subscriber.logs:
+			if diff := cmp.Diff(log, want); diff != "" {
+				t.Fatalf("unexpected log (-got +want):\n%s", diff)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for log")
+		}
+	}
+
+	expectLog(logs[0])
+	expectLog(logs[1])
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if bn, _ := progressStore.LastBlock(); bn == 5000 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the progress of the filtered range")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// blocks 4995 to 6000 are replaced right after the failed range and the
+	// log of block 4998 changes
+	evmClient.mu.Lock()
+	evmClient.failFrom = 0
+	evmClient.forks = map[uint64]string{4998: "b"}
+	reorgedLog := newLog(4998, "ijkl")
+	evmClient.mu.Unlock()
+	evmClient.Reorg(6000, "b", 4995, []types.Log{logs[0], reorgedLog})
+
+	removed := logs[1]
+	removed.Removed = true
+	expectLog(removed)
+	expectLog(reorgedLog)
+
+	select {
+	case log := <-subscriber.logs:
+		t.Fatalf("unexpected log %v", log)
+	case <-time.After(1 * time.Second):
+	}
+
+	cancel()
+	select {
+	case <-doneChan:
+	case <-time.After(1 * time.Second):
+		t.Error("timed out waiting for doneChan")
+	}
+
+	if bn, _ := progressStore.LastBlock(); bn != 6000 {
+		t.Errorf("expected block number 6000, got %d", bn)
+	}
+}
+
 type testSubscriber struct {
 	logs chan types.Log
 }
@@ -93,6 +262,41 @@ type testEVMClient struct {
 	mu       sync.Mutex
 	blockNum uint64
 	logs     []types.Log
+	// forks changes the hash of the blocks to simulate a reorg
+	forks map[uint64]string
+	// failFrom fails the filtering of the ranges starting from the block
+	failFrom uint64
+}
+
+func (t *testEVMClient) header(number uint64) *types.Header {
+	return &types.Header{
+		Number: new(big.Int).SetUint64(number),
+		Extra:  []byte(t.forks[number]),
+	}
+}
+
+func (t *testEVMClient) Reorg(blockNum uint64, fork string, from uint64, logs []types.Log) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.forks == nil {
+		t.forks = make(map[uint64]string)
+	}
+	for n := from; n <= blockNum; n++ {
+		t.forks[n] = fork
+	}
+	t.blockNum = blockNum
+	t.logs = logs
+}
+
+func (t *testEVMClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if number.Uint64() > t.blockNum {
+		return nil, ethereum.NotFound
+	}
+	return t.header(number.Uint64()), nil
 }
 
 func (t *testEVMClient) SetBlockNumber(blockNum uint64) {
@@ -116,6 +320,10 @@ func (t *testEVMClient) FilterLogs(
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if t.failFrom != 0 && q.FromBlock.Uint64() >= t.failFrom {
+		return nil, errors.New("filter failed")
+	}
+
 	logs := make([]types.Log, 0, len(t.logs))
 	for _, log := range t.logs {
 		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
@@ -0,0 +1,111 @@
+package publisher
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// reorgWindow is the number of blocks below the last processed block for which
+// the block hashes and the published logs are remembered.
+const reorgWindow = 128
+
+type Option func(*options)
+
+type options struct {
+	confirmations uint64
+}
+
+// WithConfirmations delays the publishing of the logs until their block is
+// the given number of blocks deep in the chain.
+func WithConfirmations(confirmations uint64) Option {
+	return func(o *options) {
+		o.confirmations = confirmations
+	}
+}
+
+func newOptions(opts ...Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// recentBlocks remembers the hashes of the recently processed blocks along
+// with the logs published from them, so that the logs of the blocks reverted
+// by a reorg can be published again as removed.
+type recentBlocks struct {
+	hashes map[uint64]common.Hash
+	logs   []types.Log
+}
+
+func newRecentBlocks() *recentBlocks {
+	return &recentBlocks{hashes: make(map[uint64]common.Hash)}
+}
+
+func (r *recentBlocks) addBlock(number uint64, hash common.Hash) {
+	r.hashes[number] = hash
+}
+
+func (r *recentBlocks) addLog(log types.Log) {
+	r.hashes[log.BlockNumber] = log.BlockHash
+	r.logs = append(r.logs, log)
+}
+
+func (r *recentBlocks) hash(number uint64) (common.Hash, bool) {
+	hash, ok := r.hashes[number]
+	return hash, ok
+}
+
+// numbers returns the remembered block numbers, highest first.
+func (r *recentBlocks) numbers() []uint64 {
+	numbers := make([]uint64, 0, len(r.hashes))
+	for n := range r.hashes {
+		numbers = append(numbers, n)
+	}
+	slices.Sort(numbers)
+	slices.Reverse(numbers)
+	return numbers
+}
+
+// revert forgets the blocks above the ancestor and returns their logs marked
+// as removed, latest first.
+func (r *recentBlocks) revert(ancestor uint64) []types.Log {
+	for n := range r.hashes {
+		if n > ancestor {
+			delete(r.hashes, n)
+		}
+	}
+
+	var removed []types.Log
+	for i := len(r.logs) - 1; i >= 0; i-- {
+		if r.logs[i].BlockNumber <= ancestor {
+			break
+		}
+		log := r.logs[i]
+		log.Removed = true
+		removed = append(removed, log)
+	}
+	r.logs = r.logs[:len(r.logs)-len(removed)]
+	return removed
+}
+
+// prune forgets the blocks which are too deep to be reorged.
+func (r *recentBlocks) prune(last uint64) {
+	if last < reorgWindow {
+		return
+	}
+	oldest := last - reorgWindow
+	for n := range r.hashes {
+		if n < oldest {
+			delete(r.hashes, n)
+		}
+	}
+	idx := 0
+	for idx < len(r.logs) && r.logs[idx].BlockNumber < oldest {
+		idx++
+	}
+	r.logs = slices.Delete(r.logs, 0, idx)
+}
@@ -18,6 +18,7 @@ const (
 )
 
 type WSEVMClient interface {
+	BlockNumber(ctx context.Context) (uint64, error)
 	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
 }
 
@@ -26,18 +27,27 @@ type wsPublisher struct {
 	logger        *slog.Logger
 	evmClient     WSEVMClient
 	subscriber    Subscriber
+	confirmations uint64
 
 	mu        sync.RWMutex
 	contracts []common.Address
 	updateCh  chan struct{}
 }
 
-func NewWSPublisher(progressStore ProgressStore, logger *slog.Logger, evmClient WSEVMClient, subscriber Subscriber) *wsPublisher {
+func NewWSPublisher(
+	progressStore ProgressStore,
+	logger *slog.Logger,
+	evmClient WSEVMClient,
+	subscriber Subscriber,
+	opts ...Option,
+) *wsPublisher {
+	o := newOptions(opts...)
 	return &wsPublisher{
 		progressStore: progressStore,
 		logger:        logger,
 		evmClient:     evmClient,
 		subscriber:    subscriber,
+		confirmations: o.confirmations,
 		contracts:     make([]common.Address, 0),
 		updateCh:      make(chan struct{}, 1),
 	}
@@ -95,6 +105,13 @@ func (w *wsPublisher) Start(ctx context.Context, contractAddr ...common.Address)
 			return
 		}
 
+		var confirmTimer <-chan time.Time
+		if w.confirmations > 0 {
+			ticker := time.NewTicker(500 * time.Millisecond)
+			defer ticker.Stop()
+			confirmTimer = ticker.C
+		}
+
 		inactivityStart := time.Now()
 		for {
 			if time.Since(inactivityStart) > inactivityTimeout {
@@ -130,6 +147,10 @@ func (w *wsPublisher) Start(ctx context.Context, contractAddr ...common.Address)
 				continue
 			}
 
+			// The logs waiting for confirmations are delivered again by the
+			// next subscription as they are above the last block.
+			var pending []types.Log
+
 		PROCESSING:
 			for {
 				select {
@@ -147,16 +168,55 @@ func (w *wsPublisher) Start(ctx context.Context, contractAddr ...common.Address)
 					inactivityStart = time.Now()
 					time.Sleep(5 * time.Second)
 					break PROCESSING
-				case logMsg := <-logChan:
-					// process log
-					w.subscriber.PublishLogEvent(ctx, logMsg)
-
-					if logMsg.BlockNumber > lastBlock {
-						if err := w.progressStore.SetLastBlock(logMsg.BlockNumber); err != nil {
+				case <-confirmTimer:
+					head, err := w.evmClient.BlockNumber(ctx)
+					if err != nil {
+						w.logger.Warn("failed to get block number", "error", err)
+						continue
+					}
+					var confirmed []types.Log
+					confirmed, pending = splitConfirmed(pending, head, w.confirmations)
+					for _, logMsg := range confirmed {
+						if err := w.publish(ctx, logMsg, &lastBlock); err != nil {
 							w.logger.Error("failed to set last block", "error", err)
 							return
 						}
-						lastBlock = logMsg.BlockNumber
+					}
+				case logMsg := <-logChan:
+					if logMsg.Removed {
+						// A removed log which was not published yet is
+						// dropped, the rest are published as removals.
+						idx := slices.IndexFunc(pending, func(l types.Log) bool {
+							return l.TxHash == logMsg.TxHash && l.Index == logMsg.Index && l.BlockHash == logMsg.BlockHash
+						})
+						if idx >= 0 {
+							pending = slices.Delete(pending, idx, idx+1)
+							continue
+						}
+						w.logger.Warn(
+							"log removed by reorg",
+							"block", logMsg.BlockNumber,
+							"txHash", logMsg.TxHash,
+							"index", logMsg.Index,
+						)
+						w.subscriber.PublishLogEvent(ctx, logMsg)
+						if logMsg.BlockNumber <= lastBlock {
+							lastBlock = logMsg.BlockNumber - 1
+							if err := w.progressStore.SetLastBlock(lastBlock); err != nil {
+								w.logger.Error("failed to set last block", "error", err)
+								return
+							}
+						}
+						continue
+					}
+
+					if w.confirmations > 0 {
+						pending = append(pending, logMsg)
+						continue
+					}
+					if err := w.publish(ctx, logMsg, &lastBlock); err != nil {
+						w.logger.Error("failed to set last block", "error", err)
+						return
 					}
 				}
 			}
@@ -165,3 +225,30 @@ func (w *wsPublisher) Start(ctx context.Context, contractAddr ...common.Address)
 
 	return doneChan
 }
+
+func (w *wsPublisher) publish(ctx context.Context, logMsg types.Log, lastBlock *uint64) error {
+	w.subscriber.PublishLogEvent(ctx, logMsg)
+
+	if logMsg.BlockNumber > *lastBlock {
+		if err := w.progressStore.SetLastBlock(logMsg.BlockNumber); err != nil {
+			return err
+		}
+		*lastBlock = logMsg.BlockNumber
+	}
+	return nil
+}
+
+// splitConfirmed returns the logs with enough confirmations at the head and
+// the ones which are still pending.
+func splitConfirmed(logs []types.Log, head, confirmations uint64) ([]types.Log, []types.Log) {
+	if head < confirmations {
+		return nil, logs
+	}
+	idx := slices.IndexFunc(logs, func(l types.Log) bool {
+		return l.BlockNumber > head-confirmations
+	})
+	if idx < 0 {
+		return logs, nil
+	}
+	return logs[:idx], logs[idx:]
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -198,6 +199,100 @@ func TestWSPublisher_AddContracts(t *testing.T) {
 	}
 }
 
+func TestWSPublisherReorg(t *testing.T) {
+	t.Parallel()
+
+	logger := util.NewTestLogger(io.Discard)
+
+	newLog := func(blockNum uint64, data string) types.Log {
+		return types.Log{
+			BlockNumber: blockNum,
+			BlockHash:   common.BigToHash(new(big.Int).SetUint64(blockNum)),
+			Address:     common.HexToAddress("0x1"),
+			Topics:      []common.Hash{common.HexToHash("0x1")},
+			Data:        []byte(data),
+		}
+	}
+	logs := []types.Log{newLog(1, "abcd"), newLog(2, "efgh"), newLog(3, "ijkl")}
+
+	evmClient := &testWSEVMClient{
+		subscribed: make(chan struct{}, 1),
+	}
+	progressStore := &testStore{}
+	subscriber := &testSubscriber{
+		logs: make(chan types.Log),
+	}
+
+	p := publisher.NewWSPublisher(
+		progressStore,
+		logger,
+		evmClient,
+		subscriber,
+		publisher.WithConfirmations(1),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	doneChan := p.Start(ctx, common.HexToAddress("0x1"))
+	select {
+	case <-evmClient.subscribed:
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out waiting for subscribe")
+	}
+
+	expectLog := func(want types.Log) {
+		t.Helper()
+		select {
+		case log := <-subscriber.logs:
+			if diff := cmp.Diff(log, want); diff != "" {
+				t.Fatalf("unexpected log (-got +want):\n%s", diff)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for log")
+		}
+	}
+
+	for _, l := range logs {
+		evmClient.SendLog(l)
+	}
+	evmClient.SetBlockNumber(3)
+	expectLog(logs[0])
+	expectLog(logs[1])
+
+	// the log of block 3 is not confirmed yet and is dropped, the log of
+	// block 2 was published and is removed
+	for _, l := range []types.Log{logs[2], logs[1]} {
+		l.Removed = true
+		evmClient.SendLog(l)
+	}
+	removed := logs[1]
+	removed.Removed = true
+	expectLog(removed)
+
+	reorgedLog := newLog(2, "mnop")
+	evmClient.SendLog(reorgedLog)
+	evmClient.SetBlockNumber(4)
+	expectLog(reorgedLog)
+
+	select {
+	case log := <-subscriber.logs:
+		t.Fatalf("unexpected log %v", log)
+	case <-time.After(1 * time.Second):
+	}
+
+	cancel()
+	select {
+	case <-doneChan:
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out waiting for doneChan")
+	}
+
+	if bn, _ := progressStore.LastBlock(); bn != 2 {
+		t.Errorf("expected block number 2, got %d", bn)
+	}
+}
+
 type testSubscription struct {
 	done chan struct{}
 	errC chan error
@@ -218,6 +313,19 @@ type testWSEVMClient struct {
 	sub        *testSubscription
 	errC       chan error
 	lastAddrs  []common.Address
+	head       uint64
+}
+
+func (c *testWSEVMClient) SetBlockNumber(head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+}
+
+func (c *testWSEVMClient) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
 }
 
 func (c *testWSEVMClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, logs chan<- types.Log) (ethereum.Subscription, error) {
@@ -0,0 +1,361 @@
+package txmonitor
+
+import (
+	"cmp"
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultFeeBumpPercentage is the minimum increase of the tip and fee
+	// caps accepted by geth for a replacement transaction.
+	DefaultFeeBumpPercentage = 10
+
+	sendTimeout = 5 * time.Second
+)
+
+// FeeBumpPolicy configures the replacement of transactions which stay pending
+// for too long with the same call signed with higher tip and fee caps.
+type FeeBumpPolicy struct {
+	// Interval is how long a transaction stays pending before it is replaced.
+	Interval time.Duration
+	// Percentage is the increase of the tip and fee caps on every replacement.
+	Percentage int64
+	// MaxGasTipCap and MaxGasFeeCap are the ceilings of the bumped caps. The
+	// transaction is not replaced anymore once a bump would exceed them.
+	MaxGasTipCap *big.Int
+	MaxGasFeeCap *big.Int
+}
+
+// bump returns the increased tip and fee caps, or false if they would exceed
+// the ceilings of the policy.
+func (p *FeeBumpPolicy) bump(tipCap, feeCap *big.Int) (*big.Int, *big.Int, bool) {
+	percentage := p.Percentage
+	if percentage <= 0 {
+		percentage = DefaultFeeBumpPercentage
+	}
+	increase := func(v *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(v, big.NewInt(100+percentage))
+		bumped.Div(bumped, big.NewInt(100))
+		if bumped.Cmp(v) <= 0 {
+			bumped.Add(v, big.NewInt(1))
+		}
+		return bumped
+	}
+
+	newTipCap, newFeeCap := increase(tipCap), increase(feeCap)
+	if newFeeCap.Cmp(newTipCap) < 0 {
+		newFeeCap.Set(newTipCap)
+	}
+	if p.MaxGasTipCap != nil && newTipCap.Cmp(p.MaxGasTipCap) > 0 {
+		return nil, nil, false
+	}
+	if p.MaxGasFeeCap != nil && newFeeCap.Cmp(p.MaxGasFeeCap) > 0 {
+		return nil, nil, false
+	}
+	return newTipCap, newFeeCap, true
+}
+
+type TxSigner interface {
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// TxSender sends the replacement transactions. It must not manage the nonces
+// of the owner, so the backend should be used instead of the transactor. It
+// also retrieves the transactions restored from the Saver on startup, which
+// only keeps their hashes.
+type TxSender interface {
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+type feeBumper struct {
+	policy  FeeBumpPolicy
+	chainID *big.Int
+	signer  TxSigner
+	sender  TxSender
+}
+
+// WithFeeBumping enables the replacement of the transactions sent through the
+// monitor which are not included after the interval of the policy. The
+// receipt of any replacement resolves the waiters of the original transaction.
+func WithFeeBumping(policy FeeBumpPolicy, chainID *big.Int, signer TxSigner, sender TxSender) Option {
+	return func(m *Monitor) {
+		m.feeBumper = &feeBumper{
+			policy:  policy,
+			chainID: chainID,
+			signer:  signer,
+			sender:  sender,
+		}
+	}
+}
+
+// inflightTx is the latest transaction sent for a nonce along with the hashes
+// of all the transactions it replaced. The transaction is nil if the group was
+// restored from the Saver until it is retrieved from the backend.
+type inflightTx struct {
+	tx     *types.Transaction
+	sentAt time.Time
+	hashes []common.Hash
+	capped bool
+}
+
+// bumpFees replaces the transactions which were not included in time, lowest
+// nonce first as the later ones cannot be included before it.
+func (m *Monitor) bumpFees(ctx context.Context, confirmedNonce uint64) {
+	m.mtx.Lock()
+	nonces := make([]uint64, 0, len(m.inflight))
+	for nonce, p := range m.inflight {
+		if nonce < confirmedNonce || p.capped || time.Since(p.sentAt) < m.feeBumper.policy.Interval {
+			continue
+		}
+		nonces = append(nonces, nonce)
+	}
+	m.mtx.Unlock()
+
+	slices.Sort(nonces)
+	for _, nonce := range nonces {
+		if err := ctx.Err(); err != nil {
+			return
+		}
+		m.replace(ctx, nonce)
+	}
+}
+
+// restoreInflight rebuilds the groups of transactions sent for the same nonce
+// from the pending transactions of the Saver, so that the replacements sent
+// before a restart still resolve the original transactions.
+func (m *Monitor) restoreInflight(pending []*TxnDetails) {
+	slices.SortFunc(pending, func(a, b *TxnDetails) int {
+		return cmp.Compare(a.Created, b.Created)
+	})
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, txn := range pending {
+		p, ok := m.inflight[txn.Nonce]
+		if !ok {
+			p = new(inflightTx)
+			m.inflight[txn.Nonce] = p
+		}
+		p.hashes = append(p.hashes, txn.Hash)
+		p.sentAt = time.Unix(txn.Created, 0)
+	}
+}
+
+// loadInflight retrieves the transaction of a group restored from the Saver.
+// The one with the highest tip is the latest replacement.
+func (m *Monitor) loadInflight(ctx context.Context, nonce uint64, p *inflightTx) *types.Transaction {
+	m.mtx.Lock()
+	hashes := slices.Clone(p.hashes)
+	m.mtx.Unlock()
+
+	var latest *types.Transaction
+	for _, txHash := range hashes {
+		tx, _, err := m.feeBumper.sender.TransactionByHash(ctx, txHash)
+		if err != nil {
+			m.logger.Debug("failed to get restored transaction", "txHash", txHash, "error", err)
+			continue
+		}
+		if latest == nil || tx.GasTipCap().Cmp(latest.GasTipCap()) > 0 {
+			latest = tx
+		}
+	}
+	if latest == nil {
+		m.logger.Warn("restored transactions not found, not replacing them", "nonce", nonce, "hashes", hashes)
+		m.setCapped(nonce, p)
+		return nil
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.inflight[nonce] != p {
+		return nil
+	}
+	p.tx = latest
+	return latest
+}
+
+func (m *Monitor) replace(ctx context.Context, nonce uint64) {
+	m.mtx.Lock()
+	p, ok := m.inflight[nonce]
+	if !ok {
+		m.mtx.Unlock()
+		return
+	}
+	tx := p.tx
+	m.mtx.Unlock()
+
+	if tx == nil {
+		if tx = m.loadInflight(ctx, nonce, p); tx == nil {
+			return
+		}
+	}
+
+	tipCap, feeCap, ok := m.feeBumper.policy.bump(tx.GasTipCap(), tx.GasFeeCap())
+	if !ok {
+		m.logger.Warn(
+			"fee bump ceiling reached",
+			"txHash", tx.Hash(),
+			"nonce", nonce,
+			"gasTipCap", tx.GasTipCap(),
+			"gasFeeCap", tx.GasFeeCap(),
+		)
+		m.setCapped(nonce, p)
+		return
+	}
+
+	var txData types.TxData
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		txData = &types.DynamicFeeTx{
+			ChainID:    m.feeBumper.chainID,
+			Nonce:      nonce,
+			GasTipCap:  tipCap,
+			GasFeeCap:  feeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}
+	case types.LegacyTxType:
+		txData = &types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: feeCap,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}
+	default:
+		m.logger.Warn("fee bumping not supported for transaction type", "txHash", tx.Hash(), "type", tx.Type())
+		m.setCapped(nonce, p)
+		return
+	}
+
+	signedTx, err := m.feeBumper.signer.SignTx(types.NewTx(txData), m.feeBumper.chainID)
+	if err != nil {
+		m.logger.Error("failed to sign replacement transaction", "txHash", tx.Hash(), "error", err)
+		return
+	}
+
+	// The replacement joins the transactions of the nonce before it is sent,
+	// so that they are not reported as cancelled if it is included before
+	// its receipt is watched.
+	m.mtx.Lock()
+	if m.inflight[nonce] != p {
+		// the transaction was included or a new one was sent for the nonce
+		m.mtx.Unlock()
+		return
+	}
+	p.hashes = append(p.hashes, signedTx.Hash())
+	m.mtx.Unlock()
+
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	err = m.feeBumper.sender.SendTransaction(sendCtx, signedTx)
+	cancel()
+	if err != nil {
+		m.mtx.Lock()
+		p.hashes = slices.DeleteFunc(p.hashes, func(h common.Hash) bool { return h == signedTx.Hash() })
+		m.mtx.Unlock()
+
+		// The transaction could have been included since the nonce was
+		// checked, in which case the receipt resolves it.
+		if strings.Contains(err.Error(), "nonce too low") {
+			m.logger.Debug("transaction included before replacement", "txHash", tx.Hash(), "nonce", nonce)
+			return
+		}
+		m.logger.Error("failed to send replacement transaction", "txHash", tx.Hash(), "error", err)
+		return
+	}
+
+	m.mtx.Lock()
+	p.tx = signedTx
+	p.sentAt = time.Now()
+	m.mtx.Unlock()
+
+	m.metrics.feeBumpCount.Inc()
+	m.logger.Info(
+		"replaced transaction with bumped fees",
+		"txHash", tx.Hash(),
+		"replacementTxHash", signedTx.Hash(),
+		"nonce", nonce,
+		"gasTipCap", tipCap,
+		"gasFeeCap", feeCap,
+	)
+	m.track(ctx, signedTx)
+}
+
+func (m *Monitor) setCapped(nonce uint64, p *inflightTx) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.inflight[nonce] == p {
+		p.capped = true
+	}
+}
+
+// resolveReplacements notifies the waiters of all the transactions sent for a
+// nonce with the receipt of the one which was included. The transactions are
+// only reported as cancelled once none of them is found. The results of the
+// resolved transactions are removed from the map.
+func (m *Monitor) resolveReplacements(results map[common.Hash]Result, nonceMap map[common.Hash]uint64) {
+	m.mtx.Lock()
+	groups := make(map[uint64][]common.Hash)
+	for txHash := range results {
+		nonce := nonceMap[txHash]
+		if p, ok := m.inflight[nonce]; ok && slices.Contains(p.hashes, txHash) {
+			groups[nonce] = slices.Clone(p.hashes)
+		}
+	}
+	m.mtx.Unlock()
+
+	for nonce, hashes := range groups {
+		var (
+			included *Result
+			complete = true
+		)
+		for _, txHash := range hashes {
+			res, ok := results[txHash]
+			switch {
+			case !ok:
+				complete = false
+			case res.Receipt != nil:
+				included = &res
+			}
+		}
+
+		resolved := true
+		switch {
+		case included != nil:
+			for _, txHash := range hashes {
+				m.notify(nonce, txHash, *included)
+			}
+		case complete:
+			for _, txHash := range hashes {
+				m.notify(nonce, txHash, results[txHash])
+			}
+		default:
+			// some of the receipts could not be retrieved, retry on the
+			// next check
+			resolved = false
+		}
+
+		for _, txHash := range hashes {
+			delete(results, txHash)
+		}
+		if resolved {
+			m.mtx.Lock()
+			delete(m.inflight, nonce)
+			m.mtx.Unlock()
+		}
+	}
+}
@@ -11,6 +11,7 @@ type metrics struct {
 	lastUsedGas        prometheus.Gauge
 	lastUsedGasPrice   prometheus.Gauge
 	lastUsedGasTip     prometheus.Gauge
+	feeBumpCount       prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -51,6 +52,12 @@ func newMetrics() *metrics {
 			Name:      "last_used_gas_tip",
 			Help:      "Last used gas tip",
 		}),
+		feeBumpCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "txmonitor",
+			Name:      "fee_bump_count",
+			Help:      "Number of transactions replaced with bumped fees",
+		}),
 	}
 }
 
@@ -62,5 +69,6 @@ func (m *metrics) Metrics() []prometheus.Collector {
 		m.lastUsedGas,
 		m.lastUsedGasPrice,
 		m.lastUsedGasTip,
+		m.feeBumpCount,
 	}
 }
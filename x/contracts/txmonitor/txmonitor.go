@@ -75,14 +75,20 @@ type Monitor struct {
 	newTxAdded         chan struct{}
 	nonceUpdate        chan struct{}
 	blockUpdate        chan waitCheck
+	feeBumpUpdate      chan uint64
 	nonceOverrideChan  chan uint64
 	logger             *slog.Logger
 	lastConfirmedNonce atomic.Uint64
 	maxPendingTxs      uint64
 	stuckDuration      time.Duration
 	metrics            *metrics
+	feeBumper          *feeBumper
+	inflight           map[uint64]*inflightTx
 }
 
+// Option is a functional option for configuring the Monitor.
+type Option func(*Monitor)
+
 func New(
 	owner common.Address,
 	client EVM,
@@ -90,6 +96,7 @@ func New(
 	saver Saver,
 	logger *slog.Logger,
 	maxPendingTxs uint64,
+	opts ...Option,
 ) *Monitor {
 	if saver == nil {
 		saver = noopSaver{}
@@ -107,7 +114,12 @@ func New(
 		newTxAdded:        make(chan struct{}),
 		nonceUpdate:       make(chan struct{}),
 		blockUpdate:       make(chan waitCheck),
+		feeBumpUpdate:     make(chan uint64),
 		nonceOverrideChan: make(chan uint64, 1),
+		inflight:          make(map[uint64]*inflightTx),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
 
 	pending, err := saver.PendingTxns()
@@ -115,6 +127,7 @@ func New(
 		logger.Error("failed to get pending transactions", "err", err)
 	}
 
+	m.restoreInflight(pending)
 	for _, txn := range pending {
 		m.WatchTx(txn.Hash, txn.Nonce)
 	}
@@ -232,6 +245,11 @@ func (m *Monitor) Start(ctx context.Context) <-chan struct{} {
 				lastNonceAdvance = time.Now()
 			}
 
+			select {
+			case m.feeBumpUpdate <- lastNonce:
+			default:
+			}
+
 			select {
 			case m.blockUpdate <- waitCheck{lastNonce, currentBlock}:
 			default:
@@ -254,6 +272,24 @@ func (m *Monitor) Start(ctx context.Context) <-chan struct{} {
 		}
 	}()
 
+	// The replacements are sent apart from the polling, so that the RPC
+	// calls they make do not delay the receipts.
+	if m.feeBumper != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case nonce := <-m.feeBumpUpdate:
+					m.bumpFees(ctx, nonce)
+				}
+			}
+		}()
+	}
+
 	go func() {
 		wg.Wait()
 		close(done)
@@ -280,6 +316,19 @@ func (m *Monitor) Allow(ctx context.Context, nonce uint64) bool {
 // Sent saves the transaction and starts monitoring it for the receipt. The Saver
 // is used to save the transaction details in the storage backend of choice.
 func (m *Monitor) Sent(ctx context.Context, tx *types.Transaction) {
+	if m.feeBumper != nil {
+		m.mtx.Lock()
+		m.inflight[tx.Nonce()] = &inflightTx{
+			tx:     tx,
+			sentAt: time.Now(),
+			hashes: []common.Hash{tx.Hash()},
+		}
+		m.mtx.Unlock()
+	}
+	m.track(ctx, tx)
+}
+
+func (m *Monitor) track(ctx context.Context, tx *types.Transaction) {
 	if err := m.saver.Save(ctx, tx.Hash(), tx.Nonce()); err != nil {
 		m.logger.Error("failed to save transaction", "err", err)
 	}
@@ -404,6 +453,7 @@ func (m *Monitor) check(ctx context.Context, newBlock uint64, lastNonce uint64)
 		}
 	}
 
+	results := make(map[common.Hash]Result, len(txHashes))
 	for start := 0; start < len(txHashes); start += batchSize {
 		end := start + batchSize
 		if end > len(txHashes) {
@@ -413,14 +463,13 @@ func (m *Monitor) check(ctx context.Context, newBlock uint64, lastNonce uint64)
 		receipts, err := m.helper.BatchReceipts(ctx, txHashes[start:end])
 		if err != nil {
 			m.logger.Error("failed to get receipts", "err", err)
-			return
+			break
 		}
 
 		for i, r := range receipts {
-			nonce := nonceMap[txHashes[start+i]]
 			if r.Err != nil {
 				if errors.Is(r.Err, ethereum.NotFound) {
-					m.notify(nonce, txHashes[start+i], Result{nil, ErrTxnCancelled})
+					results[txHashes[start+i]] = Result{nil, ErrTxnCancelled}
 					continue
 				}
 				m.logger.Error("failed to get receipt", "error", r.Err, "txHash", txHashes[start+i])
@@ -442,13 +491,18 @@ func (m *Monitor) check(ctx context.Context, newBlock uint64, lastNonce uint64)
 					"status", r.Receipt.Status,
 					"reason", reason,
 				)
-				m.notify(nonce, txHashes[start+i], Result{r.Receipt, fmt.Errorf("%w: %v", ErrTxnFailed, reason)})
+				results[txHashes[start+i]] = Result{r.Receipt, fmt.Errorf("%w: %v", ErrTxnFailed, reason)}
 				continue
 			}
 
-			m.notify(nonce, txHashes[start+i], Result{r.Receipt, nil})
+			results[txHashes[start+i]] = Result{r.Receipt, nil}
 		}
 	}
+
+	m.resolveReplacements(results, nonceMap)
+	for txHash, res := range results {
+		m.notify(nonceMap[txHash], txHash, res)
+	}
 }
 
 type noopSaver struct{}
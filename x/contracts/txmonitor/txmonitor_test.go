@@ -1,9 +1,12 @@
 package txmonitor_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"io"
 	"math/big"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -233,6 +236,271 @@ func TestNoOverrideWhenNonceAdvances(t *testing.T) {
 	}
 }
 
+func TestFeeBumping(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(1)
+
+	tx := types.MustSignNewTx(
+		key,
+		types.NewLondonSigner(chainID),
+		&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     5,
+			Gas:       100000,
+			GasFeeCap: big.NewInt(200),
+			GasTipCap: big.NewInt(100),
+			To:        &common.Address{},
+			Data:      []byte{0x01},
+		},
+	)
+
+	evm := newPollingEVM(0, 5)
+	helper := &testEVMHelper{receipts: make(map[common.Hash]txmonitor.Result)}
+	saver := &testSaver{status: make(map[common.Hash]string)}
+	sender := &testSender{}
+
+	monitor := txmonitor.New(
+		common.Address{},
+		evm,
+		helper,
+		saver,
+		util.NewTestLogger(io.Discard),
+		2048,
+		txmonitor.WithFeeBumping(
+			txmonitor.FeeBumpPolicy{
+				Interval:     50 * time.Millisecond,
+				Percentage:   10,
+				MaxGasTipCap: big.NewInt(125),
+				MaxGasFeeCap: big.NewInt(250),
+			},
+			chainID,
+			&testSigner{key: key},
+			sender,
+		),
+	)
+	monitor.SetStuckDuration(time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := monitor.Start(ctx)
+
+	monitor.Sent(ctx, tx)
+	res := monitor.WatchTx(tx.Hash(), tx.Nonce())
+
+	// 100 -> 110 -> 121 is within the ceiling, the next bump to 133 is not
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sender.sentTxns()) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for replacements")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	sent := sender.sentTxns()
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 replacements, got %d", len(sent))
+	}
+	for i, want := range []struct{ tipCap, feeCap int64 }{{110, 220}, {121, 242}} {
+		if sent[i].Nonce() != tx.Nonce() {
+			t.Fatalf("replacement %d: expected nonce %d, got %d", i, tx.Nonce(), sent[i].Nonce())
+		}
+		if sent[i].GasTipCap().Int64() != want.tipCap || sent[i].GasFeeCap().Int64() != want.feeCap {
+			t.Fatalf(
+				"replacement %d: expected caps %d/%d, got %s/%s",
+				i, want.tipCap, want.feeCap, sent[i].GasTipCap(), sent[i].GasFeeCap(),
+			)
+		}
+		if !bytes.Equal(sent[i].Data(), tx.Data()) || sent[i].Gas() != tx.Gas() {
+			t.Fatalf("replacement %d: call changed", i)
+		}
+	}
+
+	saver.mu.Lock()
+	for _, txn := range append(sent, tx) {
+		if nonce, ok := saver.saved[txn.Hash()]; !ok || nonce != tx.Nonce() {
+			t.Fatalf("transaction %s not saved", txn.Hash())
+		}
+	}
+	saver.mu.Unlock()
+
+	// the first replacement is included
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: sent[0].Hash()}
+	helper.setReceipt(sent[0].Hash(), txmonitor.Result{Receipt: receipt})
+	evm.setNonce(6)
+
+	select {
+	case r := <-res:
+		if r.Err != nil {
+			t.Fatalf("unexpected error: %v", r.Err)
+		}
+		if r.Receipt.TxHash != sent[0].Hash() {
+			t.Fatalf("expected receipt of %s, got %s", sent[0].Hash(), r.Receipt.TxHash)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for receipt")
+	}
+
+	for saver.count() != 3 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for txHash, status := range saver.status {
+		if status != "success" {
+			t.Fatalf("transaction %s: unexpected status %q", txHash, status)
+		}
+	}
+
+	cancel()
+	<-done
+}
+
+func TestTxMonitorRestoredReplacements(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(1)
+
+	sign := func(nonce uint64, tipCap int64) *types.Transaction {
+		return types.MustSignNewTx(
+			key,
+			types.NewLondonSigner(chainID),
+			&types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     nonce,
+				Gas:       100000,
+				GasFeeCap: big.NewInt(2 * tipCap),
+				GasTipCap: big.NewInt(tipCap),
+				To:        &common.Address{},
+				Data:      []byte{0x01},
+			},
+		)
+	}
+
+	// nonce 5 was replaced before the restart and the replacement is
+	// included, nonce 6 is still pending and gets replaced after it
+	original, replacement, stuck := sign(5, 100), sign(5, 110), sign(6, 100)
+	created := time.Now().Add(-time.Minute).Unix()
+
+	evm := newPollingEVM(0, 6)
+	helper := &testEVMHelper{receipts: make(map[common.Hash]txmonitor.Result)}
+	helper.setReceipt(replacement.Hash(), txmonitor.Result{
+		Receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: replacement.Hash()},
+	})
+	saver := &testSaver{
+		status: make(map[common.Hash]string),
+		pending: []*txmonitor.TxnDetails{
+			{Hash: original.Hash(), Nonce: 5, Created: created},
+			{Hash: replacement.Hash(), Nonce: 5, Created: created + 1},
+			{Hash: stuck.Hash(), Nonce: 6, Created: created},
+		},
+	}
+	sender := &testSender{known: []*types.Transaction{original, replacement, stuck}}
+
+	monitor := txmonitor.New(
+		common.Address{},
+		evm,
+		helper,
+		saver,
+		util.NewTestLogger(io.Discard),
+		2048,
+		txmonitor.WithFeeBumping(
+			txmonitor.FeeBumpPolicy{
+				Interval:     time.Second,
+				Percentage:   10,
+				MaxGasTipCap: big.NewInt(115),
+			},
+			chainID,
+			&testSigner{key: key},
+			sender,
+		),
+	)
+	monitor.SetStuckDuration(time.Hour)
+
+	res := monitor.WatchTx(original.Hash(), 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := monitor.Start(ctx)
+
+	select {
+	case r := <-res:
+		if r.Err != nil {
+			t.Fatalf("unexpected error: %v", r.Err)
+		}
+		if r.Receipt.TxHash != replacement.Hash() {
+			t.Fatalf("expected receipt of %s, got %s", replacement.Hash(), r.Receipt.TxHash)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for receipt")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sender.sentTxns()) < 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the replacement of the restored transaction")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	sent := sender.sentTxns()
+	if len(sent) != 1 {
+		t.Fatalf("expected 1 replacement, got %d", len(sent))
+	}
+	if sent[0].Nonce() != 6 || sent[0].GasTipCap().Int64() != 110 {
+		t.Fatalf("unexpected replacement: nonce %d, tip %s", sent[0].Nonce(), sent[0].GasTipCap())
+	}
+
+	cancel()
+	<-done
+}
+
+type testSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (t *testSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), t.key)
+}
+
+type testSender struct {
+	mu    sync.Mutex
+	txns  []*types.Transaction
+	known []*types.Transaction
+}
+
+func (t *testSender) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.txns = append(t.txns, tx)
+	return nil
+}
+
+func (t *testSender) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, tx := range t.known {
+		if tx.Hash() == hash {
+			return tx, true, nil
+		}
+	}
+	return nil, false, ethereum.NotFound
+}
+
+func (t *testSender) sentTxns() []*types.Transaction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.txns)
+}
+
 // pollingEVM returns values from atomic fields, suitable for stuck detection tests.
 type pollingEVM struct {
 	blockNum atomic.Uint64
@@ -271,10 +539,20 @@ func (t *testEVM) NonceAt(ctx context.Context, account common.Address, blockNumb
 }
 
 type testEVMHelper struct {
+	mu       sync.Mutex
 	receipts map[common.Hash]txmonitor.Result
 }
 
+func (t *testEVMHelper) setReceipt(txHash common.Hash, res txmonitor.Result) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.receipts[txHash] = res
+}
+
 func (t *testEVMHelper) BatchReceipts(ctx context.Context, txns []common.Hash) ([]txmonitor.Result, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	results := make([]txmonitor.Result, 0, len(txns))
 	for _, tx := range txns {
 		if _, ok := t.receipts[tx]; !ok {
@@ -291,8 +569,10 @@ func (t *testEVMHelper) RevertReason(ctx context.Context, r *types.Receipt, from
 }
 
 type testSaver struct {
-	mu     sync.Mutex
-	status map[common.Hash]string
+	mu      sync.Mutex
+	saved   map[common.Hash]uint64
+	status  map[common.Hash]string
+	pending []*txmonitor.TxnDetails
 }
 
 func (t *testSaver) count() int {
@@ -302,6 +582,12 @@ func (t *testSaver) count() int {
 }
 
 func (t *testSaver) Save(ctx context.Context, txHash common.Hash, nonce uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.saved == nil {
+		t.saved = make(map[common.Hash]uint64)
+	}
+	t.saved[txHash] = nonce
 	return nil
 }
 
@@ -313,5 +599,5 @@ func (t *testSaver) Update(ctx context.Context, txHash common.Hash, status strin
 }
 
 func (t *testSaver) PendingTxns() ([]*txmonitor.TxnDetails, error) {
-	return nil, nil
+	return t.pending, nil
 }
@@ -2,13 +2,13 @@ package keysigner
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type KeySigner interface {
@@ -17,8 +17,21 @@ type KeySigner interface {
 	SignHash(data []byte) ([]byte, error)
 	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 	GetAddress() common.Address
-	GetPrivateKey() (*ecdsa.PrivateKey, error)
-	ZeroPrivateKey(key *ecdsa.PrivateKey)
 	GetAuth(chainID *big.Int) (*bind.TransactOpts, error)
 	GetAuthWithCtx(ctx context.Context, chainID *big.Int) (*bind.TransactOpts, error)
 }
+
+// DataSigner is implemented by key signers which sign the keccak256 hash of
+// the given data instead of a hash, like the Web3Signer backed RemoteSigner.
+type DataSigner interface {
+	SignData(data []byte) ([]byte, error)
+}
+
+// SignKeccak256 signs the keccak256 hash of data. Signers implementing
+// DataSigner are given the data itself, all other signers sign the hash.
+func SignKeccak256(ks KeySigner, data []byte) ([]byte, error) {
+	if ds, ok := ks.(DataSigner); ok {
+		return ds.SignData(data)
+	}
+	return ks.SignHash(crypto.Keccak256(data))
+}
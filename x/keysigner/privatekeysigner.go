@@ -58,7 +58,7 @@ func (pks *PrivateKeySigner) SignHash(hash []byte) ([]byte, error) {
 }
 
 func (pks *PrivateKeySigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	return types.SignTx(tx, types.NewLondonSigner(chainID), pks.privKey)
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), pks.privKey)
 }
 
 func (pks *PrivateKeySigner) GetAddress() common.Address {
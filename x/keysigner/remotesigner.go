@@ -0,0 +1,292 @@
+package keysigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	defaultRemoteSignerTimeout = 5 * time.Second
+	maxRemoteSignerResponse    = 1 << 20
+)
+
+// RemoteSigner delegates signing to a Web3Signer compatible signing service,
+// so that the private key never enters the process memory. The service is
+// expected to expose the Web3Signer eth1 API for secp256k1 keys:
+//
+//	GET  /upcheck                        returns 200 when the service is ready
+//	GET  /api/v1/eth1/publicKeys         returns the list of available keys
+//	POST /api/v1/eth1/sign/{identifier}  signs {"data": "0x..."}
+//
+// The service signs the keccak256 hash of the data and returns the 65 byte
+// [R || S || V] signature as a hex string. As the service never signs a hash
+// directly, SignHash is not supported and callers have to provide the data
+// through SignData instead. Every signature is verified against the expected
+// address before it is used.
+type RemoteSigner struct {
+	baseURL    *url.URL
+	client     *http.Client
+	address    common.Address
+	identifier string
+}
+
+var _ DataSigner = (*RemoteSigner)(nil)
+
+// ErrHashSigningUnsupported is returned by SignHash of signers which are only
+// able to sign the data the hash is computed from.
+var ErrHashSigningUnsupported = errors.New("signer does not sign hashes directly, the signed data is required")
+
+// NewRemoteSigner connects to the signing service at rawURL and resolves the
+// key for address. If address is the zero address, the service must expose
+// exactly one key which is then used.
+func NewRemoteSigner(rawURL string, address common.Address, timeout time.Duration) (*RemoteSigner, error) {
+	baseURL, err := url.Parse(strings.TrimSuffix(rawURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer url: %w", err)
+	}
+	if timeout == 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+
+	rs := &RemoteSigner{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: timeout},
+	}
+
+	if _, err := rs.do(context.Background(), http.MethodGet, "/upcheck", nil); err != nil {
+		return nil, fmt.Errorf("remote signer is not available: %w", err)
+	}
+
+	body, err := rs.do(context.Background(), http.MethodGet, "/api/v1/eth1/publicKeys", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote signer keys: %w", err)
+	}
+	var keys []string
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, fmt.Errorf("failed to list remote signer keys: %w", err)
+	}
+
+	for _, key := range keys {
+		pubKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %w", key, err)
+		}
+		keyAddress := crypto.PubkeyToAddress(*pubKey)
+		switch {
+		case address == (common.Address{}) && len(keys) == 1,
+			keyAddress == address:
+			rs.address = keyAddress
+			rs.identifier = key
+		}
+	}
+
+	if rs.identifier == "" {
+		if address == (common.Address{}) {
+			return nil, fmt.Errorf("remote signer exposes %d keys, an address must be specified", len(keys))
+		}
+		return nil, fmt.Errorf("remote signer has no key for address %s", address.Hex())
+	}
+
+	return rs, nil
+}
+
+// parsePublicKey parses the public keys listed by the signing service. Web3Signer
+// lists the 64 byte uncompressed keys without the 0x04 prefix.
+func parsePublicKey(key string) (*ecdsa.PublicKey, error) {
+	buf, err := hexutil.Decode(key)
+	if err != nil {
+		return nil, err
+	}
+	switch len(buf) {
+	case 64:
+		return crypto.UnmarshalPubkey(append([]byte{0x04}, buf...))
+	case 33:
+		return crypto.DecompressPubkey(buf)
+	default:
+		return crypto.UnmarshalPubkey(buf)
+	}
+}
+
+// SignHash always fails as the signing service only signs data, see SignData.
+func (rs *RemoteSigner) SignHash(_ []byte) ([]byte, error) {
+	return nil, ErrHashSigningUnsupported
+}
+
+// SignData signs the keccak256 hash of data.
+func (rs *RemoteSigner) SignData(data []byte) ([]byte, error) {
+	return rs.signData(context.Background(), data)
+}
+
+func (rs *RemoteSigner) signData(ctx context.Context, data []byte) ([]byte, error) {
+	req := struct {
+		Data string `json:"data"`
+	}{
+		Data: hexutil.Encode(data),
+	}
+	body, err := rs.do(ctx, http.MethodPost, "/api/v1/eth1/sign/"+rs.identifier, req)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign: %w", err)
+	}
+
+	// Web3Signer responds with the plain hex signature, tolerate a JSON string.
+	signature := strings.Trim(strings.TrimSpace(string(body)), `"`)
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length from remote signer: %d", len(sig))
+	}
+	// Web3Signer returns the V value with the legacy offset.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != rs.address {
+		return nil, errors.New("remote signer returned signature for a different key")
+	}
+	return sig, nil
+}
+
+func (rs *RemoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return rs.signTx(context.Background(), tx, chainID)
+}
+
+func (rs *RemoteSigner) signTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	payload, err := txSigningPayload(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if crypto.Keccak256Hash(payload) != signer.Hash(tx) {
+		return nil, fmt.Errorf("unsupported signing payload for transaction type %d", tx.Type())
+	}
+	sig, err := rs.signData(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// txSigningPayload returns the data whose keccak256 hash is the signing hash of
+// the transaction.
+func txSigningPayload(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	var fields []any
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return rlp.EncodeToBytes([]any{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			chainID, uint(0), uint(0),
+		})
+	case types.AccessListTxType:
+		fields = []any{
+			chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(),
+		}
+	case types.DynamicFeeTxType:
+		fields = []any{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(),
+		}
+	case types.BlobTxType:
+		fields = []any{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(), tx.BlobGasFeeCap(), tx.BlobHashes(),
+		}
+	case types.SetCodeTxType:
+		fields = []any{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(), tx.SetCodeAuthorizations(),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+	enc, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{tx.Type()}, enc...), nil
+}
+
+func (rs *RemoteSigner) GetAddress() common.Address {
+	return rs.address
+}
+
+func (rs *RemoteSigner) GetAuth(chainID *big.Int) (*bind.TransactOpts, error) {
+	return rs.GetAuthWithCtx(context.Background(), chainID)
+}
+
+func (rs *RemoteSigner) GetAuthWithCtx(ctx context.Context, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, bind.ErrNoChainID
+	}
+	return &bind.TransactOpts{
+		From: rs.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != rs.address {
+				return nil, bind.ErrNotAuthorized
+			}
+			return rs.signTx(ctx, tx, chainID)
+		},
+		Context: ctx,
+	}, nil
+}
+
+func (rs *RemoteSigner) String() string {
+	return rs.baseURL.Redacted()
+}
+
+func (rs *RemoteSigner) do(ctx context.Context, method, path string, in any) ([]byte, error) {
+	var body io.Reader
+	if in != nil {
+		buf, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rs.baseURL.String()+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteSignerResponse))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
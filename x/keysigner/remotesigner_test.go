@@ -0,0 +1,178 @@
+package keysigner_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/mev-commit/x/keysigner"
+)
+
+func newTestSigningService(t *testing.T, legacyV bool) (*httptest.Server, common.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Web3Signer lists the uncompressed keys without the 0x04 prefix.
+	pubKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)[1:])
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /upcheck", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /api/v1/eth1/publicKeys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]string{pubKey})
+	})
+	mux.HandleFunc("POST /api/v1/eth1/sign/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("identifier") != pubKey {
+			http.Error(w, "unknown key", http.StatusNotFound)
+			return
+		}
+		var req struct {
+			Data string `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, err := hexutil.Decode(req.Data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sig, err := crypto.Sign(crypto.Keccak256(data), key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if legacyV {
+			sig[crypto.RecoveryIDOffset] += 27
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(hexutil.Encode(sig)))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	for _, legacyV := range []bool{false, true} {
+		srv, address := newTestSigningService(t, legacyV)
+
+		rs, err := keysigner.NewRemoteSigner(srv.URL, common.Address{}, 0)
+		if err != nil {
+			t.Fatalf("failed to create remote signer: %v", err)
+		}
+		if rs.GetAddress() != address {
+			t.Fatalf("expected address %s, got %s", address, rs.GetAddress())
+		}
+
+		data := []byte("test")
+		sig, err := keysigner.SignKeccak256(rs, data)
+		if err != nil {
+			t.Fatalf("failed to sign data: %v", err)
+		}
+		pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crypto.PubkeyToAddress(*pubKey) != address {
+			t.Fatal("signature recovers to a different address")
+		}
+
+		if _, err := rs.SignHash(crypto.Keccak256(data)); !errors.Is(err, keysigner.ErrHashSigningUnsupported) {
+			t.Fatalf("expected hash signing to be unsupported, got %v", err)
+		}
+
+		chainID := big.NewInt(1337)
+		txs := []types.TxData{
+			&types.LegacyTx{
+				Nonce:    1,
+				GasPrice: big.NewInt(2),
+				Gas:      21000,
+				To:       &common.Address{},
+				Value:    big.NewInt(1),
+			},
+			&types.LegacyTx{
+				Nonce:    1,
+				GasPrice: big.NewInt(2),
+				Gas:      100000,
+				Data:     []byte{0x60, 0x00},
+			},
+			&types.AccessListTx{
+				ChainID:    chainID,
+				Nonce:      1,
+				GasPrice:   big.NewInt(2),
+				Gas:        21000,
+				To:         &common.Address{},
+				Value:      big.NewInt(1),
+				AccessList: types.AccessList{{Address: common.HexToAddress("0x1")}},
+			},
+			&types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     1,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(2),
+				Gas:       21000,
+				To:        &common.Address{},
+				Value:     big.NewInt(1),
+			},
+		}
+		var tx *types.Transaction
+		for _, txData := range txs {
+			tx, err = rs.SignTx(types.NewTx(txData), chainID)
+			if err != nil {
+				t.Fatalf("failed to sign tx: %v", err)
+			}
+			from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if from != address {
+				t.Fatalf("expected tx sender %s, got %s", address, from)
+			}
+		}
+
+		opts, err := rs.GetAuth(chainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := opts.Signer(common.HexToAddress("0x1"), tx); err == nil {
+			t.Fatal("expected error signing for a different address")
+		}
+	}
+}
+
+func TestRemoteSignerErrors(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newTestSigningService(t, false)
+
+	_, err := keysigner.NewRemoteSigner(srv.URL, common.HexToAddress("0x1"), 0)
+	if err == nil || !strings.Contains(err.Error(), "no key for address") {
+		t.Fatalf("expected missing key error, got %v", err)
+	}
+
+	rs, err := keysigner.NewRemoteSigner(srv.URL, common.Address{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	if _, err := rs.SignData([]byte("test")); err == nil {
+		t.Fatal("expected error when the signing service is down")
+	}
+}